@@ -1,18 +1,37 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"petri/internal/config"
+	"petri/internal/content"
+	"petri/internal/i18n"
+	"petri/internal/save"
 	"petri/internal/ui"
 )
 
 const Version = "0.1.1"
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "serve":
+			os.Exit(runServe(os.Args[2:]))
+		case "agent":
+			os.Exit(runAgent(os.Args[2:]))
+		}
+	}
+
 	// Test mode flags
 	noFood := flag.Bool("no-food", false, "Skip spawning food items (test mode)")
 	noWater := flag.Bool("no-water", false, "Skip spawning water sources (test mode)")
@@ -21,13 +40,45 @@ func main() {
 	debug := flag.Bool("debug", false, "Show debug info (action progress, etc.)")
 	mushroomsOnly := flag.Bool("mushrooms-only", false, "Replace all items with mushroom varieties (test mode)")
 	version := flag.Bool("version", false, "Show version")
+	tuningSchema := flag.Bool("tuning-schema", false, "Print the JSON Schema for tuning files and exit")
+	streamAddr := flag.String("stream-addr", "", "Stream live updates as Server-Sent Events on this address (e.g. 127.0.0.1:8081)")
+	streamSocket := flag.String("stream-socket", "", "Stream live updates as newline-delimited JSON on this Unix socket path")
+	metricsAddr := flag.String("metrics-addr", "", "Serve Prometheus metrics at /metrics on this address (e.g. 127.0.0.1:9090)")
+	lang := flag.String("lang", i18n.DefaultLanguage, "Language for player-facing text ("+strings.Join(i18n.Languages(), ", ")+")")
+	render := flag.String("render", defaultRenderProfile(), "Render profile: default, colorblind, high-contrast, or mono (NO_COLOR selects mono)")
 	flag.Parse()
 
+	if err := i18n.SetLanguage(*lang); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	if *version {
 		fmt.Println("Version:", Version)
 		os.Exit(0)
 	}
 
+	if *tuningSchema {
+		schema, err := config.TuningSchema()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(schema))
+		os.Exit(0)
+	}
+
+	profile, err := ui.ParseRenderProfile(*render)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	ui.SetRenderProfile(profile)
+
+	if !loadContentAndTuning() {
+		os.Exit(1)
+	}
+
 	testCfg := ui.TestConfig{
 		NoFood:        *noFood,
 		NoWater:       *noWater,
@@ -37,13 +88,245 @@ func main() {
 		MushroomsOnly: *mushroomsOnly,
 	}
 
+	model := ui.NewModel(testCfg)
+	streamer, stopStream, err := startStreaming(*streamAddr, *streamSocket)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error starting stream: %v\n", err)
+		os.Exit(1)
+	}
+	defer stopStream()
+	if streamer != nil {
+		model = model.WithStreamer(streamer)
+	}
+	if *metricsAddr != "" {
+		metrics, stopMetrics, err := startMetrics(*metricsAddr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error starting metrics: %v\n", err)
+			stopStream()
+			os.Exit(1)
+		}
+		defer stopMetrics()
+		model = model.WithMetrics(metrics)
+	}
+
 	p := tea.NewProgram(
-		ui.NewModel(testCfg),
+		model,
 		tea.WithAltScreen(),
 	)
 
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		stopStream()
 		os.Exit(1)
 	}
 }
+
+// loadContentAndTuning installs content packs and the global tuning file, which must be in place
+// before any world is created or loaded. Reports the error and returns false on failure.
+func loadContentAndTuning() bool {
+	if _, err := content.Load(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading content packs:\n%v\n", err)
+		return false
+	}
+	if err := loadGlobalTuning(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tuning file:\n%v\n", err)
+		return false
+	}
+	return true
+}
+
+// defaultRenderProfile honors the NO_COLOR convention (https://no-color.org)
+func defaultRenderProfile() string {
+	if os.Getenv("NO_COLOR") != "" {
+		return string(ui.RenderMonochrome)
+	}
+	return string(ui.RenderDefault)
+}
+
+// runServe runs a world headless with the local HTTP JSON API.
+// Usage: petri serve --world world-0001 --addr 127.0.0.1:8080
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	worldID := fs.String("world", "", "World ID to serve (empty = create a new random world)")
+	addr := fs.String("addr", "127.0.0.1:8080", "Address to listen on")
+	streamAddr := fs.String("stream-addr", "", "Stream live updates as Server-Sent Events on this address")
+	streamSocket := fs.String("stream-socket", "", "Stream live updates as newline-delimited JSON on this Unix socket path")
+	lang := fs.String("lang", i18n.DefaultLanguage, "Language for log and status text")
+	fs.Parse(args)
+
+	if err := i18n.SetLanguage(*lang); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if !loadContentAndTuning() {
+		return 1
+	}
+
+	server, err := ui.LoadServer(*worldID, ui.TestConfig{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading world: %v\n", err)
+		return 1
+	}
+
+	streamer, stopStream, err := startStreaming(*streamAddr, *streamSocket)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error starting stream: %v\n", err)
+		return 1
+	}
+	defer stopStream()
+	if streamer != nil {
+		server.SetStreamer(streamer)
+	}
+
+	httpServer := &http.Server{Addr: *addr, Handler: server.Handler()}
+	stop := make(chan struct{})
+	go server.Run(stop)
+
+	// Save and shut down on interrupt
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		close(stop)
+		httpServer.Close()
+	}()
+
+	fmt.Printf("Serving %s on http://%s\n", server.WorldID(), *addr)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "Error running server: %v\n", err)
+		return 1
+	}
+
+	if err := server.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving world: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runAgent runs the gym-style agent protocol over stdin/stdout, or over a Unix socket.
+// Usage: petri agent --obs-interval 10 [--socket /tmp/petri-agent.sock]
+func runAgent(args []string) int {
+	fs := flag.NewFlagSet("agent", flag.ExitOnError)
+	obsInterval := fs.Int("obs-interval", ui.DefaultAgentObsInterval, "Ticks between observations")
+	socketPath := fs.String("socket", "", "Serve agents on this Unix socket instead of stdin/stdout")
+	lang := fs.String("lang", i18n.DefaultLanguage, "Language for log and status text")
+	fs.Parse(args)
+
+	if err := i18n.SetLanguage(*lang); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if !loadContentAndTuning() {
+		return 1
+	}
+
+	if *socketPath == "" {
+		env := ui.NewAgentEnv(ui.TestConfig{}, *obsInterval)
+		if err := env.Serve(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running agent protocol: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	os.Remove(*socketPath) // Clear a stale socket from a previous run
+	l, err := net.Listen("unix", *socketPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error listening on %s: %v\n", *socketPath, err)
+		return 1
+	}
+	defer os.Remove(*socketPath)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		l.Close()
+	}()
+
+	// One agent at a time; each connection gets a fresh environment
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return 0
+		}
+		env := ui.NewAgentEnv(ui.TestConfig{}, *obsInterval)
+		if err := env.Serve(conn, conn); err != nil {
+			fmt.Fprintf(os.Stderr, "Agent connection ended: %v\n", err)
+		}
+		conn.Close()
+	}
+}
+
+// startStreaming starts the live update listeners that are configured.
+// Returns a nil streamer if neither address nor socket is set.
+// The returned stop function closes listeners and open connections and removes the socket file.
+func startStreaming(addr, socketPath string) (*ui.Streamer, func(), error) {
+	if addr == "" && socketPath == "" {
+		return nil, func() {}, nil
+	}
+
+	streamer := ui.NewStreamer()
+	closers := []func(){streamer.Close}
+	stop := func() {
+		for _, c := range closers {
+			c()
+		}
+		closers = nil
+	}
+
+	if addr != "" {
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, stop, err
+		}
+		httpServer := &http.Server{Handler: streamer}
+		go httpServer.Serve(l)
+		closers = append(closers, func() { httpServer.Close() })
+	}
+
+	if socketPath != "" {
+		os.Remove(socketPath) // Clear a stale socket from a previous run
+		l, err := net.Listen("unix", socketPath)
+		if err != nil {
+			stop()
+			return nil, func() {}, err
+		}
+		go streamer.ServeSocket(l)
+		closers = append(closers, func() {
+			l.Close()
+			os.Remove(socketPath)
+		})
+	}
+
+	return streamer, stop, nil
+}
+
+// startMetrics serves Prometheus metrics at /metrics on addr.
+// The returned stop function closes the listener.
+func startMetrics(addr string) (*ui.Metrics, func(), error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	metrics := ui.NewMetrics()
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics)
+	httpServer := &http.Server{Handler: mux}
+	go httpServer.Serve(l)
+	return metrics, func() { httpServer.Close() }, nil
+}
+
+// loadGlobalTuning applies ~/.petri/tuning.json, if present, to every world
+func loadGlobalTuning() error {
+	path, err := save.GlobalTuningPath()
+	if err != nil {
+		return err
+	}
+	values, err := config.LoadTuningFile(path)
+	if err != nil {
+		return err
+	}
+	return config.SetGlobalTuning(values)
+}
@@ -8,6 +8,8 @@ const (
 	MapWidth  = 58
 	MapHeight = 58
 
+	WorldDaySeconds = 120.0 // 1 game second = 12 world minutes
+
 	ItemSpawnCount   = 20
 	FlowerSpawnCount = 20
 	SpringCount      = 2
@@ -19,7 +21,29 @@ const (
 	ClayMinCount     = 6
 	ClayMaxCount     = 10
 	ClayLooseItems   = 2 // loose clay items spawned on clay tiles at world gen (min; max is +1)
+	TreeMinCount     = 3
+	TreeMaxCount     = 6
+	TreeMinWidth     = 1
+	TreeMaxWidth     = 10
+	TreeHollowChance = 0.3 // chance a tree with heartwood spawns hollow with an opening
+	ChopWoodYield    = 2   // pieces of wood dropped by chopping down one tree tile
+	OutcropMinCount  = 2
+	OutcropMaxCount  = 4
+	OutcropMinSize   = 3
+	OutcropMaxSize   = 8
 	UpdateInterval   = 150 * time.Millisecond
+	MaxStepTicks     = 8000 // most ticks one /step or agent step request may run (10 world days)
+
+	// Terrain generation (elevation and moisture are noise fields stretched to 0-1)
+	TerrainNoiseOctaves  = 3    // octaves of value noise summed into each field
+	WetlandMoisture      = 0.6  // low ground at least this wet is wetland
+	ForestElevation      = 0.5  // ground at least this high and ForestMoisture wet is forest edge
+	ForestMoisture       = 0.45 // see ForestElevation
+	ClayFlatsElevation   = 0.3  // drier ground below this elevation is clay flats
+	TerrainMaxPondTiles  = 120  // most wetland tiles flooded into ponds, lowest ground first
+	RiverSourceElevation = 0.75 // rivers rise on ground at least this high
+	OutcropElevation     = 0.65 // rock outcrops break through ground at least this high
+	BiomeSpawnAttempts   = 30   // random spots tried for a biome-favoring spawn before settling anywhere
 
 	// Symbols
 	CharRobot       = '@'
@@ -31,6 +55,8 @@ const (
 	CharSpring      = '☉'
 	CharLeafPile    = '#'
 	CharWater       = '▓'
+	CharRiver       = '≈'
+	CharChannel     = '~'
 	CharStick       = '/'
 	CharNut         = 'o'
 	CharShell       = '<'
@@ -44,7 +70,18 @@ const (
 	CharClay        = '☗'
 	CharClayTile    = '░'
 	CharBrick       = '▬'
+	CharChisel      = 'j'
+	CharWood        = '='
+	CharLivewood    = '♣'
+	CharHeartwood   = '▒'
+	CharHollow      = '·'
+	CharTreeOpening = '∩'
+	CharOutcrop     = '▲'
+	CharStone       = '*'
+	CharFlake       = '^'
+	CharAxe         = 'P'
 	CharFence       = '╬'
+	CharCampfire    = 'Ψ'
 	CharHutCornerTL = '┏'
 	CharHutCornerTR = '┓'
 	CharHutCornerBL = '┗'
@@ -57,7 +94,11 @@ const (
 	CharHutTRight   = '┣'
 	CharHutTLeft    = '┫'
 	CharHutCross    = '╋'
+	CharRabbit      = 'r'
+)
 
+// Balance values. Declared as variables so tuning files can override them at runtime (see tuning.go).
+var (
 	// Speed system
 	BaseSpeed              = 50 // baseline speed (0-100 scale)
 	MinSpeed               = 5  // minimum speed floor
@@ -150,6 +191,79 @@ const (
 	// Ground spawning (sticks, nuts, shells)
 	GroundSpawnInterval = 600.0 // ~5 world days between spawns per item type (±LifecycleIntervalVariance)
 
+	// Wild creatures (rabbits)
+	CreatureSpawnInterval = 360.0 // ~3 world days between arrivals (±LifecycleIntervalVariance)
+	CreatureStayDuration  = 240.0 // ~2 world days before a creature heads back to the map edge
+	CreatureMaxCount      = 3.0   // no arrivals while this many creatures are on the map
+	CreatureMealSatiation = 40.0  // hunger reduced per plant eaten
+	CreatureTilledBonus   = 10.0  // tiles of distance a tilled-soil plant is worth to creatures that prefer them
+	CreatureGnawDamage    = 20.0  // durability a creature gnaws off a construct blocking its path, per attempt
+
+	// Threat response
+	ThreatPerceptionRadius = 5.0  // tiles within which characters notice threatening creatures
+	ThreatFleeThreshold    = 4.0  // creature threat + mood tier at which characters flee instead of shooing
+	FearMoodPenalty        = 10.0 // mood lost when a character flees a threat
+	FearCooldown           = 30.0 // seconds after fleeing or shooing before threats are noticed again
+
+	// Seasons (see LifecycleConfig.Seasons and GroundSpawnSeasons)
+	SeasonLengthDays = 4.0 // world days per season (spring, summer, autumn, winter)
+
+	// Weather (see WeatherOdds)
+	WeatherSpellDuration       = 120.0 // ~1 world day per weather spell (±LifecycleIntervalVariance)
+	DroughtThirstFactor        = 1.5   // thirst rises this many times as fast during a drought
+	DroughtEvaporationInterval = 20.0  // average seconds between pond edge tiles drying up in a drought
+	SummerEvaporationInterval  = 60.0  // average seconds between pond edge tiles drying up on clear summer days
+	RainRefillInterval         = 15.0  // average seconds between dried pond beds refilling in rain or a storm
+	StormDamageRate            = 4.0   // extra durability lost per second by constructs and exposed items in a storm
+
+	// Irrigation channels (see game/water.go)
+	ChannelSiltTime       = 480.0 // seconds (~4 world days) a channel holds water before silting up
+	ChannelUpkeepFraction = 0.5   // a channel needs clearing once this fraction of its silt time is left
+
+	// Soil fertility on tilled land (see game/soil.go); 1 is freshly tilled soil
+	SoilFertilityMin           = 0.25  // exhausted soil still grows plants at this fraction of the usual rate
+	SoilFertilityMax           = 1.5   // compost can enrich soil up to this
+	SoilHarvestDepletion       = 0.2   // fertility lost when a tile yields the same crop as its last harvest
+	SoilRotationRecovery       = 0.15  // fertility regained when a different crop is planted
+	SoilFallowRecoveryTime     = 480.0 // seconds (~4 world days) for fallow soil to recover from the minimum to 1
+	ShellCompostFertility      = 0.3   // fertility added by working a shell into the soil
+	SpentPlantCompostFertility = 0.1   // fertility added when a plant dies where it grew on tilled soil
+	SoilPoorFertility          = 0.6   // below this the soil overlay shows a tile as poor
+	SoilRichFertility          = 1.1   // above this the soil overlay shows a tile as rich
+
+	// Fire (see game/fire.go)
+	CampfireFuelPerStick      = 60.0  // seconds (~half a world day) of burning per stick fed to a campfire
+	CampfireMaxFuel           = 360.0 // most fuel a campfire holds (~3 world days)
+	CampfireRefuelFuel        = 60.0  // a campfire needs feeding once it has this much fuel left
+	CampfireRadius            = 3.0   // tiles around a lit campfire that are warmed and lit at night
+	CampfireWarmthBonus       = 12.0  // degrees warmer near a lit campfire
+	FireSpreadInterval        = 30.0  // average seconds for a fire to catch a flammable neighbor in dry weather
+	WildfireBurnTime          = 20.0  // seconds a wildfire burns on a tile before it is burnt out
+	CookedSatiationMultiplier = 1.5   // cooked food satisfies this many times as much hunger
+
+	// Temperature (°C; see SeasonTemperatures and WeatherTemperatureOffsets)
+	DayNightTemperatureSwing = 10.0 // degrees between full night and full day
+	ColdTemperature          = 8.0  // below this felt temperature, characters lose warmth
+	HutWarmthBonus           = 15.0 // degrees warmer inside an enclosed hut
+	WarmthLossRate           = 0.2  // warmth lost per second per degree below ColdTemperature
+	WarmthRecoveryRate       = 2.0  // warmth regained per second when not cold
+	ColdEnergyDrain          = 0.25 // extra energy lost per second while awake and cold outdoors
+	ColdMoodPenalty          = 1.0  // mood lost per second while cold outdoors (additive with need decay)
+	HutSleepRestoreBonus     = 1.25 // energy restore multiplier for sleeping inside a hut
+
+	// Day/night cycle (hours on the 24-hour world clock)
+	DawnHour              = 6.0  // world hour the sky starts to lighten
+	DuskHour              = 19.0 // world hour the sky starts to darken
+	TwilightHours         = 1.0  // world hours dawn and dusk take to fade between light and dark
+	NightPerceptionFactor = 0.5  // share of ThreatPerceptionRadius left in full darkness
+	NightWorkFactor       = 0.6  // share of normal action speed in full darkness
+	NightSleepEnergy      = 60.0 // below this energy, characters turn in for the night after dusk (ground sleep allowed)
+	DawnWakeEnergy        = 50.0 // sleepers with at least this much energy get up once dawn breaks
+
+	// Durability (see MaterialDurability)
+	DurabilitySalvageFraction   = 0.5 // share of a broken construct's input materials left behind
+	DeconstructRecoveryFraction = 1.0 // share of input materials recovered by deliberately dismantling a construct
+
 	// Bundle defaults
 	DefaultMaxBundleSize = 6
 
@@ -188,7 +302,44 @@ const (
 // LifecycleConfig defines spawn and death intervals for an item type
 type LifecycleConfig struct {
 	SpawnInterval float64 // base seconds between spawn attempts (multiplied by initial item count)
+	Reproduction  string  // "fast", "medium" or "slow": use that Reproduction* tier when SpawnInterval is 0
 	DeathInterval float64 // base seconds until death (0 = immortal, multiplied by initial item count)
+
+	// Seasonal timer speeds, keyed by season ("spring", "summer", "autumn", "winter") or
+	// "late_" + season for just its second half. Unlisted seasons run at normal speed.
+	Seasons map[string]SeasonRates
+}
+
+// reproductionTiers maps LifecycleConfig.Reproduction names to their tunable intervals
+var reproductionTiers = map[string]*float64{
+	"fast":   &ReproductionFast,
+	"medium": &ReproductionMedium,
+	"slow":   &ReproductionSlow,
+}
+
+// IsReproductionTier returns true if tier names a reproduction interval tier
+func IsReproductionTier(tier string) bool {
+	_, ok := reproductionTiers[tier]
+	return ok
+}
+
+// SpawnSeconds returns the base seconds between spawn attempts: SpawnInterval if set, otherwise
+// the current (tunable) interval of the Reproduction tier, defaulting to medium
+func (c LifecycleConfig) SpawnSeconds() float64 {
+	if c.SpawnInterval > 0 {
+		return c.SpawnInterval
+	}
+	if tier, ok := reproductionTiers[c.Reproduction]; ok {
+		return *tier
+	}
+	return ReproductionMedium
+}
+
+// SeasonRates scales an item type's lifecycle timers during a season: 1 is normal speed, 0 pauses the timer
+type SeasonRates struct {
+	Spawn  float64 // Reproduction (fruiting)
+	Sprout float64 // Sprouts maturing
+	Death  float64 // Dying of old age
 }
 
 // ItemLifecycle maps item types to their lifecycle configuration
@@ -197,11 +348,79 @@ type LifecycleConfig struct {
 // spawn attempts across all plants. To get target world-time intervals, divide by
 // expected item count (typically 20). E.g., 18 * 20 = 360s = 3 world days.
 var ItemLifecycle = map[string]LifecycleConfig{
-	"berry":    {SpawnInterval: ReproductionFast, DeathInterval: 0},      // ~2 world days between spawns, immortal until eaten
-	"mushroom": {SpawnInterval: ReproductionMedium, DeathInterval: 0},    // ~3 world days between spawns, immortal until eaten
-	"flower":   {SpawnInterval: ReproductionMedium, DeathInterval: 48.0}, // ~3 world days between spawns, dies after ~8 world days
-	"gourd":    {SpawnInterval: ReproductionSlow, DeathInterval: 0},      // ~5 world days between spawns, immortal until eaten
-	"grass":    {SpawnInterval: ReproductionFast, DeathInterval: 48.0},   // ~2 world days between spawns, dies after ~8 world days
+	// ~2 world days between spawns, immortal until eaten; fruits best in summer
+	"berry": {Reproduction: "fast", DeathInterval: 0, Seasons: map[string]SeasonRates{
+		"summer": {Spawn: 1.5, Sprout: 1.5, Death: 1},
+		"winter": {Spawn: 0.25, Sprout: 0.25, Death: 1},
+	}},
+	// ~3 world days between spawns, immortal until eaten; flushes in autumn
+	"mushroom": {Reproduction: "medium", DeathInterval: 0, Seasons: map[string]SeasonRates{
+		"autumn": {Spawn: 2, Sprout: 1.5, Death: 1},
+		"winter": {Spawn: 0.5, Sprout: 0.5, Death: 1},
+	}},
+	// ~3 world days between spawns, dies after ~8 world days; blooms in spring, dies back in winter
+	"flower": {Reproduction: "medium", DeathInterval: 48.0, Seasons: map[string]SeasonRates{
+		"spring": {Spawn: 2, Sprout: 1.5, Death: 1},
+		"winter": {Spawn: 0, Sprout: 0, Death: 2},
+	}},
+	// ~5 world days between spawns, immortal until eaten; only fruits in late summer
+	"gourd": {Reproduction: "slow", DeathInterval: 0, Seasons: map[string]SeasonRates{
+		"spring":      {Spawn: 0, Sprout: 1, Death: 1},
+		"summer":      {Spawn: 0, Sprout: 1.5, Death: 1},
+		"late_summer": {Spawn: 3, Sprout: 1.5, Death: 1},
+		"autumn":      {Spawn: 0, Sprout: 0.5, Death: 1},
+		"winter":      {Spawn: 0, Sprout: 0, Death: 1},
+	}},
+	// ~2 world days between spawns, dies after ~8 world days; dormant in winter
+	"grass": {Reproduction: "fast", DeathInterval: 48.0, Seasons: map[string]SeasonRates{
+		"spring": {Spawn: 1.5, Sprout: 1.5, Death: 1},
+		"winter": {Spawn: 0.25, Sprout: 0.25, Death: 1.5},
+	}},
+}
+
+// GroundSpawnSeasons scales ground spawn timers by season (same keys as LifecycleConfig.Seasons).
+// Unlisted item types and seasons spawn at the normal rate.
+var GroundSpawnSeasons = map[string]map[string]float64{
+	"nut": {"spring": 0.5, "autumn": 3, "winter": 0.25}, // nuts drop in autumn
+}
+
+// BiomeAffinities lists the biomes ("meadow", "wetland", "forest_edge", "clay_flats") each item type and
+// feature favors at world generation. Each variety of an item type settles in one of its type's biomes,
+// so varieties cluster by biome. Unlisted types spawn anywhere.
+var BiomeAffinities = map[string][]string{
+	"berry":     {"forest_edge", "meadow"},
+	"mushroom":  {"wetland", "forest_edge"},
+	"flower":    {"meadow", "clay_flats"},
+	"gourd":     {"meadow", "clay_flats"},
+	"grass":     {"meadow", "wetland"},
+	"stick":     {"forest_edge"},
+	"nut":       {"forest_edge"},
+	"leaf pile": {"forest_edge"},
+	"spring":    {"wetland"},
+}
+
+// SeasonTemperatures is the average temperature of each season in °C, before the time of day and weather
+var SeasonTemperatures = map[string]float64{
+	"spring": 12,
+	"summer": 22,
+	"autumn": 10,
+	"winter": 0,
+}
+
+// WeatherTemperatureOffsets shifts the temperature by weather (°C). Unlisted weather has no effect.
+var WeatherTemperatureOffsets = map[string]float64{
+	"rain":    -3,
+	"storm":   -6,
+	"drought": 4,
+}
+
+// WeatherOdds weights the weather rolled for the next spell by season ("clear", "rain", "storm", "drought").
+// Weights are relative; unlisted weather never occurs in that season.
+var WeatherOdds = map[string]map[string]float64{
+	"spring": {"clear": 3, "rain": 3, "storm": 1},
+	"summer": {"clear": 4, "rain": 1, "storm": 1, "drought": 2},
+	"autumn": {"clear": 3, "rain": 2, "storm": 2},
+	"winter": {"clear": 4, "rain": 2, "storm": 1},
 }
 
 // ExtractableTypes defines which plant types yield seeds via extraction
@@ -238,6 +457,18 @@ var MaxBundleSize = map[string]int{
 	"grass": 6,
 }
 
+// MaterialDurability maps materials to the full durability of constructs and crafted items made from them.
+// Durability wears down by one point per second of age, so these are also lifespans when nothing else damages them.
+// Materials not listed never wear.
+var MaterialDurability = map[string]float64{
+	"grass": 2400.0,  // thatch: ~20 world days
+	"gourd": 2400.0,  // hollow gourd vessels: ~20 world days
+	"shell": 3600.0,  // shell hoes: ~30 world days
+	"stick": 4800.0,  // ~40 world days
+	"brick": 14400.0, // ~120 world days
+	"stone": 9600.0,  // stone axes and flakes: ~80 world days
+}
+
 // VesselExcludedTypes is the set of item types that cannot be stored in vessels.
 // Distinct from MaxBundleSize — items can be vessel-excluded without being bundleable (clay, brick).
 var VesselExcludedTypes = map[string]bool{
@@ -245,6 +476,8 @@ var VesselExcludedTypes = map[string]bool{
 	"grass": true,
 	"clay":  true,
 	"brick": true,
+	"wood":  true,
+	"stone": true,
 }
 
 // GroundSpawnCount maps ground-spawned item types to their initial world-gen count.
@@ -252,6 +485,7 @@ var VesselExcludedTypes = map[string]bool{
 var GroundSpawnCount = map[string]int{
 	"stick": 6,
 	"nut":   6,
+	"stone": 4,
 }
 
 // GetGroundSpawnCount returns the initial spawn count for a ground item type, defaulting to 1
@@ -0,0 +1,333 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+)
+
+// TuningSource identifies which layer set a tunable's effective value
+type TuningSource string
+
+const (
+	SourceDefault TuningSource = "default" // Compiled-in value from config.go
+	SourceGlobal  TuningSource = "global"  // ~/.petri/tuning.json
+	SourceWorld   TuningSource = "world"   // Stored in the world's save
+)
+
+// Tunable is one balance value that tuning files may override
+type Tunable struct {
+	Key         string // JSON key in tuning files (snake_case)
+	Group       string // Section heading for docs and the debug panel
+	Description string
+	Default     float64
+	Max         float64 // Inclusive upper bound; 0 = unbounded. Lower bound is always 0.
+	value       *float64
+}
+
+// tunable registers a config variable, capturing its compiled-in value as the default
+func tunable(group, key string, value *float64, max float64, description string) *Tunable {
+	return &Tunable{Key: key, Group: group, Description: description, Default: *value, Max: max, value: value}
+}
+
+// tunables lists every overridable value, in display order
+var tunables = []*Tunable{
+	tunable("survival", "hunger_increase_rate", &HungerIncreaseRate, 0, "Hunger gained per second"),
+	tunable("survival", "thirst_increase_rate", &ThirstIncreaseRate, 0, "Thirst gained per second"),
+	tunable("survival", "energy_decrease_rate", &EnergyDecreaseRate, 0, "Energy lost per second"),
+	tunable("survival", "energy_movement_drain", &EnergyMovementDrain, 0, "Additional energy lost per move"),
+	tunable("survival", "starvation_damage_rate", &StarvationDamageRate, 0, "Health lost per second while starving"),
+	tunable("survival", "dehydration_damage_rate", &DehydrationDamageRate, 0, "Health lost per second while dehydrated"),
+	tunable("survival", "poison_damage_rate", &PoisonDamageRate, 0, "Health lost per second while poisoned"),
+	tunable("survival", "poison_duration", &PoisonDuration, 0, "Seconds poison lasts"),
+	tunable("survival", "drink_thirst_reduction", &DrinkThirstReduction, 100, "Thirst removed per drink"),
+	tunable("survival", "bed_energy_restore_rate", &BedEnergyRestoreRate, 0, "Energy restored per second sleeping in a bed"),
+	tunable("survival", "ground_energy_restore_rate", &GroundEnergyRestoreRate, 0, "Energy restored per second sleeping on the ground"),
+	tunable("survival", "satisfaction_cooldown", &SatisfactionCooldown, 0, "Seconds before a satisfied stat starts changing again"),
+	tunable("survival", "heal_amount", &HealAmount, 100, "Health restored by healing items"),
+
+	tunable("actions", "action_duration_short", &ActionDurationShort, 0, "Seconds for eat, drink, pickup"),
+	tunable("actions", "action_duration_medium", &ActionDurationMedium, 0, "Seconds for till soil, dig, plant, build"),
+	tunable("actions", "action_duration_long", &ActionDurationLong, 0, "Seconds for crafting recipes"),
+	tunable("actions", "idle_cooldown", &IdleCooldown, 0, "Seconds between idle activity attempts"),
+	tunable("actions", "look_duration", &LookDuration, 0, "Seconds to look at an item"),
+	tunable("actions", "talk_duration", &TalkDuration, 0, "Seconds a conversation lasts"),
+	tunable("actions", "frustration_duration", &FrustrationDuration, 0, "Seconds a character stays frustrated"),
+	tunable("actions", "order_abandon_cooldown", &OrderAbandonCooldown, 0, "Seconds before an abandoned order reopens"),
+
+	tunable("mood", "mood_increase_rate", &MoodIncreaseRate, 0, "Mood gained per second with no needs"),
+	tunable("mood", "mood_decrease_rate_slow", &MoodDecreaseRateSlow, 0, "Mood lost per second at Moderate need"),
+	tunable("mood", "mood_decrease_rate_medium", &MoodDecreaseRateMedium, 0, "Mood lost per second at Severe need"),
+	tunable("mood", "mood_decrease_rate_fast", &MoodDecreaseRateFast, 0, "Mood lost per second at Crisis need"),
+	tunable("mood", "mood_boost_on_consumption", &MoodBoostOnConsumption, 100, "Mood gained when eating or drinking"),
+	tunable("mood", "mood_preference_modifier", &MoodPreferenceModifier, 100, "Mood change per preference point on consumption"),
+	tunable("mood", "mood_penalty_poisoned", &MoodPenaltyPoisoned, 0, "Mood lost per second while poisoned"),
+	tunable("mood", "mood_penalty_frustrated", &MoodPenaltyFrustrated, 0, "Mood lost per second while frustrated"),
+
+	tunable("discovery", "know_how_discovery_chance", &KnowHowDiscoveryChance, 1, "Chance per interaction to discover know-how when Joyful"),
+	tunable("discovery", "pref_formation_chance_miserable", &PrefFormationChanceMiserable, 1, "Chance to form a preference when Miserable"),
+	tunable("discovery", "pref_formation_chance_unhappy", &PrefFormationChanceUnhappy, 1, "Chance to form a preference when Unhappy"),
+	tunable("discovery", "pref_formation_chance_happy", &PrefFormationChanceHappy, 1, "Chance to form a preference when Happy"),
+	tunable("discovery", "pref_formation_chance_joyful", &PrefFormationChanceJoyful, 1, "Chance to form a preference when Joyful"),
+	tunable("discovery", "pref_formation_weight_single", &PrefFormationWeightSingle, 1, "Share of new preferences that are single-attribute"),
+
+	tunable("spawning", "item_spawn_chance", &ItemSpawnChance, 1, "Chance a plant reproduces per spawn opportunity"),
+	tunable("spawning", "item_spawn_max_density", &ItemSpawnMaxDensity, 1, "Max fraction of tiles holding items"),
+	tunable("spawning", "lifecycle_interval_variance", &LifecycleIntervalVariance, 1, "± randomization of spawn/death timers"),
+	tunable("spawning", "reproduction_fast", &ReproductionFast, 0, "Base seconds between spawns for fast plants (berry, grass)"),
+	tunable("spawning", "reproduction_medium", &ReproductionMedium, 0, "Base seconds between spawns for medium plants (mushroom, flower)"),
+	tunable("spawning", "reproduction_slow", &ReproductionSlow, 0, "Base seconds between spawns for slow plants (gourd)"),
+	tunable("spawning", "ground_spawn_interval", &GroundSpawnInterval, 0, "Seconds between ground spawns per item type"),
+	tunable("spawning", "tilled_growth_multiplier", &TilledGrowthMultiplier, 0, "Growth speed on tilled soil"),
+	tunable("spawning", "wet_growth_multiplier", &WetGrowthMultiplier, 0, "Growth speed on wet tiles"),
+	tunable("spawning", "watered_tile_duration", &WateredTileDuration, 0, "Seconds manual watering lasts"),
+
+	tunable("creatures", "creature_spawn_interval", &CreatureSpawnInterval, 0, "Seconds between wild creature arrivals"),
+	tunable("creatures", "creature_stay_duration", &CreatureStayDuration, 0, "Seconds a creature stays before leaving"),
+	tunable("creatures", "creature_max_count", &CreatureMaxCount, 0, "Creatures on the map that stop further arrivals"),
+	tunable("creatures", "creature_meal_satiation", &CreatureMealSatiation, 100, "Creature hunger reduced per plant eaten"),
+	tunable("creatures", "creature_tilled_bonus", &CreatureTilledBonus, 0, "Tiles of distance a tilled-soil plant is worth to rabbits"),
+	tunable("creatures", "creature_gnaw_damage", &CreatureGnawDamage, 0, "Durability a creature gnaws off a blocking construct"),
+
+	tunable("threats", "threat_perception_radius", &ThreatPerceptionRadius, 0, "Tiles within which characters notice threats"),
+	tunable("threats", "threat_flee_threshold", &ThreatFleeThreshold, 0, "Threat plus mood tier at which characters flee"),
+	tunable("threats", "fear_mood_penalty", &FearMoodPenalty, 100, "Mood lost when fleeing a threat"),
+	tunable("threats", "fear_cooldown", &FearCooldown, 0, "Seconds before threats are noticed again"),
+
+	tunable("seasons", "season_length_days", &SeasonLengthDays, 0, "World days per season"),
+
+	tunable("weather", "weather_spell_duration", &WeatherSpellDuration, 0, "Seconds each weather spell lasts"),
+	tunable("weather", "drought_thirst_factor", &DroughtThirstFactor, 0, "Thirst rate multiplier during a drought"),
+	tunable("weather", "drought_evaporation_interval", &DroughtEvaporationInterval, 0, "Seconds between pond tiles drying up in a drought"),
+	tunable("weather", "summer_evaporation_interval", &SummerEvaporationInterval, 0, "Seconds between pond tiles drying up on clear summer days"),
+	tunable("weather", "rain_refill_interval", &RainRefillInterval, 0, "Seconds between dried pond beds refilling in rain"),
+	tunable("weather", "storm_damage_rate", &StormDamageRate, 0, "Durability lost per second by exposed things in a storm"),
+
+	tunable("channels", "channel_silt_time", &ChannelSiltTime, 0, "Seconds a channel holds water before silting up"),
+	tunable("channels", "channel_upkeep_fraction", &ChannelUpkeepFraction, 1, "Fraction of silt time left when a channel needs clearing"),
+
+	tunable("soil", "soil_fertility_min", &SoilFertilityMin, 1, "Growth rate fraction of exhausted soil"),
+	tunable("soil", "soil_fertility_max", &SoilFertilityMax, 0, "Highest fertility compost can reach"),
+	tunable("soil", "soil_harvest_depletion", &SoilHarvestDepletion, 1, "Fertility lost harvesting the same crop twice running"),
+	tunable("soil", "soil_rotation_recovery", &SoilRotationRecovery, 1, "Fertility regained planting a different crop"),
+	tunable("soil", "soil_fallow_recovery_time", &SoilFallowRecoveryTime, 0, "Seconds for fallow soil to recover fully"),
+	tunable("soil", "shell_compost_fertility", &ShellCompostFertility, 1, "Fertility added by composting a shell"),
+	tunable("soil", "spent_plant_compost_fertility", &SpentPlantCompostFertility, 1, "Fertility added when a garden plant dies in place"),
+
+	tunable("fire", "campfire_fuel_per_stick", &CampfireFuelPerStick, 0, "Seconds a campfire burns per stick"),
+	tunable("fire", "campfire_max_fuel", &CampfireMaxFuel, 0, "Most seconds of fuel a campfire holds"),
+	tunable("fire", "campfire_refuel_fuel", &CampfireRefuelFuel, 0, "Seconds of fuel left when a campfire needs feeding"),
+	tunable("fire", "campfire_radius", &CampfireRadius, 0, "Tiles warmed and lit by a campfire"),
+	tunable("fire", "campfire_warmth_bonus", &CampfireWarmthBonus, 0, "Degrees warmer near a lit campfire"),
+	tunable("fire", "fire_spread_interval", &FireSpreadInterval, 0, "Seconds for fire to catch a flammable neighbor in dry weather"),
+	tunable("fire", "wildfire_burn_time", &WildfireBurnTime, 0, "Seconds a wildfire burns on a tile"),
+	tunable("fire", "cooked_satiation_multiplier", &CookedSatiationMultiplier, 0, "Hunger satisfied by cooked food relative to raw"),
+
+	tunable("temperature", "day_night_temperature_swing", &DayNightTemperatureSwing, 0, "Degrees between full night and full day"),
+	tunable("temperature", "cold_temperature", &ColdTemperature, 0, "Felt temperature below which characters lose warmth"),
+	tunable("temperature", "hut_warmth_bonus", &HutWarmthBonus, 0, "Degrees warmer inside a hut"),
+	tunable("temperature", "warmth_loss_rate", &WarmthLossRate, 0, "Warmth lost per second per degree of cold"),
+	tunable("temperature", "warmth_recovery_rate", &WarmthRecoveryRate, 0, "Warmth regained per second when warm"),
+	tunable("temperature", "cold_energy_drain", &ColdEnergyDrain, 0, "Extra energy lost per second when cold outdoors"),
+	tunable("temperature", "cold_mood_penalty", &ColdMoodPenalty, 0, "Mood lost per second when cold outdoors"),
+	tunable("temperature", "hut_sleep_restore_bonus", &HutSleepRestoreBonus, 0, "Energy restore multiplier for sleeping in a hut"),
+
+	tunable("daynight", "dawn_hour", &DawnHour, 24, "World hour the sky starts to lighten"),
+	tunable("daynight", "dusk_hour", &DuskHour, 24, "World hour the sky starts to darken"),
+	tunable("daynight", "twilight_hours", &TwilightHours, 12, "World hours dawn and dusk take to fade"),
+	tunable("daynight", "night_perception_factor", &NightPerceptionFactor, 1, "Share of threat perception radius left at night"),
+	tunable("daynight", "night_work_factor", &NightWorkFactor, 1, "Share of normal action speed at night"),
+	tunable("daynight", "night_sleep_energy", &NightSleepEnergy, 100, "Energy below which characters turn in after dusk"),
+	tunable("daynight", "dawn_wake_energy", &DawnWakeEnergy, 100, "Energy at which sleepers get up at dawn"),
+
+	tunable("durability", "durability_salvage_fraction", &DurabilitySalvageFraction, 1, "Share of input materials a broken construct leaves"),
+	tunable("durability", "deconstruct_recovery_fraction", &DeconstructRecoveryFraction, 1, "Share of input materials recovered by deconstructing"),
+
+	tunable("food_seeking", "food_seek_pref_weight_moderate", &FoodSeekPrefWeightModerate, 0, "Preference weight at Moderate hunger"),
+	tunable("food_seeking", "food_seek_pref_weight_severe", &FoodSeekPrefWeightSevere, 0, "Preference weight at Severe hunger"),
+	tunable("food_seeking", "food_seek_pref_weight_crisis", &FoodSeekPrefWeightCrisis, 0, "Preference weight at Crisis hunger"),
+	tunable("food_seeking", "food_seek_dist_weight", &FoodSeekDistWeight, 0, "Base distance penalty per tile (vessel scoring)"),
+	tunable("food_seeking", "food_seek_dist_weight_moderate", &FoodSeekDistWeightModerate, 0, "Distance penalty per tile at Moderate hunger"),
+	tunable("food_seeking", "food_seek_dist_weight_severe", &FoodSeekDistWeightSevere, 0, "Distance penalty per tile at Severe hunger"),
+	tunable("food_seeking", "food_seek_dist_weight_crisis", &FoodSeekDistWeightCrisis, 0, "Distance penalty per tile at Crisis hunger"),
+	tunable("food_seeking", "item_seek_pref_weight", &ItemSeekPrefWeight, 0, "Tiles one preference point is worth when seeking items"),
+	tunable("food_seeking", "item_seek_dist_weight", &ItemSeekDistWeight, 0, "Distance penalty per tile when seeking items"),
+	tunable("food_seeking", "healing_bonus_mild", &HealingBonusMild, 0, "Score bonus for known healing food at Mild health"),
+	tunable("food_seeking", "healing_bonus_moderate", &HealingBonusModerate, 0, "Score bonus for known healing food at Moderate health"),
+	tunable("food_seeking", "healing_bonus_severe", &HealingBonusSevere, 0, "Score bonus for known healing food at Severe health"),
+	tunable("food_seeking", "healing_bonus_crisis", &HealingBonusCrisis, 0, "Score bonus for known healing food at Crisis health"),
+}
+
+// Tuning layers. World overrides global, global overrides defaults.
+var (
+	globalTuning map[string]float64
+	worldTuning  map[string]float64
+)
+
+// TuningValue is a tunable's effective value and the layer it came from
+type TuningValue struct {
+	Tunable
+	Value  float64
+	Source TuningSource
+}
+
+// Tunables returns every overridable value, in display order
+func Tunables() []Tunable {
+	result := make([]Tunable, len(tunables))
+	for i, t := range tunables {
+		result[i] = *t
+	}
+	return result
+}
+
+// findTunable looks up a tunable by key
+func findTunable(key string) *Tunable {
+	for _, t := range tunables {
+		if t.Key == key {
+			return t
+		}
+	}
+	return nil
+}
+
+// ParseTuning decodes and validates a tuning file: a JSON object mapping tunable keys to numbers
+func ParseTuning(data []byte) (map[string]float64, error) {
+	var values map[string]float64
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("invalid tuning JSON: %w", err)
+	}
+	if err := ValidateTuning(values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// LoadTuningFile reads and validates a tuning file. A missing file returns nil, nil.
+func LoadTuningFile(path string) (map[string]float64, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	values, err := ParseTuning(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
+
+// ValidateTuning checks that every key is a known tunable and every value is within its bounds.
+// All problems are reported together.
+func ValidateTuning(values map[string]float64) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	// Sort for consistent ordering (maps iterate randomly)
+	sort.Strings(keys)
+
+	var problems []string
+	for _, key := range keys {
+		v := values[key]
+		t := findTunable(key)
+		switch {
+		case t == nil:
+			problems = append(problems, fmt.Sprintf("unknown key %q", key))
+		case math.IsNaN(v) || math.IsInf(v, 0) || v < 0:
+			problems = append(problems, fmt.Sprintf("%s: %g must be a number >= 0", key, v))
+		case t.Max > 0 && v > t.Max:
+			problems = append(problems, fmt.Sprintf("%s: %g exceeds maximum %g", key, v, t.Max))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid tuning: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// SetGlobalTuning validates and applies the global tuning layer (nil clears it)
+func SetGlobalTuning(values map[string]float64) error {
+	if err := ValidateTuning(values); err != nil {
+		return err
+	}
+	globalTuning = values
+	applyTuning()
+	return nil
+}
+
+// SetWorldTuning validates and applies the per-world tuning layer (nil clears it)
+func SetWorldTuning(values map[string]float64) error {
+	if err := ValidateTuning(values); err != nil {
+		return err
+	}
+	worldTuning = values
+	applyTuning()
+	return nil
+}
+
+// applyTuning resets every tunable to its default, then overlays the global and world layers.
+// Only changed values are written, so re-applying the same layers touches nothing.
+func applyTuning() {
+	for _, t := range tunables {
+		v := t.Default
+		if g, ok := globalTuning[t.Key]; ok {
+			v = g
+		}
+		if w, ok := worldTuning[t.Key]; ok {
+			v = w
+		}
+		if *t.value != v {
+			*t.value = v
+		}
+	}
+}
+
+// EffectiveTuning returns every tunable's current value and source, in display order
+func EffectiveTuning() []TuningValue {
+	result := make([]TuningValue, len(tunables))
+	for i, t := range tunables {
+		source := SourceDefault
+		if _, ok := worldTuning[t.Key]; ok {
+			source = SourceWorld
+		} else if _, ok := globalTuning[t.Key]; ok {
+			source = SourceGlobal
+		}
+		result[i] = TuningValue{Tunable: *t, Value: *t.value, Source: source}
+	}
+	return result
+}
+
+// TuningSchema returns a JSON Schema describing tuning files
+func TuningSchema() ([]byte, error) {
+	type property struct {
+		Type        string   `json:"type"`
+		Description string   `json:"description"`
+		Default     float64  `json:"default"`
+		Minimum     float64  `json:"minimum"`
+		Maximum     *float64 `json:"maximum,omitempty"`
+	}
+	properties := make(map[string]property, len(tunables))
+	for _, t := range tunables {
+		p := property{Type: "number", Description: t.Group + ": " + t.Description, Default: t.Default}
+		if t.Max > 0 {
+			max := t.Max
+			p.Maximum = &max
+		}
+		properties[t.Key] = p
+	}
+	schema := map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "Petri tuning file",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties":           properties,
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
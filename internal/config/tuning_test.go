@@ -0,0 +1,127 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseTuning_AcceptsKnownKeys(t *testing.T) {
+	t.Parallel()
+
+	values, err := ParseTuning([]byte(`{"hunger_increase_rate": 0.2, "know_how_discovery_chance": 0.05}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if values["hunger_increase_rate"] != 0.2 || values["know_how_discovery_chance"] != 0.05 {
+		t.Errorf("Unexpected values: %v", values)
+	}
+}
+
+func TestParseTuning_ReportsEveryProblem(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseTuning([]byte(`{"hunger_rate": 1, "thirst_increase_rate": -1, "item_spawn_chance": 1.5}`))
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	for _, want := range []string{`unknown key "hunger_rate"`, "thirst_increase_rate: -1", "item_spawn_chance: 1.5 exceeds maximum 1"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected %q in error: %v", want, err)
+		}
+	}
+
+	if _, err := ParseTuning([]byte(`{"hunger_increase_rate": "fast"}`)); err == nil {
+		t.Error("Expected error for non-numeric value")
+	}
+}
+
+func TestLoadTuningFile_MissingFileIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	values, err := LoadTuningFile(filepath.Join(t.TempDir(), "tuning.json"))
+	if err != nil || values != nil {
+		t.Errorf("Expected nil, nil for missing file, got %v, %v", values, err)
+	}
+}
+
+func TestLoadTuningFile_NamesFileInError(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "tuning.json")
+	if err := os.WriteFile(path, []byte(`{"bogus": 1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := LoadTuningFile(path)
+	if err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("Expected error naming %s, got %v", path, err)
+	}
+}
+
+// Not parallel: mutates the global tuning layers
+func TestSetTuning_WorldOverridesGlobalOverridesDefault(t *testing.T) {
+	t.Cleanup(func() {
+		SetWorldTuning(nil)
+		SetGlobalTuning(nil)
+	})
+	hungerDefault := HungerIncreaseRate
+
+	if err := SetGlobalTuning(map[string]float64{"hunger_increase_rate": 0.3, "thirst_increase_rate": 0.4}); err != nil {
+		t.Fatalf("SetGlobalTuning: %v", err)
+	}
+	if err := SetWorldTuning(map[string]float64{"hunger_increase_rate": 0.5}); err != nil {
+		t.Fatalf("SetWorldTuning: %v", err)
+	}
+	if HungerIncreaseRate != 0.5 || ThirstIncreaseRate != 0.4 {
+		t.Errorf("Expected hunger 0.5 (world), thirst 0.4 (global), got %v, %v", HungerIncreaseRate, ThirstIncreaseRate)
+	}
+
+	sources := make(map[string]TuningSource)
+	for _, tv := range EffectiveTuning() {
+		sources[tv.Key] = tv.Source
+	}
+	if sources["hunger_increase_rate"] != SourceWorld || sources["thirst_increase_rate"] != SourceGlobal || sources["energy_decrease_rate"] != SourceDefault {
+		t.Errorf("Unexpected sources: hunger=%s thirst=%s energy=%s",
+			sources["hunger_increase_rate"], sources["thirst_increase_rate"], sources["energy_decrease_rate"])
+	}
+
+	// Clearing the world layer falls back to global, clearing global falls back to default
+	SetWorldTuning(nil)
+	if HungerIncreaseRate != 0.3 {
+		t.Errorf("Expected global hunger rate 0.3 after clearing world tuning, got %v", HungerIncreaseRate)
+	}
+	SetGlobalTuning(nil)
+	if HungerIncreaseRate != hungerDefault {
+		t.Errorf("Expected default hunger rate %v, got %v", hungerDefault, HungerIncreaseRate)
+	}
+}
+
+// Not parallel: reads the global tuning layers
+func TestSetWorldTuning_InvalidLeavesValuesUnchanged(t *testing.T) {
+	before := HungerIncreaseRate
+	if err := SetWorldTuning(map[string]float64{"hunger_increase_rate": 2, "nope": 1}); err == nil {
+		t.Fatal("Expected error for unknown key")
+	}
+	if HungerIncreaseRate != before {
+		t.Errorf("Expected hunger rate unchanged after invalid tuning, got %v", HungerIncreaseRate)
+	}
+}
+
+// Not parallel: mutates the global tuning layers
+func TestSetWorldTuning_ReachesLifecycleSpawnIntervals(t *testing.T) {
+	t.Cleanup(func() { SetWorldTuning(nil) })
+
+	if err := SetWorldTuning(map[string]float64{"reproduction_fast": 6, "action_duration_long": 3}); err != nil {
+		t.Fatalf("SetWorldTuning: %v", err)
+	}
+	if got := ItemLifecycle["berry"].SpawnSeconds(); got != 6 {
+		t.Errorf("Expected berry spawn interval to follow reproduction_fast (6), got %v", got)
+	}
+	if got := ItemLifecycle["gourd"].SpawnSeconds(); got != ReproductionSlow {
+		t.Errorf("Expected gourd spawn interval %v, got %v", ReproductionSlow, got)
+	}
+	if ActionDurationLong != 3 {
+		t.Errorf("Expected action_duration_long tunable to apply, got %v", ActionDurationLong)
+	}
+}
@@ -0,0 +1,173 @@
+package content
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/save"
+)
+
+// BasePackID is the ID of the built-in content pack
+const BasePackID = "base"
+
+// shipped holds the packs distributed with the game
+//
+//go:embed packs/*.json
+var shipped embed.FS
+
+// loaded is the "id@version" list of packs in effect, in load order
+var loaded = []string{BasePackID + "@1"}
+
+// Loaded returns the "id@version" references of the packs in effect, in load order.
+// Worlds record this list when they are created.
+func Loaded() []string {
+	return append([]string(nil), loaded...)
+}
+
+// Missing returns the packs a world was created with that are not loaded now.
+// Only IDs are compared; a different version of the same pack is allowed.
+func Missing(recorded []string) []string {
+	have := make(map[string]bool)
+	for _, ref := range loaded {
+		have[refID(ref)] = true
+	}
+	var missing []string
+	for _, ref := range recorded {
+		if !have[refID(ref)] {
+			missing = append(missing, ref)
+		}
+	}
+	return missing
+}
+
+// refID strips the "@version" suffix from a pack reference
+func refID(ref string) string {
+	id, _, _ := strings.Cut(ref, "@")
+	return id
+}
+
+// ModsDir returns the user mods directory (~/.petri/mods)
+func ModsDir() (string, error) {
+	base, err := save.BaseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "mods"), nil
+}
+
+// ShippedPacks returns the packs distributed with the game, sorted by file name
+func ShippedPacks() ([]*Pack, error) {
+	entries, err := shipped.ReadDir("packs")
+	if err != nil {
+		return nil, err
+	}
+	var packs []*Pack
+	for _, e := range entries {
+		data, err := shipped.ReadFile("packs/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+		p, err := Decode(data, "shipped/"+e.Name())
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, p)
+	}
+	return packs, nil
+}
+
+// LoadDir reads every *.json pack in dir, sorted by file name.
+// A missing directory yields no packs.
+func LoadDir(dir string) ([]*Pack, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	var packs []*Pack
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read pack: %w", err)
+		}
+		p, err := Decode(data, path)
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, p)
+	}
+	return packs, nil
+}
+
+// Load reads the shipped packs and the user's mods, validates them together,
+// and installs them. Call once at startup, before any world is created or loaded.
+func Load() ([]*Pack, error) {
+	packs, err := ShippedPacks()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ModsDir()
+	if err != nil {
+		return nil, err
+	}
+	mods, err := LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	packs = append(packs, mods...)
+	if err := Install(packs); err != nil {
+		return nil, err
+	}
+	return packs, nil
+}
+
+// Install validates packs and, if they are valid, applies them to the registries
+// in order (later packs replace earlier entries with the same ID).
+// Nothing is applied if validation fails.
+func Install(packs []*Pack) error {
+	if _, err := buildCatalog(packs); err != nil {
+		return err
+	}
+
+	refs := []string{BasePackID + "@1"}
+	for _, p := range packs {
+		apply(p)
+		if p.ID != BasePackID {
+			refs = append(refs, p.Ref())
+		} else {
+			refs[0] = p.Ref()
+		}
+	}
+	loaded = refs
+	return nil
+}
+
+// apply writes a validated pack's entries into the registries
+func apply(p *Pack) {
+	for _, d := range p.Activities {
+		a, _ := d.toActivity()
+		entity.ActivityRegistry[a.ID] = a
+	}
+	for _, d := range p.Recipes {
+		r, _ := d.toRecipe()
+		entity.RecipeRegistry[r.ID] = r
+	}
+	for itemType, d := range p.ItemTypes {
+		cfg, _ := d.toItemTypeConfig(itemType)
+		game.SetItemTypeConfig(itemType, cfg)
+	}
+	for itemType, d := range p.Lifecycle {
+		lc, _ := d.toLifecycleConfig(itemType)
+		config.ItemLifecycle[itemType] = lc
+	}
+	for _, d := range p.ConstructKinds {
+		entity.ConstructKindRegistry[d.Kind] = entity.ConstructKind{Kind: d.Kind, Name: d.Name, Passable: d.Passable}
+	}
+}
@@ -0,0 +1,100 @@
+package content
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+)
+
+func TestLoadDir_ReadsPacksInFileOrder(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"id": "second", "version": 1}`), 0644)
+	os.WriteFile(filepath.Join(dir, "a.json"), []byte(`{"id": "first", "version": 2}`), 0644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte(`ignored`), 0644)
+
+	packs, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir: %v", err)
+	}
+	if len(packs) != 2 || packs[0].ID != "first" || packs[1].ID != "second" {
+		t.Fatalf("Expected first then second, got %+v", packs)
+	}
+	if packs[0].Ref() != "first@2" {
+		t.Errorf("Expected ref first@2, got %s", packs[0].Ref())
+	}
+
+	packs, err = LoadDir(filepath.Join(dir, "missing"))
+	if err != nil || len(packs) != 0 {
+		t.Errorf("Expected no packs and no error for missing dir, got %v, %v", packs, err)
+	}
+}
+
+// Not parallel: installs into the shared registries, then removes what it added.
+func TestInstall_AppliesPackAndRecordsRef(t *testing.T) {
+	p := decodePack(t, `{
+		"id": "reeds", "version": 3,
+		"item_types": {"reed": {"colors": ["green"], "symbol": "|", "spawn_count": 10}},
+		"lifecycle": {"reed": {"spawn_interval": 18}},
+		"construct_kinds": [{"kind": "mat", "name": "Mat", "passable": true}],
+		"activities": [{"id": "weaveMat", "name": "Mat", "category": "construction", "intent_formation": "orderable", "availability": "knowhow"}],
+		"recipes": [{
+			"id": "reed-mat", "activity_id": "weaveMat", "name": "Reed Mat",
+			"inputs": [{"item_type": "reed", "count": 3}],
+			"output": {"item_type": "mat"},
+			"discovery_triggers": [{"action": "pickup", "item_type": "reed"}]
+		}]
+	}`)
+	prevLoaded := loaded
+	defer func() {
+		delete(entity.ActivityRegistry, "weaveMat")
+		delete(entity.RecipeRegistry, "reed-mat")
+		delete(entity.ConstructKindRegistry, "mat")
+		delete(config.ItemLifecycle, "reed")
+		game.ResetItemTypeConfigs()
+		loaded = prevLoaded
+	}()
+
+	if err := Install([]*Pack{p}); err != nil {
+		t.Fatalf("Install: %v", err)
+	}
+	if _, ok := entity.ActivityRegistry["weaveMat"]; !ok {
+		t.Error("Expected weaveMat activity registered")
+	}
+	if r := entity.RecipeRegistry["reed-mat"]; r == nil || r.Inputs[0].ItemType != "reed" {
+		t.Errorf("Expected reed-mat recipe registered, got %+v", r)
+	}
+	if cfg, ok := game.GetItemTypeConfigs()["reed"]; !ok || cfg.Sym != '|' {
+		t.Errorf("Expected reed item type with symbol |, got %+v", cfg)
+	}
+	if config.ItemLifecycle["reed"].SpawnInterval != 18 {
+		t.Error("Expected reed lifecycle registered")
+	}
+	if !reflect.DeepEqual(Loaded(), []string{"base@1", "reeds@3"}) {
+		t.Errorf("Expected loaded [base@1 reeds@3], got %v", Loaded())
+	}
+	if missing := Missing([]string{"base@1", "reeds@1", "stone@2"}); !reflect.DeepEqual(missing, []string{"stone@2"}) {
+		t.Errorf("Expected only stone@2 missing, got %v", missing)
+	}
+}
+
+func TestInstall_InvalidPackChangesNothing(t *testing.T) {
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"activities": [{"id": "juggle", "name": "Juggle", "intent_formation": "orderable", "availability": "default"}],
+		"recipes": [{"id": "iron-ball", "activity_id": "juggle", "name": "Ball",
+			"inputs": [{"item_type": "iron", "count": 1}], "output": {"item_type": "ball"}}]
+	}`)
+	if err := Install([]*Pack{p}); err == nil {
+		t.Fatal("Expected invalid pack to be rejected")
+	}
+	if _, ok := entity.ActivityRegistry["juggle"]; ok {
+		t.Error("Expected no activity registered from an invalid pack")
+	}
+}
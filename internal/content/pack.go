@@ -0,0 +1,407 @@
+// Package content loads data-driven content packs: JSON files that add or replace
+// activities, recipes, item types, item lifecycles, and construct kinds.
+//
+// The compiled-in registries are the "base" pack. The shipped packs/base.json is the
+// same content in pack form (kept in sync by a golden test) and is the template for
+// mods. User packs are discovered in ~/.petri/mods/.
+package content
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// Pack is one content pack file
+type Pack struct {
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	Version        int                     `json:"version"`
+	Activities     []ActivityDef           `json:"activities,omitempty"`
+	Recipes        []RecipeDef             `json:"recipes,omitempty"`
+	ItemTypes      map[string]ItemTypeDef  `json:"item_types,omitempty"`
+	Lifecycle      map[string]LifecycleDef `json:"lifecycle,omitempty"`
+	ConstructKinds []ConstructKindDef      `json:"construct_kinds,omitempty"`
+
+	Source string `json:"-"` // File the pack was read from (empty for built-in)
+}
+
+// Ref returns the "id@version" reference recorded in saves
+func (p *Pack) Ref() string {
+	return fmt.Sprintf("%s@%d", p.ID, p.Version)
+}
+
+// TriggerDef is the pack form of entity.DiscoveryTrigger
+type TriggerDef struct {
+	Action              string `json:"action"` // Action name, e.g. "look", "pickup" (see actionNames)
+	ItemType            string `json:"item_type,omitempty"`
+	ConstructKind       string `json:"construct_kind,omitempty"`
+	ConstructMaterial   string `json:"construct_material,omitempty"`
+	RequiresEdible      bool   `json:"requires_edible,omitempty"`
+	RequiresPlantable   bool   `json:"requires_plantable,omitempty"`
+	RequiresHarvestable bool   `json:"requires_harvestable,omitempty"`
+}
+
+// ActivityDef is the pack form of entity.Activity
+type ActivityDef struct {
+	ID                string       `json:"id"`
+	Name              string       `json:"name"`
+	Category          string       `json:"category,omitempty"`
+	IntentFormation   string       `json:"intent_formation"` // "automatic" or "orderable"
+	Availability      string       `json:"availability"`     // "default" or "knowhow"
+	DiscoveryTriggers []TriggerDef `json:"discovery_triggers,omitempty"`
+}
+
+// RecipeInputDef is the pack form of entity.RecipeInput
+type RecipeInputDef struct {
+	ItemType string `json:"item_type"`
+	Count    int    `json:"count"`
+}
+
+// RecipeOutputDef is the pack form of entity.RecipeOutput
+type RecipeOutputDef struct {
+	ItemType          string `json:"item_type"`
+	Kind              string `json:"kind,omitempty"`
+	ContainerCapacity int    `json:"container_capacity,omitempty"`
+}
+
+// RecipeDef is the pack form of entity.Recipe
+type RecipeDef struct {
+	ID                string           `json:"id"`
+	ActivityID        string           `json:"activity_id"`
+	Name              string           `json:"name"`
+	Inputs            []RecipeInputDef `json:"inputs"`
+	Output            RecipeOutputDef  `json:"output"`
+	Duration          float64          `json:"duration,omitempty"`
+	Repeatable        bool             `json:"repeatable,omitempty"`
+	DiscoveryTriggers []TriggerDef     `json:"discovery_triggers,omitempty"`
+	BundledActivities []string         `json:"bundled_activities,omitempty"`
+}
+
+// ItemTypeDef is the pack form of game.ItemTypeConfig
+type ItemTypeDef struct {
+	Colors               []string `json:"colors"`
+	Patterns             []string `json:"patterns,omitempty"`
+	Textures             []string `json:"textures,omitempty"`
+	Kind                 string   `json:"kind,omitempty"`
+	Edible               bool     `json:"edible,omitempty"`
+	CanBePoisonOrHealing bool     `json:"can_be_poison_or_healing,omitempty"`
+	Plantable            bool     `json:"plantable,omitempty"`
+	CanProduceSeeds      bool     `json:"can_produce_seeds,omitempty"`
+	Symbol               string   `json:"symbol"` // Single character
+	SpawnCount           int      `json:"spawn_count"`
+	NonPlantSpawned      bool     `json:"non_plant_spawned,omitempty"`
+}
+
+// LifecycleDef is the pack form of config.LifecycleConfig
+type LifecycleDef struct {
+	SpawnInterval float64                   `json:"spawn_interval,omitempty"`
+	Reproduction  string                    `json:"reproduction,omitempty"` // "fast", "medium" or "slow" tunable tier when spawn_interval is unset
+	DeathInterval float64                   `json:"death_interval,omitempty"`
+	Seasons       map[string]SeasonRatesDef `json:"seasons,omitempty"` // Keyed by season or "late_" + season
+}
+
+// SeasonRatesDef is the pack form of config.SeasonRates
+type SeasonRatesDef struct {
+	Spawn  float64 `json:"spawn"`
+	Sprout float64 `json:"sprout"`
+	Death  float64 `json:"death"`
+}
+
+// ConstructKindDef is the pack form of entity.ConstructKind
+type ConstructKindDef struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Passable bool   `json:"passable,omitempty"`
+}
+
+// actionNames maps trigger action names to action types.
+// Only actions that discovery can observe are listed.
+var actionNames = map[string]entity.ActionType{
+	"consume":     entity.ActionConsume,
+	"drink":       entity.ActionDrink,
+	"look":        entity.ActionLook,
+	"talk":        entity.ActionTalk,
+	"pickup":      entity.ActionPickup,
+	"craft":       entity.ActionCraft,
+	"tillSoil":    entity.ActionTillSoil,
+	"plant":       entity.ActionPlant,
+	"fillVessel":  entity.ActionFillVessel,
+	"forage":      entity.ActionForage,
+	"waterGarden": entity.ActionWaterGarden,
+	"extract":     entity.ActionExtract,
+	"dig":         entity.ActionDig,
+	"buildFence":  entity.ActionBuildFence,
+	"buildHut":    entity.ActionBuildHut,
+}
+
+// actionName returns the pack name for an action type
+func actionName(action entity.ActionType) string {
+	for name, a := range actionNames {
+		if a == action {
+			return name
+		}
+	}
+	return ""
+}
+
+// Decode parses a pack from JSON. Unknown fields are rejected so typos surface at startup.
+func Decode(data []byte, source string) (*Pack, error) {
+	var p Pack
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if p.ID == "" {
+		return nil, fmt.Errorf("%s: pack id is required", source)
+	}
+	p.Source = source
+	return &p, nil
+}
+
+// toLifecycleConfig converts a lifecycle definition, rejecting unknown season keys and negative rates
+func (d LifecycleDef) toLifecycleConfig(itemType string) (config.LifecycleConfig, error) {
+	lc := config.LifecycleConfig{SpawnInterval: d.SpawnInterval, Reproduction: d.Reproduction, DeathInterval: d.DeathInterval}
+	if d.Reproduction != "" && !config.IsReproductionTier(d.Reproduction) {
+		return config.LifecycleConfig{}, fmt.Errorf("lifecycle %s: unknown reproduction tier %q", itemType, d.Reproduction)
+	}
+	for key, r := range d.Seasons {
+		if !game.IsSeasonKey(key) {
+			return config.LifecycleConfig{}, fmt.Errorf("lifecycle %s: unknown season %q", itemType, key)
+		}
+		if r.Spawn < 0 || r.Sprout < 0 || r.Death < 0 {
+			return config.LifecycleConfig{}, fmt.Errorf("lifecycle %s: season %s has a negative rate", itemType, key)
+		}
+		if lc.Seasons == nil {
+			lc.Seasons = make(map[string]config.SeasonRates)
+		}
+		lc.Seasons[key] = config.SeasonRates{Spawn: r.Spawn, Sprout: r.Sprout, Death: r.Death}
+	}
+	return lc, nil
+}
+
+// =============================================================================
+// Conversion to registry types
+// =============================================================================
+
+// toTriggers converts trigger definitions, rejecting unknown action names
+func toTriggers(defs []TriggerDef, owner string) ([]entity.DiscoveryTrigger, error) {
+	var triggers []entity.DiscoveryTrigger
+	for _, d := range defs {
+		action, ok := actionNames[d.Action]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown action type %q", owner, d.Action)
+		}
+		triggers = append(triggers, entity.DiscoveryTrigger{
+			Action:              action,
+			ItemType:            d.ItemType,
+			ConstructKind:       d.ConstructKind,
+			ConstructMaterial:   d.ConstructMaterial,
+			RequiresEdible:      d.RequiresEdible,
+			RequiresPlantable:   d.RequiresPlantable,
+			RequiresHarvestable: d.RequiresHarvestable,
+		})
+	}
+	return triggers, nil
+}
+
+// toActivity converts an activity definition
+func (d ActivityDef) toActivity() (entity.Activity, error) {
+	owner := "activity " + d.ID
+	formation := entity.IntentFormation(d.IntentFormation)
+	if formation != entity.IntentAutomatic && formation != entity.IntentOrderable {
+		return entity.Activity{}, fmt.Errorf("%s: unknown intent formation %q", owner, d.IntentFormation)
+	}
+	availability := entity.Availability(d.Availability)
+	if availability != entity.AvailabilityDefault && availability != entity.AvailabilityKnowHow {
+		return entity.Activity{}, fmt.Errorf("%s: unknown availability %q", owner, d.Availability)
+	}
+	triggers, err := toTriggers(d.DiscoveryTriggers, owner)
+	if err != nil {
+		return entity.Activity{}, err
+	}
+	return entity.Activity{
+		ID:                d.ID,
+		Name:              d.Name,
+		Category:          d.Category,
+		IntentFormation:   formation,
+		Availability:      availability,
+		DiscoveryTriggers: triggers,
+	}, nil
+}
+
+// toRecipe converts a recipe definition
+func (d RecipeDef) toRecipe() (*entity.Recipe, error) {
+	triggers, err := toTriggers(d.DiscoveryTriggers, "recipe "+d.ID)
+	if err != nil {
+		return nil, err
+	}
+	recipe := &entity.Recipe{
+		ID:         d.ID,
+		ActivityID: d.ActivityID,
+		Name:       d.Name,
+		Output: entity.RecipeOutput{
+			ItemType:          d.Output.ItemType,
+			Kind:              d.Output.Kind,
+			ContainerCapacity: d.Output.ContainerCapacity,
+		},
+		Duration:          d.Duration,
+		Repeatable:        d.Repeatable,
+		DiscoveryTriggers: triggers,
+		BundledActivities: d.BundledActivities,
+	}
+	for _, in := range d.Inputs {
+		if in.Count < 1 {
+			return nil, fmt.Errorf("recipe %s: input %q count must be at least 1", d.ID, in.ItemType)
+		}
+		recipe.Inputs = append(recipe.Inputs, entity.RecipeInput{ItemType: in.ItemType, Count: in.Count})
+	}
+	return recipe, nil
+}
+
+// toItemTypeConfig converts an item type definition
+func (d ItemTypeDef) toItemTypeConfig(itemType string) (game.ItemTypeConfig, error) {
+	if utf8.RuneCountInString(d.Symbol) != 1 {
+		return game.ItemTypeConfig{}, fmt.Errorf("item type %s: symbol must be a single character, got %q", itemType, d.Symbol)
+	}
+	if len(d.Colors) == 0 {
+		return game.ItemTypeConfig{}, fmt.Errorf("item type %s: at least one color is required", itemType)
+	}
+	sym, _ := utf8.DecodeRuneInString(d.Symbol)
+	cfg := game.ItemTypeConfig{
+		Kind:                 d.Kind,
+		Edible:               d.Edible,
+		CanBePoisonOrHealing: d.CanBePoisonOrHealing,
+		Plantable:            d.Plantable,
+		CanProduceSeeds:      d.CanProduceSeeds,
+		Sym:                  sym,
+		SpawnCount:           d.SpawnCount,
+		NonPlantSpawned:      d.NonPlantSpawned,
+	}
+	for _, c := range d.Colors {
+		cfg.Colors = append(cfg.Colors, types.Color(c))
+	}
+	for _, p := range d.Patterns {
+		cfg.Patterns = append(cfg.Patterns, types.Pattern(p))
+	}
+	for _, t := range d.Textures {
+		cfg.Textures = append(cfg.Textures, types.Texture(t))
+	}
+	return cfg, nil
+}
+
+// =============================================================================
+// Snapshot of the current registries
+// =============================================================================
+
+// Snapshot builds a pack from the current registries. Before any packs are applied
+// this is the built-in base content. Entries are sorted by ID for stable output.
+func Snapshot(id, name string, version int) *Pack {
+	p := &Pack{
+		ID:        id,
+		Name:      name,
+		Version:   version,
+		ItemTypes: make(map[string]ItemTypeDef),
+		Lifecycle: make(map[string]LifecycleDef),
+	}
+
+	for _, a := range entity.ActivityRegistry {
+		p.Activities = append(p.Activities, ActivityDef{
+			ID:                a.ID,
+			Name:              a.Name,
+			Category:          a.Category,
+			IntentFormation:   string(a.IntentFormation),
+			Availability:      string(a.Availability),
+			DiscoveryTriggers: fromTriggers(a.DiscoveryTriggers),
+		})
+	}
+	sort.Slice(p.Activities, func(i, j int) bool { return p.Activities[i].ID < p.Activities[j].ID })
+
+	for _, r := range entity.RecipeRegistry {
+		def := RecipeDef{
+			ID:         r.ID,
+			ActivityID: r.ActivityID,
+			Name:       r.Name,
+			Output: RecipeOutputDef{
+				ItemType:          r.Output.ItemType,
+				Kind:              r.Output.Kind,
+				ContainerCapacity: r.Output.ContainerCapacity,
+			},
+			Duration:          r.Duration,
+			Repeatable:        r.Repeatable,
+			DiscoveryTriggers: fromTriggers(r.DiscoveryTriggers),
+			BundledActivities: r.BundledActivities,
+		}
+		for _, in := range r.Inputs {
+			def.Inputs = append(def.Inputs, RecipeInputDef{ItemType: in.ItemType, Count: in.Count})
+		}
+		p.Recipes = append(p.Recipes, def)
+	}
+	sort.Slice(p.Recipes, func(i, j int) bool { return p.Recipes[i].ID < p.Recipes[j].ID })
+
+	for itemType, cfg := range game.GetItemTypeConfigs() {
+		def := ItemTypeDef{
+			Kind:                 cfg.Kind,
+			Edible:               cfg.Edible,
+			CanBePoisonOrHealing: cfg.CanBePoisonOrHealing,
+			Plantable:            cfg.Plantable,
+			CanProduceSeeds:      cfg.CanProduceSeeds,
+			Symbol:               string(cfg.Sym),
+			SpawnCount:           cfg.SpawnCount,
+			NonPlantSpawned:      cfg.NonPlantSpawned,
+		}
+		for _, c := range cfg.Colors {
+			def.Colors = append(def.Colors, string(c))
+		}
+		for _, pat := range cfg.Patterns {
+			def.Patterns = append(def.Patterns, string(pat))
+		}
+		for _, t := range cfg.Textures {
+			def.Textures = append(def.Textures, string(t))
+		}
+		p.ItemTypes[itemType] = def
+	}
+
+	for itemType, lc := range config.ItemLifecycle {
+		def := LifecycleDef{SpawnInterval: lc.SpawnInterval, Reproduction: lc.Reproduction, DeathInterval: lc.DeathInterval}
+		for key, r := range lc.Seasons {
+			if def.Seasons == nil {
+				def.Seasons = make(map[string]SeasonRatesDef)
+			}
+			def.Seasons[key] = SeasonRatesDef{Spawn: r.Spawn, Sprout: r.Sprout, Death: r.Death}
+		}
+		p.Lifecycle[itemType] = def
+	}
+
+	for _, k := range entity.ConstructKindRegistry {
+		p.ConstructKinds = append(p.ConstructKinds, ConstructKindDef{Kind: k.Kind, Name: k.Name, Passable: k.Passable})
+	}
+	sort.Slice(p.ConstructKinds, func(i, j int) bool { return p.ConstructKinds[i].Kind < p.ConstructKinds[j].Kind })
+
+	return p
+}
+
+// fromTriggers converts registry triggers to pack form
+func fromTriggers(triggers []entity.DiscoveryTrigger) []TriggerDef {
+	var defs []TriggerDef
+	for _, t := range triggers {
+		defs = append(defs, TriggerDef{
+			Action:              actionName(t.Action),
+			ItemType:            t.ItemType,
+			ConstructKind:       t.ConstructKind,
+			ConstructMaterial:   t.ConstructMaterial,
+			RequiresEdible:      t.RequiresEdible,
+			RequiresPlantable:   t.RequiresPlantable,
+			RequiresHarvestable: t.RequiresHarvestable,
+		})
+	}
+	return defs
+}
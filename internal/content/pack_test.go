@@ -0,0 +1,39 @@
+package content
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite packs/base.json from the built-in registries")
+
+// TestBasePack_MatchesBuiltins keeps the shipped base pack identical to the compiled-in
+// content. Regenerate with: go test ./internal/content -run TestBasePack -update
+func TestBasePack_MatchesBuiltins(t *testing.T) {
+	want := Snapshot(BasePackID, "Petri base content", 1)
+	if *update {
+		data, err := json.MarshalIndent(want, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile("packs/base.json", append(data, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile("packs/base.json")
+	if err != nil {
+		t.Fatalf("read base pack: %v", err)
+	}
+	got, err := Decode(data, "packs/base.json")
+	if err != nil {
+		t.Fatalf("decode base pack: %v", err)
+	}
+	got.Source = ""
+	if !reflect.DeepEqual(got, want) {
+		t.Error("packs/base.json differs from the built-in registries; rerun with -update")
+	}
+}
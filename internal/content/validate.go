@@ -0,0 +1,284 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+)
+
+// terrainSources are item types obtained from terrain or activities rather than
+// item type configs, so recipes may use them as inputs
+var terrainSources = map[string]string{
+	"stick": "ground spawning",
+	"stone": "ground spawning",
+	"clay":  "dig",
+	"seed":  "extract",
+	"wood":  "carveWood",
+}
+
+// catalog is the merged content of the built-in registries and a list of packs
+type catalog struct {
+	activities     map[string]entity.Activity
+	recipes        map[string]*entity.Recipe
+	itemTypes      map[string]game.ItemTypeConfig
+	lifecycle      map[string]config.LifecycleConfig
+	constructKinds map[string]entity.ConstructKind
+}
+
+// currentCatalog copies the current registries
+func currentCatalog() *catalog {
+	c := &catalog{
+		activities:     make(map[string]entity.Activity),
+		recipes:        make(map[string]*entity.Recipe),
+		itemTypes:      game.GetItemTypeConfigs(),
+		lifecycle:      make(map[string]config.LifecycleConfig),
+		constructKinds: make(map[string]entity.ConstructKind),
+	}
+	for id, a := range entity.ActivityRegistry {
+		c.activities[id] = a
+	}
+	for id, r := range entity.RecipeRegistry {
+		c.recipes[id] = r
+	}
+	for t, lc := range config.ItemLifecycle {
+		c.lifecycle[t] = lc
+	}
+	for k, ck := range entity.ConstructKindRegistry {
+		c.constructKinds[k] = ck
+	}
+	return c
+}
+
+// overlay adds or replaces entries from a pack. Returns conversion errors
+// (unknown action types, bad enum values, malformed symbols).
+func (c *catalog) overlay(p *Pack) error {
+	var errs []error
+	for _, d := range p.Activities {
+		a, err := d.toActivity()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.activities[a.ID] = a
+	}
+	for _, d := range p.Recipes {
+		r, err := d.toRecipe()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.recipes[r.ID] = r
+	}
+	for itemType, d := range p.ItemTypes {
+		cfg, err := d.toItemTypeConfig(itemType)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.itemTypes[itemType] = cfg
+	}
+	for itemType, d := range p.Lifecycle {
+		lc, err := d.toLifecycleConfig(itemType)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.lifecycle[itemType] = lc
+	}
+	for _, d := range p.ConstructKinds {
+		c.constructKinds[d.Kind] = entity.ConstructKind{Kind: d.Kind, Name: d.Name, Passable: d.Passable}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks packs as they would be applied on top of the current registries.
+// Rejects duplicate pack IDs, unknown action types, references to unknown activities
+// or construct kinds, recipe inputs with no source, and cyclic discovery triggers.
+func Validate(packs []*Pack) error {
+	_, err := buildCatalog(packs)
+	return err
+}
+
+// buildCatalog merges packs over the current registries and validates the result
+func buildCatalog(packs []*Pack) (*catalog, error) {
+	var errs []error
+	seen := make(map[string]string)
+	c := currentCatalog()
+	for _, p := range packs {
+		if prev, ok := seen[p.ID]; ok {
+			errs = append(errs, fmt.Errorf("pack %q in %s duplicates %s", p.ID, p.Source, prev))
+			continue
+		}
+		seen[p.ID] = p.Source
+		if err := c.overlay(p); err != nil {
+			errs = append(errs, fmt.Errorf("pack %s: %w", p.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	var problems []string
+	problems = append(problems, c.checkReferences()...)
+	problems = append(problems, c.checkRecipeSources()...)
+	problems = append(problems, c.checkDiscoveryCycles()...)
+	if len(problems) > 0 {
+		// Sort for consistent ordering (maps iterate randomly)
+		sort.Strings(problems)
+		return nil, errors.New(strings.Join(problems, "\n"))
+	}
+	return c, nil
+}
+
+// checkReferences reports recipes and triggers that name unknown activities or construct kinds
+func (c *catalog) checkReferences() []string {
+	var problems []string
+	checkTriggers := func(owner string, triggers []entity.DiscoveryTrigger) {
+		for _, t := range triggers {
+			if t.ConstructKind != "" {
+				if _, ok := c.constructKinds[t.ConstructKind]; !ok {
+					problems = append(problems, fmt.Sprintf("%s: trigger references unknown construct kind %q", owner, t.ConstructKind))
+				}
+			}
+		}
+	}
+	for id, a := range c.activities {
+		checkTriggers("activity "+id, a.DiscoveryTriggers)
+	}
+	for id, r := range c.recipes {
+		if _, ok := c.activities[r.ActivityID]; !ok {
+			problems = append(problems, fmt.Sprintf("recipe %s: unknown activity %q", id, r.ActivityID))
+		}
+		for _, b := range r.BundledActivities {
+			if _, ok := c.activities[b]; !ok {
+				problems = append(problems, fmt.Sprintf("recipe %s: unknown bundled activity %q", id, b))
+			}
+		}
+		checkTriggers("recipe "+id, r.DiscoveryTriggers)
+	}
+	return problems
+}
+
+// producedItemTypes returns item types produced by crafting recipes (construct outputs excluded)
+func (c *catalog) producedItemTypes() map[string]bool {
+	produced := make(map[string]bool)
+	for _, r := range c.recipes {
+		if _, isConstruct := c.constructKinds[r.Output.ItemType]; !isConstruct {
+			produced[r.Output.ItemType] = true
+		}
+	}
+	return produced
+}
+
+// isNaturalSource reports whether an item type exists without crafting
+func (c *catalog) isNaturalSource(itemType string) bool {
+	if _, ok := c.itemTypes[itemType]; ok {
+		return true
+	}
+	_, ok := terrainSources[itemType]
+	return ok
+}
+
+// checkRecipeSources reports recipe inputs that nothing spawns or produces
+func (c *catalog) checkRecipeSources() []string {
+	var problems []string
+	produced := c.producedItemTypes()
+	for id, r := range c.recipes {
+		for _, in := range r.Inputs {
+			if !c.isNaturalSource(in.ItemType) && !produced[in.ItemType] {
+				problems = append(problems, fmt.Sprintf("recipe %s: input %q has no source (not spawned, dug, extracted, or crafted)", id, in.ItemType))
+			}
+		}
+	}
+	return problems
+}
+
+// checkDiscoveryCycles finds know-how that can never be discovered because its
+// triggers need items or constructs that only that same know-how (directly or
+// through a chain) can produce. Starting from default activities and natural
+// items, it repeatedly unlocks anything with a satisfiable trigger; whatever is
+// left with triggers is part of a cycle.
+func (c *catalog) checkDiscoveryCycles() []string {
+	known := make(map[string]bool)      // activity IDs characters can come to know
+	discovered := make(map[string]bool) // recipe IDs characters can come to know
+	obtainable := make(map[string]bool) // item types characters can come to hold
+	buildable := make(map[string]bool)  // construct kinds characters can come to see
+
+	for id, a := range c.activities {
+		if a.Availability == entity.AvailabilityDefault {
+			known[id] = true
+		}
+	}
+	for itemType := range c.itemTypes {
+		obtainable[itemType] = true
+	}
+	for itemType := range terrainSources {
+		obtainable[itemType] = true
+	}
+
+	satisfied := func(t entity.DiscoveryTrigger) bool {
+		if t.ConstructKind != "" {
+			return buildable[t.ConstructKind]
+		}
+		return t.ItemType == "" || obtainable[t.ItemType]
+	}
+	anySatisfied := func(triggers []entity.DiscoveryTrigger) bool {
+		for _, t := range triggers {
+			if satisfied(t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for id, a := range c.activities {
+			if !known[id] && anySatisfied(a.DiscoveryTriggers) {
+				known[id] = true
+				changed = true
+			}
+		}
+		for id, r := range c.recipes {
+			if !discovered[id] && anySatisfied(r.DiscoveryTriggers) {
+				discovered[id] = true
+				known[r.ActivityID] = true
+				for _, b := range r.BundledActivities {
+					known[b] = true
+				}
+				changed = true
+			}
+			if !discovered[id] || !known[r.ActivityID] {
+				continue
+			}
+			out := r.Output.ItemType
+			if _, isConstruct := c.constructKinds[out]; isConstruct {
+				if !buildable[out] {
+					buildable[out] = true
+					changed = true
+				}
+			} else if !obtainable[out] {
+				obtainable[out] = true
+				changed = true
+			}
+		}
+	}
+
+	var problems []string
+	for id, a := range c.activities {
+		if !known[id] && len(a.DiscoveryTriggers) > 0 {
+			problems = append(problems, fmt.Sprintf("activity %s: cyclic discovery triggers (every trigger needs something only undiscovered know-how produces)", id))
+		}
+	}
+	for id, r := range c.recipes {
+		if !discovered[id] && len(r.DiscoveryTriggers) > 0 {
+			problems = append(problems, fmt.Sprintf("recipe %s: cyclic discovery triggers (every trigger needs something only undiscovered know-how produces)", id))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,160 @@
+package content
+
+import (
+	"strings"
+	"testing"
+)
+
+// decodePack decodes a pack from a JSON literal
+func decodePack(t *testing.T, data string) *Pack {
+	t.Helper()
+	p, err := Decode([]byte(data), "test.json")
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return p
+}
+
+// expectInvalid fails unless Validate rejects packs with an error mentioning want
+func expectInvalid(t *testing.T, want string, packs ...*Pack) {
+	t.Helper()
+	err := Validate(packs)
+	if err == nil {
+		t.Fatalf("Expected validation error containing %q", want)
+	}
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, got: %v", want, err)
+	}
+}
+
+func TestValidate_ShippedPacksAreValid(t *testing.T) {
+	t.Parallel()
+
+	packs, err := ShippedPacks()
+	if err != nil {
+		t.Fatalf("ShippedPacks: %v", err)
+	}
+	if err := Validate(packs); err != nil {
+		t.Errorf("Expected shipped packs to validate, got: %v", err)
+	}
+}
+
+func TestValidate_AcceptsNewPlantAndRecipe(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "reeds", "version": 1,
+		"item_types": {"reed": {"colors": ["green"], "symbol": "|", "spawn_count": 10}},
+		"lifecycle": {"reed": {"spawn_interval": 18}},
+		"activities": [{"id": "weaveMat", "name": "Mat", "category": "craft", "intent_formation": "orderable", "availability": "knowhow"}],
+		"recipes": [{
+			"id": "reed-mat", "activity_id": "weaveMat", "name": "Reed Mat",
+			"inputs": [{"item_type": "reed", "count": 3}],
+			"output": {"item_type": "mat", "kind": "reed mat"},
+			"discovery_triggers": [{"action": "pickup", "item_type": "reed"}]
+		}]
+	}`)
+	if err := Validate([]*Pack{p}); err != nil {
+		t.Errorf("Expected pack to validate, got: %v", err)
+	}
+}
+
+func TestValidate_RejectsUnknownActionType(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"activities": [{"id": "juggle", "name": "Juggle", "intent_formation": "orderable", "availability": "knowhow",
+			"discovery_triggers": [{"action": "throw", "item_type": "nut"}]}]
+	}`)
+	expectInvalid(t, `unknown action type "throw"`, p)
+}
+
+func TestValidate_RejectsUnknownSeason(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"lifecycle": {"berry": {"spawn_interval": 12, "seasons": {"monsoon": {"spawn": 2, "sprout": 1, "death": 1}}}}
+	}`)
+	expectInvalid(t, `lifecycle berry: unknown season "monsoon"`, p)
+}
+
+func TestValidate_RejectsUnknownReproductionTier(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"lifecycle": {"reed": {"reproduction": "glacial"}}
+	}`)
+	expectInvalid(t, `lifecycle reed: unknown reproduction tier "glacial"`, p)
+}
+
+func TestValidate_RejectsRecipeInputWithNoSource(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"recipes": [{"id": "iron-hoe", "activity_id": "craftHoe", "name": "Iron Hoe",
+			"inputs": [{"item_type": "stick", "count": 1}, {"item_type": "iron", "count": 1}],
+			"output": {"item_type": "hoe", "kind": "iron hoe"}}]
+	}`)
+	expectInvalid(t, `recipe iron-hoe: input "iron" has no source`, p)
+}
+
+func TestValidate_RejectsCyclicDiscoveryTriggers(t *testing.T) {
+	t.Parallel()
+
+	// Smelting is discovered by looking at ingots, but only smelting makes ingots
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"activities": [{"id": "smelt", "name": "Smelt", "category": "craft", "intent_formation": "orderable", "availability": "knowhow",
+			"discovery_triggers": [{"action": "look", "item_type": "ingot"}]}],
+		"recipes": [{"id": "clay-ingot", "activity_id": "smelt", "name": "Ingot",
+			"inputs": [{"item_type": "clay", "count": 2}],
+			"output": {"item_type": "ingot"},
+			"discovery_triggers": [{"action": "pickup", "item_type": "ingot"}]}]
+	}`)
+	err := Validate([]*Pack{p})
+	if err == nil {
+		t.Fatal("Expected cyclic discovery to be rejected")
+	}
+	for _, want := range []string{"activity smelt: cyclic", "recipe clay-ingot: cyclic"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing %q, got: %v", want, err)
+		}
+	}
+}
+
+func TestValidate_RejectsUnknownReferences(t *testing.T) {
+	t.Parallel()
+
+	p := decodePack(t, `{
+		"id": "bad", "version": 1,
+		"recipes": [{"id": "wall", "activity_id": "buildWall", "name": "Wall",
+			"inputs": [{"item_type": "stick", "count": 1}],
+			"output": {"item_type": "fence"},
+			"discovery_triggers": [{"action": "look", "construct_kind": "tower"}]}]
+	}`)
+	expectInvalid(t, `unknown activity "buildWall"`, p)
+	expectInvalid(t, `unknown construct kind "tower"`, p)
+}
+
+func TestValidate_RejectsDuplicatePackIDs(t *testing.T) {
+	t.Parallel()
+
+	a := decodePack(t, `{"id": "same", "version": 1}`)
+	b := decodePack(t, `{"id": "same", "version": 2}`)
+	expectInvalid(t, `pack "same"`, a, b)
+}
+
+func TestDecode_RejectsUnknownFieldsAndMissingID(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Decode([]byte(`{"id": "x", "recipies": []}`), "typo.json"); err == nil {
+		t.Error("Expected unknown field to be rejected")
+	}
+	if _, err := Decode([]byte(`{"version": 1}`), "noid.json"); err == nil {
+		t.Error("Expected missing id to be rejected")
+	}
+}
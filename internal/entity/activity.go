@@ -1,5 +1,11 @@
 package entity
 
+import (
+	"sort"
+
+	"petri/internal/i18n"
+)
+
 // IntentFormation describes how an activity is triggered
 type IntentFormation string
 
@@ -41,6 +47,11 @@ type Activity struct {
 	DiscoveryTriggers []DiscoveryTrigger // nil for default activities
 }
 
+// DisplayName returns the activity name in the active language
+func (a Activity) DisplayName() string {
+	return i18n.Content("activity."+a.ID, a.Name)
+}
+
 // ActivityRegistry contains all defined activities
 var ActivityRegistry = map[string]Activity{
 	"eat": {
@@ -100,6 +111,14 @@ var ActivityRegistry = map[string]Activity{
 		Availability:    AvailabilityKnowHow,
 		// No DiscoveryTriggers - discovered via recipes
 	},
+	"craftChisel": {
+		ID:              "craftChisel",
+		Name:            "Chisel",
+		Category:        "craft",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		// No DiscoveryTriggers - discovered via recipes
+	},
 	"tillSoil": {
 		ID:              "tillSoil",
 		Name:            "Till Soil",
@@ -111,6 +130,28 @@ var ActivityRegistry = map[string]Activity{
 			{Action: ActionPickup, ItemType: "hoe"},
 		},
 	},
+	"digChannel": {
+		ID:              "digChannel",
+		Name:            "Dig Channel",
+		Category:        "garden",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "hoe"},
+			{Action: ActionPickup, ItemType: "hoe"},
+		},
+	},
+	"compost": {
+		ID:              "compost",
+		Name:            "Compost",
+		Category:        "garden",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		// Pickup only: looking at a shell is how the shell hoe is discovered
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionPickup, ItemType: "shell"},
+		},
+	},
 	"plant": {
 		ID:              "plant",
 		Name:            "Plant",
@@ -185,9 +226,74 @@ var ActivityRegistry = map[string]Activity{
 		Availability:    AvailabilityKnowHow,
 		// No DiscoveryTriggers - discovered via hut recipe triggers (DD-27)
 	},
+	"buildCampfire": {
+		ID:              "buildCampfire",
+		Name:            "Campfire",
+		Category:        "construction",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		// No DiscoveryTriggers - discovered via campfire recipe triggers
+	},
+	"cook": {
+		ID:              "cook",
+		Name:            "Cook",
+		Category:        "craft",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ConstructKind: "campfire"},
+		},
+	},
+	"carveWood": {
+		ID:              "carveWood",
+		Name:            "Carve Wood",
+		Category:        "woodwork",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "chisel"},
+			{Action: ActionPickup, ItemType: "chisel"},
+			{Action: ActionPickup, ItemType: "flake"},
+			{Action: ActionPickup, ItemType: "axe"},
+		},
+	},
+	"chopWood": {
+		ID:              "chopWood",
+		Name:            "Chop Wood",
+		Category:        "woodwork",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "axe"},
+			{Action: ActionPickup, ItemType: "axe"},
+		},
+	},
+	"knap": {
+		ID:              "knap",
+		Name:            "Knap",
+		Category:        "craft",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		// No DiscoveryTriggers - discovered via recipes
+	},
+	"craftAxe": {
+		ID:              "craftAxe",
+		Name:            "Axe",
+		Category:        "craft",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityKnowHow,
+		// No DiscoveryTriggers - discovered via recipes
+	},
+	"deconstruct": {
+		ID:              "deconstruct",
+		Name:            "Deconstruct",
+		IntentFormation: IntentOrderable,
+		Availability:    AvailabilityDefault,
+	},
 }
 
-// GetDiscoverableActivities returns all activities that require know-how
+// GetDiscoverableActivities returns all activities that require know-how, ordered by ID
+// so discovery rolls happen in the same order every run
 func GetDiscoverableActivities() []Activity {
 	var activities []Activity
 	for _, activity := range ActivityRegistry {
@@ -195,5 +301,6 @@ func GetDiscoverableActivities() []Activity {
 			activities = append(activities, activity)
 		}
 	}
+	sort.Slice(activities, func(i, j int) bool { return activities[i].ID < activities[j].ID })
 	return activities
 }
@@ -1,6 +1,10 @@
 package entity
 
-import "testing"
+import (
+	"testing"
+
+	"petri/internal/i18n"
+)
 
 // TestTillSoilActivity_Registered verifies tillSoil activity exists with correct properties
 func TestTillSoilActivity_Registered(t *testing.T) {
@@ -170,3 +174,19 @@ func TestCraftBrickActivity_InRegistry(t *testing.T) {
 		t.Errorf("craftBrick DiscoveryTriggers: got %d, want 0 (discovery is via recipe)", len(activity.DiscoveryTriggers))
 	}
 }
+
+// TestActivityRegistry_BuiltinsHaveMessages verifies every built-in activity and recipe has a catalog name
+func TestActivityRegistry_BuiltinsHaveMessages(t *testing.T) {
+	t.Parallel()
+
+	for id := range ActivityRegistry {
+		if !i18n.Has("activity." + id) {
+			t.Errorf("activity %q has no activity.%s message", id, id)
+		}
+	}
+	for id := range RecipeRegistry {
+		if !i18n.Has("recipe." + id) {
+			t.Errorf("recipe %q has no recipe.%s message", id, id)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"petri/internal/config"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -21,11 +22,13 @@ type Character struct {
 	Thirst      float64
 	Energy      float64
 	Mood        float64 // 0-100, higher is better (Joyful at 90+, Miserable at 0-10)
+	Warmth      float64 // 0-100, higher is better (drops in the cold, recovers when warm)
 	Poisoned    bool
 	PoisonTimer float64
 	IsDead      bool
 	IsSleeping  bool
 	AtBed       bool // true if sleeping at a leaf pile
+	IsCold      bool // true while cold outdoors (recalculated each tick, not saved)
 
 	// Frustration tracking (when needs can't be met)
 	IsFrustrated      bool
@@ -38,6 +41,9 @@ type Character struct {
 	LastLookedY   int
 	HasLastLooked bool // Whether LastLookedX/Y are valid
 
+	// Threat response
+	FearCooldown float64 // Time until threats are noticed again after fleeing or shooing
+
 	// Talking activity tracking
 	TalkingWith *Character // Current conversation partner (nil if not talking)
 	TalkTimer   float64    // Time remaining in conversation
@@ -91,8 +97,9 @@ type Intent struct {
 	TargetFeature   *Feature        // The specific feature being pursued (nil if none)
 	TargetConstruct *Construct      // The specific construct being looked at (nil if none, ephemeral)
 	TargetWaterPos  *types.Position // Water tile being targeted for drinking (nil if none)
-	TargetBuildPos  *types.Position // Fence tile being targeted for construction (nil if none, ephemeral)
+	TargetBuildPos  *types.Position // Construct tile being built or dismantled (nil if none, ephemeral)
 	TargetCharacter *Character      // The character being pursued for talking (nil if none)
+	TargetCreature  *Creature       // The creature being fled from or shooed away (nil if none)
 	RecipeID        string          // Recipe to craft (for ActionCraft)
 	DrivingStat     types.StatType  // Which stat is driving this intent
 	DrivingTier     int             // The urgency tier when intent was set
@@ -109,19 +116,31 @@ const (
 	ActionSleep
 	ActionLook
 	ActionTalk
-	ActionPickup      // Picking up an item (used by harvest orders and order prerequisites)
-	ActionCraft       // Crafting an item (uses ActionProgress with Recipe.Duration)
-	ActionTillSoil    // Tilling a marked tile (uses ActionProgress with ActionDurationMedium)
-	ActionPlant       // Planting a plantable item on tilled soil (uses ActionProgress with ActionDurationMedium)
-	ActionFillVessel  // Filling a vessel with water at water terrain (self-managing, uses RunVesselProcurement)
-	ActionForage      // Foraging food items, optionally picking up vessel first (self-managing, uses RunVesselProcurement)
-	ActionWaterGarden // Watering dry tilled planted tiles (self-managing, consumes vessel water)
-	ActionHelpFeed    // Delivering food to a character in crisis hunger (self-managing, idle override)
-	ActionHelpWater   // Delivering water to a character in crisis thirst (self-managing, idle override)
-	ActionExtract     // Extracting seeds from a living plant (ordered, walk-then-act)
-	ActionDig         // Digging material from terrain (ordered, walk-then-act)
-	ActionBuildFence  // Building a fence on a marked tile (ordered, walk-then-act)
-	ActionBuildHut    // Building a hut wall/door on a marked tile (ordered, walk-then-act)
+	ActionPickup        // Picking up an item (used by harvest orders and order prerequisites)
+	ActionCraft         // Crafting an item (uses ActionProgress with Recipe.Duration)
+	ActionTillSoil      // Tilling a marked tile (uses ActionProgress with ActionDurationMedium)
+	ActionPlant         // Planting a plantable item on tilled soil (uses ActionProgress with ActionDurationMedium)
+	ActionFillVessel    // Filling a vessel with water at water terrain (self-managing, uses RunVesselProcurement)
+	ActionForage        // Foraging food items, optionally picking up vessel first (self-managing, uses RunVesselProcurement)
+	ActionWaterGarden   // Watering dry tilled planted tiles (self-managing, consumes vessel water)
+	ActionHelpFeed      // Delivering food to a character in crisis hunger (self-managing, idle override)
+	ActionHelpWater     // Delivering water to a character in crisis thirst (self-managing, idle override)
+	ActionExtract       // Extracting seeds from a living plant (ordered, walk-then-act)
+	ActionDig           // Digging material from terrain (ordered, walk-then-act)
+	ActionBuildFence    // Building a fence on a marked tile (ordered, walk-then-act)
+	ActionBuildHut      // Building a hut wall/door on a marked tile (ordered, walk-then-act)
+	ActionFlee          // Fleeing a threat toward shelter (threat response, preempts needs)
+	ActionShoo          // Chasing a threatening creature away (threat response, walk-then-act)
+	ActionDeconstruct   // Dismantling a construct marked for deconstruction (ordered, walk-then-act)
+	ActionWarmUp        // Walking into a hut and staying to warm up (need: warmth, walk-then-wait)
+	ActionCarve         // Carving a tree tile marked for carving (ordered, walk-then-act)
+	ActionDigChannel    // Digging or clearing an irrigation channel tile (ordered, walk-then-act)
+	ActionCompost       // Working a shell into tilled soil (ordered, walk-then-act)
+	ActionBuildCampfire // Building or feeding a campfire (ordered, walk-then-act)
+	ActionCook          // Cooking food beside a lit campfire (ordered, walk-then-act)
+	ActionExtinguish    // Putting out a burning tile with vessel water (self-managing, idle override)
+	ActionChop          // Chopping down a tree tile marked for chopping (ordered, walk-then-act)
+	ActionShelter       // Walking indoors to wait out a storm (recalculated each tick while the storm lasts, walk-then-wait)
 )
 
 // NewCharacter creates a new character with the given preferences
@@ -147,7 +166,8 @@ func NewCharacter(id, x, y int, name, food string, color types.Color) *Character
 		Thirst:          50,
 		Energy:          100,
 		Mood:            50, // Neutral mood
-		CurrentActivity: "Idle",
+		Warmth:          100,
+		CurrentActivity: i18n.T("doing.idle"),
 	}
 }
 
@@ -300,15 +320,15 @@ func (c *Character) HungerLevel() string {
 // StatusText returns a human-readable status description
 func (c *Character) StatusText() string {
 	if c.IsDead {
-		return "DEAD"
+		return i18n.T("status.dead")
 	}
 	if c.IsSleeping {
-		return "SLEEPING"
+		return i18n.T("status.sleeping")
 	}
 	if c.Poisoned {
-		return "POISONED"
+		return i18n.T("status.poisoned")
 	}
-	return "Healthy"
+	return i18n.T("status.healthy")
 }
 
 // ThirstLevel returns a human-readable thirst description
@@ -321,6 +341,11 @@ func (c *Character) EnergyLevel() string {
 	return energyLevels.forTier(c.EnergyTier())
 }
 
+// WarmthLevel returns a human-readable warmth description
+func (c *Character) WarmthLevel() string {
+	return warmthLevels.forTier(c.WarmthTier())
+}
+
 // HealthLevel returns a human-readable health description
 func (c *Character) HealthLevel() string {
 	return healthLevels.forTier(c.HealthTier())
@@ -377,7 +402,7 @@ type StatThresholds struct {
 	Inverted bool // true if lower values are worse (energy, health)
 }
 
-// StatLevels defines human-readable descriptions for each tier
+// StatLevels defines the catalog keys of the human-readable descriptions for each tier
 type StatLevels struct {
 	None     string
 	Mild     string
@@ -393,14 +418,38 @@ var (
 	energyThresholds = StatThresholds{50, 25, 10, 0, true}
 	healthThresholds = StatThresholds{75, 50, 25, 10, true}
 	moodThresholds   = StatThresholds{89, 64, 34, 10, true} // Inverted: lower is worse
-
-	hungerLevels = StatLevels{"Not Hungry", "Hungry", "Very Hungry", "Ravenous", "Starving"}
-	thirstLevels = StatLevels{"Hydrated", "Thirsty", "Very Thirsty", "Parched", "Dehydrated"}
-	energyLevels = StatLevels{"Rested", "Tired", "Very Tired", "Exhausted", "Collapsed"}
-	healthLevels = StatLevels{"Healthy", "Poor", "Very Poor", "Critical", "Dying"}
-	moodLevels   = StatLevels{"Joyful", "Happy", "Neutral", "Unhappy", "Miserable"}
+	warmthThresholds = StatThresholds{50, 25, 10, 0, true}
+
+	hungerLevels = levelKeys("hunger")
+	thirstLevels = levelKeys("thirst")
+	energyLevels = levelKeys("energy")
+	healthLevels = levelKeys("health")
+	moodLevels   = levelKeys("mood")
+	warmthLevels = levelKeys("warmth")
 )
 
+// levelKeys returns the catalog keys for a stat's tier descriptions ("level.hunger.none" ... "level.hunger.crisis")
+func levelKeys(stat string) StatLevels {
+	prefix := "level." + stat + "."
+	return StatLevels{prefix + "none", prefix + "mild", prefix + "moderate", prefix + "severe", prefix + "crisis"}
+}
+
+// TierID returns the stable identifier of a tier ("none" ... "crisis"), used in catalog keys
+func TierID(tier int) string {
+	switch tier {
+	case TierCrisis:
+		return "crisis"
+	case TierSevere:
+		return "severe"
+	case TierModerate:
+		return "moderate"
+	case TierMild:
+		return "mild"
+	default:
+		return "none"
+	}
+}
+
 // calculateTier returns the urgency tier for a value given thresholds
 func calculateTier(value float64, t StatThresholds) int {
 	if t.Inverted {
@@ -431,19 +480,19 @@ func calculateTier(value float64, t StatThresholds) int {
 	}
 }
 
-// levelForTier returns the description for a given tier
+// levelForTier returns the description for a given tier in the active language
 func (l StatLevels) forTier(tier int) string {
 	switch tier {
 	case TierCrisis:
-		return l.Crisis
+		return i18n.T(l.Crisis)
 	case TierSevere:
-		return l.Severe
+		return i18n.T(l.Severe)
 	case TierModerate:
-		return l.Moderate
+		return i18n.T(l.Moderate)
 	case TierMild:
-		return l.Mild
+		return i18n.T(l.Mild)
 	default:
-		return l.None
+		return i18n.T(l.None)
 	}
 }
 
@@ -467,6 +516,11 @@ func (c *Character) HealthTier() int {
 	return calculateTier(c.Health, healthThresholds)
 }
 
+// WarmthTier returns the urgency tier for warmth
+func (c *Character) WarmthTier() int {
+	return calculateTier(c.Warmth, warmthThresholds)
+}
+
 // MoodTier returns the tier for mood (0=Joyful, 4=Miserable)
 func (c *Character) MoodTier() int {
 	return calculateTier(c.Mood, moodThresholds)
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"petri/internal/config"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -10,12 +11,28 @@ type Construct struct {
 	BaseEntity
 	ID            int
 	ConstructType string      // "structure", future: "furniture"
-	Kind          string      // "fence", "hut"
+	Kind          string      // "fence", "hut", "campfire"
 	Material      string      // ItemType of material: "grass", "stick", "brick"
 	MaterialColor types.Color // rendering color
 	Passable      bool
-	Movable       bool   // false for structures, true for future furniture
-	WallRole      string // semantic role for hut constructs: "wall" or "door" (visual symbol computed at render time from adjacency)
+	Movable       bool        // false for structures, true for future furniture
+	WallRole      string      // semantic role for hut constructs: "wall" or "door" (visual symbol computed at render time from adjacency)
+	Durability    *Durability // nil if the material never wears
+	Fuel          float64     // seconds of burning left for a campfire; 0 means the fire is out
+}
+
+// ConstructKind defines a kind of construct that construction recipes can build
+type ConstructKind struct {
+	Kind     string // e.g., "fence", "hut" — matches Construct.Kind and recipe Output.ItemType
+	Name     string // Display name
+	Passable bool   // Default passability (hut doors are passable regardless)
+}
+
+// ConstructKindRegistry contains all defined construct kinds
+var ConstructKindRegistry = map[string]ConstructKind{
+	"fence":    {Kind: "fence", Name: "Fence"},
+	"hut":      {Kind: "hut", Name: "Hut"},
+	"campfire": {Kind: "campfire", Name: "Campfire"},
 }
 
 // NewFence creates a new fence construct at the given position with the specified material
@@ -33,6 +50,7 @@ func NewFence(x, y int, material string, materialColor types.Color) *Construct {
 		MaterialColor: materialColor,
 		Passable:      false,
 		Movable:       false,
+		Durability:    NewDurability(material),
 	}
 }
 
@@ -58,9 +76,63 @@ func NewHutConstruct(x, y int, material string, materialColor types.Color, wallR
 		Passable:      wallRole == "door",
 		Movable:       false,
 		WallRole:      wallRole,
+		Durability:    NewDurability(material),
+	}
+}
+
+// NewCampfire creates an unlit campfire at the given position. Fuel is added by feeding it sticks.
+func NewCampfire(x, y int) *Construct {
+	return &Construct{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharCampfire,
+			EType: TypeConstruct,
+		},
+		ConstructType: "structure",
+		Kind:          "campfire",
+		Material:      "stick",
+		MaterialColor: types.ColorGray,
+		Passable:      false,
+		Movable:       false,
 	}
 }
 
+// IsCampfire returns true if the construct is a campfire
+func (c *Construct) IsCampfire() bool {
+	return c.Kind == "campfire"
+}
+
+// IsLit returns true if the construct is a campfire with fuel left
+func (c *Construct) IsLit() bool {
+	return c.IsCampfire() && c.Fuel > 0
+}
+
+// AddFuel adds seconds of burning to a campfire, up to capacity, and colors it as lit
+func (c *Construct) AddFuel(amount, capacity float64) {
+	c.Fuel = min(c.Fuel+amount, capacity)
+	c.MaterialColor = types.ColorOrange
+}
+
+// BurnFuel burns delta seconds of a lit campfire's fuel. Returns true if the fire went out.
+func (c *Construct) BurnFuel(delta float64) bool {
+	if !c.IsLit() {
+		return false
+	}
+	c.Fuel -= delta
+	if c.Fuel > 0 {
+		return false
+	}
+	c.PutOut()
+	return true
+}
+
+// PutOut puts a campfire out, leaving it ready to be fed again
+func (c *Construct) PutOut() {
+	c.Fuel = 0
+	c.MaterialColor = types.ColorGray
+}
+
 // IsPassable returns whether characters can walk onto this construct
 func (c *Construct) IsPassable() bool {
 	return c.Passable
@@ -68,34 +140,30 @@ func (c *Construct) IsPassable() bool {
 
 // DisplayName returns a formatted name like "Stick Fence", "Stick Hut Wall", or "Stick Hut Door"
 func (c *Construct) DisplayName() string {
-	materialDisplay := c.Material
-	switch c.Material {
-	case "grass":
-		materialDisplay = "Thatch"
-	case "stick":
-		materialDisplay = "Stick"
-	case "brick":
-		materialDisplay = "Brick"
+	material := c.Material
+	if len(material) > 0 {
+		material = string(material[0]-32) + material[1:] // capitalize first letter
 	}
+	material = i18n.Or("construct.material."+c.Material, material)
 	if c.Kind == "hut" {
 		if c.WallRole == "door" {
-			return materialDisplay + " Hut Door"
+			return i18n.T("construct.hut_door", material)
 		}
-		return materialDisplay + " Hut Wall"
+		return i18n.T("construct.hut_wall", material)
+	}
+	if c.Kind == "campfire" {
+		return i18n.T("construct.campfire")
 	}
 	kind := c.Kind
 	if len(kind) > 0 {
 		kind = string(kind[0]-32) + kind[1:] // capitalize first letter
 	}
-	return materialDisplay + " " + kind
+	return i18n.Or("construct."+c.Kind, material+" "+kind, material)
 }
 
 // Description returns the construct type capitalized
 func (c *Construct) Description() string {
-	if c.ConstructType == "structure" {
-		return "Structure"
-	}
-	return c.ConstructType
+	return i18n.Or("construct.type."+c.ConstructType, c.ConstructType)
 }
 
 // PreferenceKind returns the lowercase composed identity for preference matching.
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"petri/internal/config"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// Creature is a wild animal with its own needs loop. Creatures aren't directed by
+// the player: they wander in from a map edge, eat and drink, and leave again.
+type Creature struct {
+	BaseEntity
+	ID   int
+	Kind string // CreatureKindRegistry key, e.g. "rabbit"
+
+	// Survival stats (0-100, same scale as characters)
+	Hunger     float64
+	Thirst     float64
+	Energy     float64
+	IsSleeping bool
+
+	// Visit
+	StayTimer float64 // seconds until the creature heads for the map edge
+	Leaving   bool    // walking to the edge to leave the map
+
+	// Action state
+	CurrentActivity  string
+	ActionProgress   float64
+	SpeedAccumulator float64
+}
+
+// CreatureKind defines a kind of wild creature
+type CreatureKind struct {
+	Kind          string      // e.g., "rabbit" — matches Creature.Kind
+	Name          string      // Display name
+	Symbol        rune        // Map symbol
+	Color         types.Color // Rendering color
+	Speed         int         // Movement speed (same 0-100 scale as characters)
+	Foods         []string    // Item types the creature eats
+	PrefersTilled bool        // Prefers growing plants on tilled soil over wild ones
+	Threat        int         // How threatening the creature is to characters (0 = harmless, tier scale)
+}
+
+// CreatureKindRegistry contains all defined creature kinds
+var CreatureKindRegistry = map[string]CreatureKind{
+	"rabbit": {
+		Kind:          "rabbit",
+		Name:          "Rabbit",
+		Symbol:        config.CharRabbit,
+		Color:         types.ColorBrown,
+		Speed:         60,
+		Foods:         []string{"berry", "flower", "grass", "gourd"},
+		PrefersTilled: true,
+		Threat:        TierMild,
+	},
+}
+
+// NewCreature creates a creature of the given kind at the given position.
+// Needs start at zero; callers set them for the creature's arrival.
+func NewCreature(x, y int, kind string) *Creature {
+	def := CreatureKindRegistry[kind]
+	return &Creature{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   def.Symbol,
+			EType: TypeCreature,
+		},
+		Kind:            kind,
+		Energy:          100,
+		StayTimer:       config.CreatureStayDuration,
+		CurrentActivity: i18n.T("doing.idle"),
+	}
+}
+
+// Def returns the creature's kind definition
+func (c *Creature) Def() CreatureKind {
+	return CreatureKindRegistry[c.Kind]
+}
+
+// DisplayName returns the creature's kind name in the active language (e.g. "Rabbit")
+func (c *Creature) DisplayName() string {
+	return i18n.Content("creature."+c.Kind, c.Def().Name)
+}
+
+// Eats returns true if the creature eats items of the given type
+func (c *Creature) Eats(item *Item) bool {
+	for _, food := range c.Def().Foods {
+		if item.ItemType == food {
+			return true
+		}
+	}
+	return false
+}
+
+// HungerTier returns the urgency tier for hunger
+func (c *Creature) HungerTier() int {
+	return calculateTier(c.Hunger, hungerThresholds)
+}
+
+// ThirstTier returns the urgency tier for thirst
+func (c *Creature) ThirstTier() int {
+	return calculateTier(c.Thirst, thirstThresholds)
+}
+
+// EnergyTier returns the urgency tier for energy
+func (c *Creature) EnergyTier() int {
+	return calculateTier(c.Energy, energyThresholds)
+}
+
+// HungerLevel returns a human-readable hunger description
+func (c *Creature) HungerLevel() string {
+	return hungerLevels.forTier(c.HungerTier())
+}
+
+// ThirstLevel returns a human-readable thirst description
+func (c *Creature) ThirstLevel() string {
+	return thirstLevels.forTier(c.ThirstTier())
+}
+
+// EnergyLevel returns a human-readable energy description
+func (c *Creature) EnergyLevel() string {
+	return energyLevels.forTier(c.EnergyTier())
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+func TestNewCreature_RabbitDefaults(t *testing.T) {
+	t.Parallel()
+
+	c := NewCreature(3, 4, "rabbit")
+	if c.Pos() != (types.Position{X: 3, Y: 4}) {
+		t.Errorf("Pos: got %v", c.Pos())
+	}
+	if c.Symbol() != config.CharRabbit || c.Type() != TypeCreature {
+		t.Errorf("Expected rabbit symbol and creature type, got %c, %d", c.Symbol(), c.Type())
+	}
+	if c.Energy != 100 || c.StayTimer != config.CreatureStayDuration {
+		t.Errorf("Expected full energy and default stay, got %.1f, %.1f", c.Energy, c.StayTimer)
+	}
+}
+
+func TestCreature_Eats(t *testing.T) {
+	t.Parallel()
+
+	c := NewCreature(0, 0, "rabbit")
+	if !c.Eats(NewBerry(0, 0, types.ColorRed, false, false)) {
+		t.Error("Expected rabbits to eat berries")
+	}
+	if c.Eats(NewMushroom(0, 0, types.ColorBrown, types.PatternNone, types.TextureNone, false, false)) {
+		t.Error("Expected rabbits not to eat mushrooms")
+	}
+}
+
+// Not parallel: SetLanguage mutates global state
+func TestCreature_DisplayName_Spanish(t *testing.T) {
+	t.Cleanup(func() { i18n.SetLanguage(i18n.DefaultLanguage) })
+
+	c := NewCreature(0, 0, "rabbit")
+	if got := c.DisplayName(); got != "Rabbit" {
+		t.Errorf("en: got %q, want Rabbit", got)
+	}
+	if err := i18n.SetLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.DisplayName(); got != "Conejo" {
+		t.Errorf("es: got %q, want Conejo", got)
+	}
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"math"
+
+	"petri/internal/config"
+)
+
+// Durability tracks wear on a construct or crafted item. Current counts down from
+// Max through age and damage; at zero the thing breaks. A nil *Durability means
+// the thing never wears (natural items, features, materials not in MaterialDurability).
+type Durability struct {
+	Current float64
+	Max     float64
+}
+
+// NewDurability returns full durability for things made of the given material,
+// or nil if the material never wears
+func NewDurability(material string) *Durability {
+	max, ok := config.MaterialDurability[material]
+	if !ok {
+		return nil
+	}
+	return &Durability{Current: max, Max: max}
+}
+
+// Damage reduces durability, returning true if it is now broken
+func (d *Durability) Damage(amount float64) bool {
+	d.Current -= amount
+	if d.Current < 0 {
+		d.Current = 0
+	}
+	return d.IsBroken()
+}
+
+// IsBroken returns true once durability has worn to zero
+func (d *Durability) IsBroken() bool {
+	return d.Current <= 0
+}
+
+// Percent returns the remaining durability as a whole percentage (rounded up, so only broken things show 0%)
+func (d *Durability) Percent() int {
+	if d.Max <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Current / d.Max * 100))
+}
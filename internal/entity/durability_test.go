@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+
+	"petri/internal/types"
+)
+
+func TestNewDurability_MaterialOrder(t *testing.T) {
+	t.Parallel()
+
+	brick, stick, thatch := NewDurability("brick"), NewDurability("stick"), NewDurability("grass")
+	if brick == nil || stick == nil || thatch == nil {
+		t.Fatal("Expected construction materials to have durability")
+	}
+	if !(brick.Max > stick.Max && stick.Max > thatch.Max) {
+		t.Errorf("Expected brick > stick > thatch, got %.0f, %.0f, %.0f", brick.Max, stick.Max, thatch.Max)
+	}
+	if brick.Current != brick.Max {
+		t.Errorf("Expected new durability at full, got %.0f/%.0f", brick.Current, brick.Max)
+	}
+	if NewDurability("clay") != nil {
+		t.Error("Expected materials without a durability entry to never wear")
+	}
+}
+
+func TestDurability_DamageAndPercent(t *testing.T) {
+	t.Parallel()
+
+	d := &Durability{Current: 100, Max: 200}
+	if got := d.Percent(); got != 50 {
+		t.Errorf("Percent() = %d, want 50", got)
+	}
+	if d.Damage(99.5) {
+		t.Error("Expected durability with 0.5 left not to be broken")
+	}
+	if got := d.Percent(); got != 1 {
+		t.Errorf("Percent() = %d, want 1 (rounded up while intact)", got)
+	}
+	if !d.Damage(10) || d.Current != 0 {
+		t.Errorf("Expected durability to break and clamp at 0, got %.1f", d.Current)
+	}
+}
+
+func TestDurability_ConstructsAndToolsWear(t *testing.T) {
+	t.Parallel()
+
+	if NewFence(0, 0, "stick", types.ColorBrown).Durability == nil {
+		t.Error("Expected stick fence to have durability")
+	}
+	if NewHutConstruct(0, 0, "brick", types.ColorTerracotta, "wall").Durability == nil {
+		t.Error("Expected brick hut wall to have durability")
+	}
+	if NewHoe(0, 0, types.ColorSilver).Durability == nil || NewVessel(0, 0, "hollow gourd", "gourd").Durability == nil {
+		t.Error("Expected crafted tools and vessels to have durability")
+	}
+	if NewBerry(0, 0, types.ColorRed, false, false).Durability != nil || NewBrick(0, 0).Durability != nil {
+		t.Error("Expected natural items and materials never to wear")
+	}
+}
@@ -10,6 +10,7 @@ const (
 	TypeItem
 	TypeFeature
 	TypeConstruct
+	TypeCreature
 )
 
 // Entity is the base interface for all game objects
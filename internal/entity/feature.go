@@ -1,6 +1,10 @@
 package entity
 
-import "petri/internal/config"
+import (
+	"petri/internal/config"
+
+	"petri/internal/i18n"
+)
 
 // FeatureType identifies different landscape features
 type FeatureType int
@@ -71,10 +75,10 @@ func (f *Feature) IsPassable() bool {
 func (f *Feature) Description() string {
 	switch f.FType {
 	case FeatureSpring:
-		return "spring"
+		return i18n.T("feature.spring")
 	case FeatureLeafPile:
-		return "leaf pile"
+		return i18n.T("feature.leaf_pile")
 	default:
-		return "feature"
+		return i18n.T("feature.other")
 	}
 }
@@ -1,18 +1,11 @@
 package entity
 
 import (
-	"fmt"
-
 	"petri/internal/config"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
-// bundlePluralName maps bundleable item types to their plural form for display.
-// Only needed for types without Kind set — types with Kind use Pluralize(Kind) instead.
-var bundlePluralName = map[string]string{
-	"stick": "sticks",
-}
-
 // PlantProperties contains properties specific to growing plants
 type PlantProperties struct {
 	IsGrowing   bool    // Can reproduce at location (gates spawning)
@@ -38,6 +31,7 @@ type ContainerData struct {
 type EdibleProperties struct {
 	Poisonous bool
 	Healing   bool
+	Cooked    bool // roasted over a campfire: no longer poisonous, more filling
 }
 
 // Item represents an item in the game world
@@ -77,6 +71,9 @@ type Item struct {
 
 	// Lifecycle
 	DeathTimer float64 // countdown until death (0 = immortal)
+
+	// Wear for crafted tools and vessels (nil for items that never wear)
+	Durability *Durability
 }
 
 // IsEdible returns true if this item can be consumed
@@ -94,6 +91,21 @@ func (i *Item) IsHealing() bool {
 	return i.Edible != nil && i.Edible.Healing
 }
 
+// IsCooked returns true if this item is edible and has been cooked
+func (i *Item) IsCooked() bool {
+	return i.Edible != nil && i.Edible.Cooked
+}
+
+// Cook roasts an edible item: it stops being poisonous and can no longer be planted
+func (i *Item) Cook() {
+	if i.Edible == nil {
+		return
+	}
+	i.Edible.Cooked = true
+	i.Edible.Poisonous = false
+	i.Plantable = false
+}
+
 // NewBerry creates a new berry item
 func NewBerry(x, y int, color types.Color, poisonous, healing bool) *Item {
 	return &Item{
@@ -242,6 +254,7 @@ func NewVessel(x, y int, kind, material string) *Item {
 			Capacity: 1,
 			Contents: []Stack{},
 		},
+		Durability: NewDurability(material),
 	}
 }
 
@@ -309,10 +322,92 @@ func NewHoe(x, y int, color types.Color) *Item {
 			Sym:   config.CharHoe,
 			EType: TypeItem,
 		},
-		ItemType: "hoe",
-		Kind:     "shell hoe",
-		Material: "shell",
-		Color:    color,
+		ItemType:   "hoe",
+		Kind:       "shell hoe",
+		Material:   "shell",
+		Color:      color,
+		Durability: NewDurability("shell"),
+	}
+}
+
+// NewChisel creates a new chisel item (non-edible, non-plant, crafted from a shell)
+func NewChisel(x, y int, color types.Color) *Item {
+	return &Item{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharChisel,
+			EType: TypeItem,
+		},
+		ItemType:   "chisel",
+		Kind:       "shell chisel",
+		Material:   "shell",
+		Color:      color,
+		Durability: NewDurability("shell"),
+	}
+}
+
+// NewWood creates a new wood item (non-edible, non-plant, carved out of a tree)
+func NewWood(x, y int) *Item {
+	return &Item{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharWood,
+			EType: TypeItem,
+		},
+		Name:     "piece of wood",
+		ItemType: "wood",
+		Color:    types.ColorBrown,
+	}
+}
+
+// NewStone creates a new stone item (non-edible, non-plant, found beside rock outcrops)
+func NewStone(x, y int) *Item {
+	return &Item{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharStone,
+			EType: TypeItem,
+		},
+		Name:     "stone",
+		ItemType: "stone",
+		Color:    types.ColorGray,
+	}
+}
+
+// NewFlake creates a new stone flake (non-edible, non-plant, knapped from a stone)
+func NewFlake(x, y int) *Item {
+	return &Item{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharFlake,
+			EType: TypeItem,
+		},
+		ItemType:   "flake",
+		Kind:       "stone flake",
+		Material:   "stone",
+		Color:      types.ColorGray,
+		Durability: NewDurability("stone"),
+	}
+}
+
+// NewAxe creates a new stone axe (non-edible, non-plant, crafted from stick + stone)
+func NewAxe(x, y int) *Item {
+	return &Item{
+		BaseEntity: BaseEntity{
+			X:     x,
+			Y:     y,
+			Sym:   config.CharAxe,
+			EType: TypeItem,
+		},
+		ItemType:   "axe",
+		Kind:       "stone axe",
+		Material:   "stone",
+		Color:      types.ColorGray,
+		Durability: NewDurability("stone"),
 	}
 }
 
@@ -322,49 +417,25 @@ func NewHoe(x, y int, color types.Color) *Item {
 // Kind is used when present (crafted items), ItemType as fallback (natural items).
 // e.g., "silver shell hoe", "warty spotted green hollow gourd", "red berry"
 func (i *Item) Description() string {
-	if i.BundleCount >= 2 {
-		var plural string
-		if i.Kind != "" {
-			plural = Pluralize(i.Kind)
-		} else {
-			plural = bundlePluralName[i.ItemType]
-			if plural == "" {
-				plural = i.ItemType + "s"
-			}
-		}
-		return fmt.Sprintf("bundle of %s (%d)", plural, i.BundleCount)
+	noun := i.ItemType
+	if i.Kind != "" {
+		noun = i.Kind
 	}
 
-	if i.Name != "" {
-		return i.Name
+	if i.BundleCount >= 2 {
+		return i18n.T("item.bundle", Pluralize(noun), i.BundleCount)
 	}
 
-	var parts []string
-
-	if i.Texture != types.TextureNone {
-		parts = append(parts, string(i.Texture))
-	}
-	if i.Pattern != types.PatternNone {
-		parts = append(parts, string(i.Pattern))
-	}
-	if i.Color != "" {
-		parts = append(parts, string(i.Color))
-	}
-	if i.Kind != "" {
-		parts = append(parts, i.Kind)
-	} else {
-		parts = append(parts, i.ItemType)
+	if i.Name != "" {
+		return itemNoun(i.Name, 1)
 	}
 
-	result := ""
-	for i, part := range parts {
-		if i > 0 {
-			result += " "
-		}
-		result += part
-	}
+	result := describeItem(i.Texture, i.Pattern, i.Color, itemNoun(noun, 1))
 	if i.Plant != nil && i.Plant.IsSprout {
-		result += " sprout"
+		result = i18n.T("item.sprout", result)
+	}
+	if i.IsCooked() {
+		result = i18n.T("item.cooked", result)
 	}
 	return result
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"petri/internal/config"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -826,3 +827,26 @@ func TestItem_Description_BrickStillWorks(t *testing.T) {
 		t.Errorf("Brick Description(): got %q, want %q", got, "brick")
 	}
 }
+
+// TestItem_Description_Spanish verifies descriptions follow the active language's word order
+// Not parallel: SetLanguage mutates global state
+func TestItem_Description_Spanish(t *testing.T) {
+	if err := i18n.SetLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { i18n.SetLanguage(i18n.DefaultLanguage) })
+
+	tests := []struct {
+		item     *Item
+		expected string
+	}{
+		{NewBerry(0, 0, types.ColorRed, false, false), "baya de color rojo"},
+		{NewMushroom(0, 0, types.ColorBrown, types.PatternSpotted, types.TextureSlimy, false, false), "seta de color marrón con manchas de textura viscosa"},
+		{&Item{ItemType: "stick", BundleCount: 3}, "manojo de palos (3)"},
+	}
+	for _, tc := range tests {
+		if got := tc.item.Description(); got != tc.expected {
+			t.Errorf("Description(): got %q, want %q", got, tc.expected)
+		}
+	}
+}
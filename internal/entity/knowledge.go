@@ -1,8 +1,11 @@
 package entity
 
 import (
+	"unicode"
+	"unicode/utf8"
+
+	"petri/internal/i18n"
 	"petri/internal/types"
-	"strings"
 )
 
 // KnowledgeCategory represents the type of knowledge about an item
@@ -49,25 +52,12 @@ func NewKnowledgeFromVariety(v *ItemVariety, category KnowledgeCategory) Knowled
 // Format: "[Texture] [pattern] [color] [itemType]s are [category]"
 // First letter is capitalized
 func (k Knowledge) Description() string {
-	var parts []string
-
-	if k.Texture != types.TextureNone && k.Texture != "" {
-		parts = append(parts, string(k.Texture))
-	}
-	if k.Pattern != types.PatternNone && k.Pattern != "" {
-		parts = append(parts, string(k.Pattern))
-	}
-	if k.Color != "" {
-		parts = append(parts, string(k.Color))
-	}
-	parts = append(parts, Pluralize(k.ItemType))
-
-	description := strings.Join(parts, " ")
-	description += " are " + string(k.Category)
+	items := describeItem(k.Texture, k.Pattern, k.Color, Pluralize(k.ItemType))
+	description := i18n.T("knowledge."+string(k.Category), items)
 
 	// Capitalize first letter
-	if len(description) > 0 {
-		description = strings.ToUpper(string(description[0])) + description[1:]
+	if r, size := utf8.DecodeRuneInString(description); size > 0 {
+		description = string(unicode.ToUpper(r)) + description[size:]
 	}
 
 	return description
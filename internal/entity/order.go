@@ -1,6 +1,10 @@
 package entity
 
-import "strings"
+import (
+	"strings"
+
+	"petri/internal/i18n"
+)
 
 // OrderStatus represents the current state of an order
 type OrderStatus string
@@ -41,41 +45,34 @@ func (o *Order) DisplayName() string {
 	if !ok {
 		return o.ActivityID + " " + o.TargetType
 	}
+	name := activity.DisplayName()
 	switch activity.Category {
 	case "craft":
-		return "Craft " + strings.ToLower(activity.Name)
+		if o.ActivityID == "knap" {
+			return name // Knapping names the work, not the thing made
+		}
+		return i18n.T("order.craft", strings.ToLower(name))
 	case "construction":
-		return "Build " + strings.ToLower(activity.Name)
+		return i18n.T("order.build", strings.ToLower(name))
 	case "garden":
 		if o.TargetType != "" {
-			return activity.Name + " " + Pluralize(o.TargetType)
+			return i18n.T("order.target", name, Pluralize(o.TargetType))
 		}
-		return activity.Name
+		return name
+	case "woodwork":
+		return name
 	default:
 		if o.ActivityID == "extract" && o.TargetType != "" {
-			return activity.Name + " " + ItemDisplayName(o.TargetType) + " seeds"
+			return i18n.T("order.extract", name, ItemDisplayName(o.TargetType))
 		}
-		if o.ActivityID == "dig" {
-			return activity.Name
+		if o.ActivityID == "dig" || o.ActivityID == "deconstruct" {
+			return name
 		}
-		return activity.Name + " " + Pluralize(o.TargetType)
+		return i18n.T("order.target", name, Pluralize(o.TargetType))
 	}
 }
 
 // StatusDisplay returns a human-readable status string
 func (o *Order) StatusDisplay() string {
-	switch o.Status {
-	case OrderOpen:
-		return "Open"
-	case OrderAssigned:
-		return "Assigned"
-	case OrderPaused:
-		return "Paused"
-	case OrderCompleted:
-		return "Completed"
-	case OrderAbandoned:
-		return "Abandoned"
-	default:
-		return string(o.Status)
-	}
+	return i18n.Or("order.status."+string(o.Status), string(o.Status))
 }
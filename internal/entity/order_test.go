@@ -1,6 +1,10 @@
 package entity
 
-import "testing"
+import (
+	"testing"
+
+	"petri/internal/i18n"
+)
 
 func TestOrder_DisplayName_ConstructionBuildPrefix(t *testing.T) {
 	t.Parallel()
@@ -21,3 +25,27 @@ func TestOrder_DisplayName_ConstructionBuildPrefix(t *testing.T) {
 		}
 	}
 }
+
+// Not parallel: SetLanguage mutates global state
+func TestOrder_DisplayName_Spanish(t *testing.T) {
+	if err := i18n.SetLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { i18n.SetLanguage(i18n.DefaultLanguage) })
+
+	tests := []struct {
+		activityID string
+		targetType string
+		expected   string
+	}{
+		{"buildFence", "", "Construir cerca"},
+		{"harvest", "berry", "Cosechar bayas"},
+		{"extract", "flower", "Extraer semillas de flor"},
+	}
+	for _, tc := range tests {
+		order := NewOrder(1, tc.activityID, tc.targetType)
+		if got := order.DisplayName(); got != tc.expected {
+			t.Errorf("DisplayName() for %s: got %q, want %q", tc.activityID, got, tc.expected)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"strings"
+
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -177,64 +180,28 @@ func (p Preference) Description() string {
 		if p.Texture != "" {
 			return textureNoun(p.Texture)
 		}
+		if p.Color != "" {
+			return i18n.T("preference.color", attributeText("color", string(p.Color)))
+		}
 	}
 
-	// Build parts in order: texture, pattern, color, type (adjective forms)
-	parts := []string{}
-
-	if p.Texture != "" {
-		parts = append(parts, string(p.Texture))
-	}
-	if p.Pattern != "" {
-		parts = append(parts, string(p.Pattern))
-	}
-	if p.Color != "" {
-		parts = append(parts, string(p.Color))
-	}
+	noun := ""
 	if p.Kind != "" {
-		parts = append(parts, Pluralize(p.Kind))
+		noun = Pluralize(p.Kind)
 	} else if p.ItemType != "" {
-		parts = append(parts, Pluralize(p.ItemType))
-	}
-
-	if len(parts) == 0 {
-		return ""
+		noun = Pluralize(p.ItemType)
 	}
-
-	// Join with spaces
-	result := parts[0]
-	for i := 1; i < len(parts); i++ {
-		result += " " + parts[i]
-	}
-	return result
+	return describeItem(p.Texture, p.Pattern, p.Color, noun)
 }
 
 // patternNoun returns the noun form of a pattern (for solo preferences).
 func patternNoun(pattern types.Pattern) string {
-	switch pattern {
-	case types.PatternSpotted:
-		return "spots"
-	case types.PatternStriped:
-		return "stripes"
-	case types.PatternSpeckled:
-		return "speckles"
-	default:
-		return string(pattern)
-	}
+	return i18n.Or("pattern."+string(pattern)+".noun", string(pattern))
 }
 
 // textureNoun returns the noun form of a texture (for solo preferences).
 func textureNoun(texture types.Texture) string {
-	switch texture {
-	case types.TextureSlimy:
-		return "slimy texture"
-	case types.TextureWaxy:
-		return "waxy texture"
-	case types.TextureWarty:
-		return "warty texture"
-	default:
-		return string(texture) + " texture"
-	}
+	return i18n.Or("texture."+string(texture)+".noun", i18n.T("texture.noun", string(texture)))
 }
 
 // IsPositive returns true if this is a "likes" preference.
@@ -252,32 +219,56 @@ func (p Preference) ExactMatch(other Preference) bool {
 		p.Texture == other.Texture
 }
 
-// ItemDisplayName returns the singular display name for an item type.
+// ItemDisplayName returns the display name for an item type or kind in the active language.
 // Maps ItemType to its canonical name (e.g., "grass" → "tall grass").
 func ItemDisplayName(itemType string) string {
 	if itemType == "grass" {
-		return "tall grass"
+		itemType = "tall grass"
 	}
-	return itemType
+	return itemNoun(itemType, 1)
 }
 
 // Pluralize returns the plural form of an item type.
 func Pluralize(itemType string) string {
-	name := ItemDisplayName(itemType)
-	switch name {
-	case "berry":
-		return "berries"
-	case "mushroom":
-		return "mushrooms"
-	case "flower":
-		return "flowers"
-	case "tall grass":
-		return "tall grass"
-	case "clay":
-		return "lumps of clay"
-	default:
+	if itemType == "grass" {
+		itemType = "tall grass"
+	}
+	return itemNoun(itemType, 2)
+}
+
+// itemNoun returns the catalog form of an item noun for count n.
+// Nouns the catalog doesn't know (e.g., from content packs) are used as-is, with "s" for plurals.
+func itemNoun(name string, n int) string {
+	key := "noun." + strings.ReplaceAll(name, " ", "_")
+	if i18n.Has(key) {
+		return i18n.N(key, n)
+	}
+	if n != 1 {
 		return name + "s"
 	}
+	return name
+}
+
+// attributeText returns a color, pattern, or texture word in the active language
+func attributeText(attribute, value string) string {
+	return i18n.Or(attribute+"."+strings.ReplaceAll(value, " ", "_"), value)
+}
+
+// describeItem composes an item phrase from its attributes and noun in the active language's
+// word order, e.g., "slimy spotted red mushroom". Empty attributes are omitted.
+func describeItem(texture types.Texture, pattern types.Pattern, color types.Color, noun string) string {
+	var textureText, patternText, colorText string
+	if texture != types.TextureNone {
+		textureText = attributeText("texture", string(texture))
+	}
+	if pattern != types.PatternNone {
+		patternText = attributeText("pattern", string(pattern))
+	}
+	if color != "" {
+		colorText = i18n.T("describe.color", attributeText("color", string(color)))
+	}
+	// Collapse the gaps left by omitted attributes
+	return strings.Join(strings.Fields(i18n.T("describe.item", noun, colorText, patternText, textureText)), " ")
 }
 
 // NewPositivePreference creates a "likes" preference for the given attributes.
@@ -1,6 +1,11 @@
 package entity
 
-import "petri/internal/config"
+import (
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/i18n"
+)
 
 // RecipeInput defines an input requirement for a recipe
 type RecipeInput struct {
@@ -22,12 +27,26 @@ type Recipe struct {
 	Name              string // e.g., "Hollow Gourd"
 	Inputs            []RecipeInput
 	Output            RecipeOutput
-	Duration          float64            // Craft time in game seconds
+	Duration          float64            // Craft time in game seconds; 0 uses config.ActionDurationLong
 	Repeatable        bool               // When true, craft order loops until world-state completion condition is met (DD-19)
 	DiscoveryTriggers []DiscoveryTrigger // Triggers for discovering this recipe
 	BundledActivities []string           // Additional activities granted on recipe discovery
 }
 
+// CraftDuration returns the craft time in game seconds, reading the tunable long action
+// duration when the recipe doesn't set its own
+func (r *Recipe) CraftDuration() float64 {
+	if r.Duration > 0 {
+		return r.Duration
+	}
+	return config.ActionDurationLong
+}
+
+// DisplayName returns the recipe name in the active language
+func (r *Recipe) DisplayName() string {
+	return i18n.Content("recipe."+r.ID, r.Name)
+}
+
 // RecipeRegistry contains all defined recipes
 var RecipeRegistry = map[string]*Recipe{
 	"thatch-fence": {
@@ -69,7 +88,6 @@ var RecipeRegistry = map[string]*Recipe{
 		Name:       "Clay Brick",
 		Inputs:     []RecipeInput{{ItemType: "clay", Count: 1}},
 		Output:     RecipeOutput{ItemType: "brick"},
-		Duration:   config.ActionDurationLong,
 		Repeatable: true,
 		DiscoveryTriggers: []DiscoveryTrigger{
 			{Action: ActionLook, ItemType: "clay"},
@@ -83,7 +101,6 @@ var RecipeRegistry = map[string]*Recipe{
 		Name:       "Hollow Gourd",
 		Inputs:     []RecipeInput{{ItemType: "gourd", Count: 1}},
 		Output:     RecipeOutput{ItemType: "vessel", Kind: "hollow gourd", ContainerCapacity: 1},
-		Duration:   config.ActionDurationLong,
 		DiscoveryTriggers: []DiscoveryTrigger{
 			{Action: ActionLook, ItemType: "gourd"},    // looking at gourd
 			{Action: ActionPickup, ItemType: "gourd"},  // picking up gourd
@@ -99,15 +116,62 @@ var RecipeRegistry = map[string]*Recipe{
 			{ItemType: "stick", Count: 1},
 			{ItemType: "shell", Count: 1},
 		},
-		Output:   RecipeOutput{ItemType: "hoe", Kind: "shell hoe"},
-		Duration: config.ActionDurationLong,
+		Output: RecipeOutput{ItemType: "hoe", Kind: "shell hoe"},
 		DiscoveryTriggers: []DiscoveryTrigger{
 			{Action: ActionLook, ItemType: "stick"},   // looking at stick
 			{Action: ActionPickup, ItemType: "stick"}, // picking up stick
 			{Action: ActionLook, ItemType: "shell"},   // looking at shell
 			{Action: ActionPickup, ItemType: "shell"}, // picking up shell
 		},
-		BundledActivities: []string{"tillSoil"}, // inventing a hoe implies knowing how to till
+		BundledActivities: []string{"tillSoil", "digChannel"}, // inventing a hoe implies knowing how to till and dig
+	},
+	"shell-chisel": {
+		ID:         "shell-chisel",
+		ActivityID: "craftChisel",
+		Name:       "Shell Chisel",
+		Inputs:     []RecipeInput{{ItemType: "shell", Count: 1}},
+		Output:     RecipeOutput{ItemType: "chisel", Kind: "shell chisel"},
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "hoe"},   // a shell edge on a hoe suggests a handheld blade
+			{Action: ActionPickup, ItemType: "hoe"}, // picking up hoe
+		},
+		BundledActivities: []string{"carveWood"}, // inventing a chisel implies knowing how to carve
+	},
+	"stone-flake": {
+		ID:         "stone-flake",
+		ActivityID: "knap",
+		Name:       "Stone Flake",
+		Inputs:     []RecipeInput{{ItemType: "stone", Count: 1}},
+		Output:     RecipeOutput{ItemType: "flake", Kind: "stone flake"},
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "stone"},   // looking at stone
+			{Action: ActionPickup, ItemType: "stone"}, // picking up stone
+		},
+	},
+	"stone-axe": {
+		ID:         "stone-axe",
+		ActivityID: "craftAxe",
+		Name:       "Stone Axe",
+		Inputs: []RecipeInput{
+			{ItemType: "stick", Count: 1},
+			{ItemType: "stone", Count: 1},
+		},
+		Output: RecipeOutput{ItemType: "axe", Kind: "stone axe"},
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionLook, ItemType: "flake"},   // a sharp flake suggests a heavier hafted edge
+			{Action: ActionPickup, ItemType: "flake"}, // picking up flake
+		},
+		BundledActivities: []string{"chopWood", "carveWood"}, // inventing an axe implies knowing how to chop and carve
+	},
+	"campfire": {
+		ID:         "campfire",
+		ActivityID: "buildCampfire",
+		Name:       "Campfire",
+		Inputs:     []RecipeInput{{ItemType: "stick", Count: 1}, {ItemType: "grass", Count: 1}},
+		Output:     RecipeOutput{ItemType: "campfire", Kind: "campfire"}, // display only; actual output is a construct
+		DiscoveryTriggers: []DiscoveryTrigger{
+			{Action: ActionPickup, ItemType: "grass"},
+		},
 	},
 	"thatch-hut": {
 		ID:         "thatch-hut",
@@ -141,7 +205,7 @@ var RecipeRegistry = map[string]*Recipe{
 	},
 }
 
-// GetRecipesForActivity returns all recipes that belong to a given activity
+// GetRecipesForActivity returns all recipes that belong to a given activity, ordered by ID
 func GetRecipesForActivity(activityID string) []*Recipe {
 	var result []*Recipe
 	for _, recipe := range RecipeRegistry {
@@ -149,10 +213,11 @@ func GetRecipesForActivity(activityID string) []*Recipe {
 			result = append(result, recipe)
 		}
 	}
+	sortRecipes(result)
 	return result
 }
 
-// GetDiscoverableRecipes returns all recipes that have discovery triggers
+// GetDiscoverableRecipes returns all recipes that have discovery triggers, ordered by ID
 func GetDiscoverableRecipes() []*Recipe {
 	var result []*Recipe
 	for _, recipe := range RecipeRegistry {
@@ -160,5 +225,11 @@ func GetDiscoverableRecipes() []*Recipe {
 			result = append(result, recipe)
 		}
 	}
+	sortRecipes(result)
 	return result
 }
+
+// sortRecipes orders recipes by ID, since registry iteration order varies between runs
+func sortRecipes(recipes []*Recipe) {
+	sort.Slice(recipes, func(i, j int) bool { return recipes[i].ID < recipes[j].ID })
+}
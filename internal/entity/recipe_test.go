@@ -42,8 +42,8 @@ func TestShellHoeRecipe_Registered(t *testing.T) {
 	}
 
 	// Verify duration
-	if recipe.Duration != config.ActionDurationLong {
-		t.Errorf("shell-hoe Duration: got %v, want %v", recipe.Duration, config.ActionDurationLong)
+	if recipe.CraftDuration() != config.ActionDurationLong {
+		t.Errorf("shell-hoe Duration: got %v, want %v", recipe.CraftDuration(), config.ActionDurationLong)
 	}
 
 	// Verify discovery triggers exist for stick and shell
@@ -139,8 +139,8 @@ func TestCraftBrickRecipe_InRegistry(t *testing.T) {
 	}
 
 	// Verify duration
-	if recipe.Duration != config.ActionDurationLong {
-		t.Errorf("clay-brick Duration: got %v, want %v", recipe.Duration, config.ActionDurationLong)
+	if recipe.CraftDuration() != config.ActionDurationLong {
+		t.Errorf("clay-brick Duration: got %v, want %v", recipe.CraftDuration(), config.ActionDurationLong)
 	}
 
 	// Verify Repeatable — crafting loops until no clay remains (DD-19)
@@ -315,3 +315,17 @@ func TestBuildFenceActivity_NoDiscoveryTriggers(t *testing.T) {
 		t.Errorf("buildFence Category: got %q, want %q", activity.Category, "construction")
 	}
 }
+
+// Not parallel: mutates config.ActionDurationLong
+func TestRecipe_CraftDurationFollowsLongActionDuration(t *testing.T) {
+	before := config.ActionDurationLong
+	t.Cleanup(func() { config.ActionDurationLong = before })
+
+	config.ActionDurationLong = 3
+	if got := RecipeRegistry["clay-brick"].CraftDuration(); got != 3 {
+		t.Errorf("Expected clay-brick to take the tuned long duration 3, got %v", got)
+	}
+	if got := (&Recipe{Duration: 7}).CraftDuration(); got != 7 {
+		t.Errorf("Expected an explicit duration to win, got %v", got)
+	}
+}
@@ -50,24 +50,11 @@ func (v *ItemVariety) IsHealing() bool {
 // Description returns a human-readable description like "slimy spotted red mushroom"
 // Format: [Texture] [Pattern] [Color] [ItemType]
 func (v *ItemVariety) Description() string {
-	var parts []string
-
-	if v.Texture != types.TextureNone {
-		parts = append(parts, string(v.Texture))
-	}
-	if v.Pattern != types.PatternNone {
-		parts = append(parts, string(v.Pattern))
-	}
-	if v.Color != "" {
-		parts = append(parts, string(v.Color))
-	}
+	noun := v.ItemType
 	if v.Kind != "" {
-		parts = append(parts, v.Kind)
-	} else {
-		parts = append(parts, v.ItemType)
+		noun = v.Kind
 	}
-
-	return strings.Join(parts, " ")
+	return describeItem(v.Texture, v.Pattern, v.Color, itemNoun(noun, 1))
 }
 
 // GenerateVarietyID creates a unique ID from the variety's attributes.
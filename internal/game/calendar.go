@@ -0,0 +1,86 @@
+package game
+
+import (
+	"strings"
+
+	"petri/internal/config"
+)
+
+// Season is one quarter of the world year
+type Season int
+
+const (
+	SeasonSpring Season = iota
+	SeasonSummer
+	SeasonAutumn
+	SeasonWinter
+)
+
+// seasonIDs are the stable season identifiers, in calendar order
+var seasonIDs = [...]string{"spring", "summer", "autumn", "winter"}
+
+// ID returns the stable identifier used in config keys, saves, and i18n keys
+func (s Season) ID() string {
+	return seasonIDs[s]
+}
+
+// ParseSeason returns the season with the given ID. Unknown IDs (including "") return spring.
+func ParseSeason(id string) Season {
+	for i, sid := range seasonIDs {
+		if sid == id {
+			return Season(i)
+		}
+	}
+	return SeasonSpring
+}
+
+// IsSeasonKey returns true if key names a season ("autumn") or the second half of one ("late_autumn")
+func IsSeasonKey(key string) bool {
+	key = strings.TrimPrefix(key, "late_")
+	for _, sid := range seasonIDs {
+		if sid == key {
+			return true
+		}
+	}
+	return false
+}
+
+// seasonLength returns the length of a season in game seconds
+func seasonLength() float64 {
+	return config.SeasonLengthDays * config.WorldDaySeconds
+}
+
+// Season returns the current season
+func (m *Map) Season() Season {
+	return m.season
+}
+
+// SeasonElapsed returns the game seconds spent in the current season
+func (m *Map) SeasonElapsed() float64 {
+	return m.seasonElapsed
+}
+
+// IsLateSeason returns true in the second half of the current season
+func (m *Map) IsLateSeason() bool {
+	return m.seasonElapsed >= seasonLength()/2
+}
+
+// SetCalendar restores the season and the time spent in it (for save/load)
+func (m *Map) SetCalendar(season Season, elapsed float64) {
+	m.season = season
+	m.seasonElapsed = elapsed
+}
+
+// AdvanceCalendar moves the calendar forward, turning to the next season when the current one ends.
+// A non-positive SeasonLengthDays holds the calendar still.
+func (m *Map) AdvanceCalendar(delta float64) {
+	length := seasonLength()
+	if length <= 0 {
+		return
+	}
+	m.seasonElapsed += delta
+	for m.seasonElapsed >= length {
+		m.seasonElapsed -= length
+		m.season = (m.season + 1) % Season(len(seasonIDs))
+	}
+}
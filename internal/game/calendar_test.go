@@ -0,0 +1,48 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+)
+
+func TestAdvanceCalendar_TurnsSeasonsAndWrapsToSpring(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(5, 5)
+	length := config.SeasonLengthDays * config.WorldDaySeconds
+	if m.Season() != SeasonSpring || m.IsLateSeason() {
+		t.Fatalf("Expected a new map to start in early spring, got %s", m.Season().ID())
+	}
+
+	m.AdvanceCalendar(length / 2)
+	if m.Season() != SeasonSpring || !m.IsLateSeason() {
+		t.Errorf("Expected late spring halfway through the season, got %s (late=%v)", m.Season().ID(), m.IsLateSeason())
+	}
+
+	m.AdvanceCalendar(length/2 + 1)
+	if m.Season() != SeasonSummer || m.SeasonElapsed() != 1 {
+		t.Errorf("Expected summer with 1s elapsed, got %s with %.1fs", m.Season().ID(), m.SeasonElapsed())
+	}
+
+	m.AdvanceCalendar(3 * length)
+	if m.Season() != SeasonSpring {
+		t.Errorf("Expected the year to wrap back to spring, got %s", m.Season().ID())
+	}
+}
+
+func TestParseSeasonAndSeasonKeys(t *testing.T) {
+	t.Parallel()
+
+	if got := ParseSeason("autumn"); got != SeasonAutumn {
+		t.Errorf("ParseSeason(autumn): got %s", got.ID())
+	}
+	if got := ParseSeason(""); got != SeasonSpring {
+		t.Errorf("ParseSeason of an old save's empty season: got %s, want spring", got.ID())
+	}
+	for key, want := range map[string]bool{"winter": true, "late_summer": true, "monsoon": false, "late_": false} {
+		if got := IsSeasonKey(key); got != want {
+			t.Errorf("IsSeasonKey(%q): got %v, want %v", key, got, want)
+		}
+	}
+}
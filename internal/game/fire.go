@@ -0,0 +1,108 @@
+package game
+
+import (
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+// IsFlammable returns true if the tile at pos has something a wildfire can catch:
+// loose grass, a leaf pile, or a thatch construct
+func (m *Map) IsFlammable(pos types.Position) bool {
+	for _, item := range m.ItemsAt(pos) {
+		if item.ItemType == "grass" {
+			return true
+		}
+	}
+	if f := m.FeatureAt(pos); f != nil && f.FType == entity.FeatureLeafPile {
+		return true
+	}
+	if c := m.ConstructAt(pos); c != nil && c.Material == "grass" {
+		return true
+	}
+	return false
+}
+
+// Ignite sets the tile at pos burning for WildfireBurnTime seconds.
+// Returns false if the tile is already burning or has nothing to burn.
+func (m *Map) Ignite(pos types.Position) bool {
+	if m.burning[pos] > 0 || !m.IsFlammable(pos) {
+		return false
+	}
+	m.burning[pos] = config.WildfireBurnTime
+	return true
+}
+
+// IsBurning returns true if a wildfire is burning at pos
+func (m *Map) IsBurning(pos types.Position) bool {
+	_, ok := m.burning[pos]
+	return ok
+}
+
+// BurnRemaining returns the seconds the fire at pos has left, and false if nothing is burning there
+func (m *Map) BurnRemaining(pos types.Position) (float64, bool) {
+	remaining, ok := m.burning[pos]
+	return remaining, ok
+}
+
+// SetBurning sets the tile at pos burning with the given seconds left (world load)
+func (m *Map) SetBurning(pos types.Position, remaining float64) {
+	m.burning[pos] = remaining
+}
+
+// BurningPositions returns all burning tiles, in row-major order
+func (m *Map) BurningPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.burning))
+	for pos := range m.burning {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// ExtinguishFire puts out the fire at pos, sparing whatever was left unburnt.
+// Returns false if nothing was burning there.
+func (m *Map) ExtinguishFire(pos types.Position) bool {
+	if _, ok := m.burning[pos]; !ok {
+		return false
+	}
+	delete(m.burning, pos)
+	return true
+}
+
+// DouseFires puts out every burning tile (rain). Returns how many fires went out.
+func (m *Map) DouseFires() int {
+	n := len(m.burning)
+	clear(m.burning)
+	return n
+}
+
+// BurnDown counts down every fire; tiles that run out are burnt out, destroying the grass,
+// leaf piles and thatch constructs on them. Returns the burnt-out positions, in row-major order.
+func (m *Map) BurnDown(delta float64) []types.Position {
+	var burnt []types.Position
+	for _, pos := range m.BurningPositions() {
+		m.burning[pos] -= delta
+		if m.burning[pos] <= 0 {
+			delete(m.burning, pos)
+			m.burnOut(pos)
+			burnt = append(burnt, pos)
+		}
+	}
+	return burnt
+}
+
+// burnOut destroys everything flammable on the tile at pos
+func (m *Map) burnOut(pos types.Position) {
+	for _, item := range m.ItemsAt(pos) {
+		if item.ItemType == "grass" {
+			m.RemoveItem(item)
+		}
+	}
+	if f := m.FeatureAt(pos); f != nil && f.FType == entity.FeatureLeafPile {
+		m.RemoveFeature(f)
+	}
+	if c := m.ConstructAt(pos); c != nil && c.Material == "grass" {
+		m.RemoveConstruct(c)
+	}
+}
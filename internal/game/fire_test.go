@@ -0,0 +1,82 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+func TestIgnite_OnlyFlammableTiles(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	bare := types.Position{X: 1, Y: 1}
+	grass := types.Position{X: 2, Y: 2}
+	m.AddItem(entity.NewGrass(grass.X, grass.Y))
+
+	if m.Ignite(bare) {
+		t.Error("Expected bare ground not to catch fire")
+	}
+	if !m.Ignite(grass) {
+		t.Fatal("Expected loose grass to catch fire")
+	}
+	if m.Ignite(grass) {
+		t.Error("Expected a burning tile not to be ignited twice")
+	}
+	if remaining, ok := m.BurnRemaining(grass); !ok || remaining != config.WildfireBurnTime {
+		t.Errorf("Expected %.0fs of burn time, got %.1f (%v)", config.WildfireBurnTime, remaining, ok)
+	}
+}
+
+func TestBurnDown_DestroysFlammables(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	grass := types.Position{X: 1, Y: 1}
+	leaves := types.Position{X: 3, Y: 3}
+	thatch := types.Position{X: 5, Y: 5}
+	m.AddItem(entity.NewGrass(grass.X, grass.Y))
+	m.AddItem(entity.NewStick(grass.X, grass.Y))
+	m.AddFeature(entity.NewLeafPile(leaves.X, leaves.Y))
+	m.AddConstruct(entity.NewFence(thatch.X, thatch.Y, "grass", types.ColorPaleGreen))
+	for _, pos := range []types.Position{grass, leaves, thatch} {
+		m.Ignite(pos)
+	}
+
+	if burnt := m.BurnDown(config.WildfireBurnTime / 2); len(burnt) != 0 {
+		t.Fatalf("Expected nothing burnt out halfway, got %v", burnt)
+	}
+	if burnt := m.BurnDown(config.WildfireBurnTime); len(burnt) != 3 {
+		t.Fatalf("Expected 3 tiles burnt out, got %v", burnt)
+	}
+	if items := m.ItemsAt(grass); len(items) != 1 || items[0].ItemType != "stick" {
+		t.Errorf("Expected only the stick to survive, got %+v", items)
+	}
+	if m.FeatureAt(leaves) != nil {
+		t.Error("Expected the leaf pile to burn away")
+	}
+	if m.ConstructAt(thatch) != nil {
+		t.Error("Expected the thatch fence to burn away")
+	}
+	if len(m.BurningPositions()) != 0 {
+		t.Error("Expected no fires left burning")
+	}
+}
+
+func TestDouseFires_SparesUnburntTiles(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	pos := types.Position{X: 4, Y: 4}
+	m.AddItem(entity.NewGrass(pos.X, pos.Y))
+	m.Ignite(pos)
+
+	if n := m.DouseFires(); n != 1 {
+		t.Errorf("Expected 1 fire doused, got %d", n)
+	}
+	if m.IsBurning(pos) || len(m.ItemsAt(pos)) != 1 {
+		t.Error("Expected the fire out and the grass left unburnt")
+	}
+}
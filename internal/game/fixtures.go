@@ -0,0 +1,40 @@
+package game
+
+import (
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+// Map fixtures shared by tests in this and other packages
+
+// AddTestHut places a finished 5×5 stick hut with its top-left corner at (x, y)
+// and a door in the middle of the bottom wall
+func AddTestHut(m *Map, x, y int) {
+	for dy := 0; dy < 5; dy++ {
+		for dx := 0; dx < 5; dx++ {
+			if dx != 0 && dx != 4 && dy != 0 && dy != 4 {
+				continue
+			}
+			role := "wall"
+			if dx == 2 && dy == 4 {
+				role = "door"
+			}
+			m.AddConstruct(entity.NewHutConstruct(x+dx, y+dy, "stick", types.ColorBrown, role))
+		}
+	}
+}
+
+// AddTestSquareTree places a size×size tree with its top-left corner at (x, y): livewood around heartwood
+func AddTestSquareTree(m *Map, x, y, size int) {
+	tiles := make(map[types.Position]TreeTile)
+	for dy := 0; dy < size; dy++ {
+		for dx := 0; dx < size; dx++ {
+			tile := TreeHeartwood
+			if dx == 0 || dy == 0 || dx == size-1 || dy == size-1 {
+				tile = TreeLivewood
+			}
+			tiles[types.Position{X: x + dx, Y: y + dy}] = tile
+		}
+	}
+	m.SetTrees(tiles)
+}
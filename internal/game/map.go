@@ -1,6 +1,8 @@
 package game
 
 import (
+	"sort"
+
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/types"
@@ -10,9 +12,11 @@ import (
 type WaterType int
 
 const (
-	WaterNone   WaterType = iota
-	WaterSpring           // Natural spring (renders as ☉)
-	WaterPond             // Pond tile (renders as ≈)
+	WaterNone    WaterType = iota
+	WaterSpring            // Natural spring (renders as ☉)
+	WaterPond              // Pond tile (renders as ▓)
+	WaterRiver             // River tile (renders as ≈)
+	WaterChannel           // Dug irrigation channel (renders as ~, silts up without upkeep)
 )
 
 // Map represents the game world as a sparse grid
@@ -26,30 +30,80 @@ type Map struct {
 	items          []*entity.Item
 	features       []*entity.Feature
 	constructs     []*entity.Construct
+	creatures      []*entity.Creature
 
 	// Water terrain (springs and ponds)
 	water map[types.Position]WaterType
 
+	// Dynamic water (see water.go): dried-up pond tiles that rain can refill, seconds until
+	// each channel silts up, and the marked-for-digging pool (channel plan, independent of orders)
+	driedPondBeds    map[types.Position]bool
+	channelSilt      map[types.Position]float64
+	markedForDigging map[types.Position]bool
+
 	// Clay terrain positions (passable, items can exist on them)
 	clay map[types.Position]bool
 
+	// Tree terrain (see tree.go)
+	trees map[types.Position]TreeTile
+
+	// Rock outcrop terrain (impassable; see outcrop.go)
+	outcrops map[types.Position]bool
+
+	// Biomes from terrain generation (see terrain.go); sparse, meadow tiles are absent
+	biomes map[types.Position]Biome
+
 	// Tilled soil positions (walkable, items can exist on them)
 	tilled map[types.Position]bool
 
+	// Fertility of tilled soil and the crop last harvested there (see soil.go)
+	fertility map[types.Position]float64
+	lastCrop  map[types.Position]string
+
 	// Marked-for-tilling pool (user's tilling plan, independent of orders)
 	markedForTilling map[types.Position]bool
 
 	// Marked-for-construction pool (fence/hut placement plan, independent of orders)
 	markedForConstruction map[types.Position]ConstructionMark
 
+	// Marked-for-deconstruction pool (constructs the user wants dismantled, independent of orders)
+	markedForDeconstruction map[types.Position]bool
+
+	// Marked-for-carving pool (tree tiles the user wants carved out, independent of orders)
+	markedForCarving map[types.Position]bool
+
+	// Marked-for-chopping pool (tree tiles the user wants chopped down, independent of orders)
+	markedForChopping map[types.Position]bool
+
 	// Manually watered tiles with decay timers (seconds remaining)
 	wateredTimers map[types.Position]float64
 
+	// Wildfire: seconds each burning tile has left before it burns out (see fire.go)
+	burning map[types.Position]float64
+
+	// Enclosed regions (derived from constructs and water, kept up to date as they change)
+	regions      map[int]*Region
+	regionAt     map[types.Position]*Region
+	nextRegionID int
+
+	// Elapsed game seconds as of the current tick (the time of day is derived from it)
+	gameTime float64
+
+	// Seasonal calendar (see calendar.go)
+	season        Season
+	seasonElapsed float64
+
+	// Weather (see weather.go)
+	weather          Weather
+	forecast         Weather
+	weatherRemaining float64
+
 	// ID counters for save/load
 	nextItemID             int
 	nextFeatureID          int
 	nextConstructID        int
 	nextConstructionLineID int
+	nextCreatureID         int
 
 	// Variety registry for this world (determines poison/healing for item types)
 	varieties *VarietyRegistry
@@ -66,20 +120,35 @@ type ConstructionMark struct {
 // NewMap creates a new map with the given dimensions
 func NewMap(width, height int) *Map {
 	return &Map{
-		Width:                 width,
-		Height:                height,
-		entities:              make(map[types.Position]entity.Entity),
-		characters:            make([]*entity.Character, 0),
-		characterByPos:        make(map[types.Position]*entity.Character),
-		items:                 make([]*entity.Item, 0),
-		features:              make([]*entity.Feature, 0),
-		constructs:            make([]*entity.Construct, 0),
-		water:                 make(map[types.Position]WaterType),
-		clay:                  make(map[types.Position]bool),
-		tilled:                make(map[types.Position]bool),
-		markedForTilling:      make(map[types.Position]bool),
-		markedForConstruction: make(map[types.Position]ConstructionMark),
-		wateredTimers:         make(map[types.Position]float64),
+		Width:                   width,
+		Height:                  height,
+		entities:                make(map[types.Position]entity.Entity),
+		characters:              make([]*entity.Character, 0),
+		characterByPos:          make(map[types.Position]*entity.Character),
+		items:                   make([]*entity.Item, 0),
+		features:                make([]*entity.Feature, 0),
+		constructs:              make([]*entity.Construct, 0),
+		creatures:               make([]*entity.Creature, 0),
+		water:                   make(map[types.Position]WaterType),
+		driedPondBeds:           make(map[types.Position]bool),
+		channelSilt:             make(map[types.Position]float64),
+		markedForDigging:        make(map[types.Position]bool),
+		clay:                    make(map[types.Position]bool),
+		trees:                   make(map[types.Position]TreeTile),
+		outcrops:                make(map[types.Position]bool),
+		biomes:                  make(map[types.Position]Biome),
+		tilled:                  make(map[types.Position]bool),
+		fertility:               make(map[types.Position]float64),
+		lastCrop:                make(map[types.Position]string),
+		markedForTilling:        make(map[types.Position]bool),
+		markedForConstruction:   make(map[types.Position]ConstructionMark),
+		markedForDeconstruction: make(map[types.Position]bool),
+		markedForCarving:        make(map[types.Position]bool),
+		markedForChopping:       make(map[types.Position]bool),
+		wateredTimers:           make(map[types.Position]float64),
+		burning:                 make(map[types.Position]float64),
+		regions:                 make(map[int]*Region),
+		regionAt:                make(map[types.Position]*Region),
 	}
 }
 
@@ -122,11 +191,14 @@ func (m *Map) RemoveItem(item *entity.Item) {
 }
 
 // EntityAt returns an entity at the given position, or nil
-// For characters, returns the character at that position
+// For characters, returns the character at that position, then any creature there
 func (m *Map) EntityAt(pos types.Position) entity.Entity {
 	if char := m.characterByPos[pos]; char != nil {
 		return char
 	}
+	if c := m.CreatureAt(pos); c != nil {
+		return c
+	}
 	return m.entities[pos]
 }
 
@@ -154,8 +226,8 @@ func (m *Map) MoveCharacter(char *entity.Character, to types.Position) bool {
 		return false
 	}
 
-	// Refuse move if target is water
-	if m.IsWater(to) {
+	// Refuse move if target is water, solid wood or rock
+	if m.IsWater(to) || m.IsSolidTree(to) || m.IsOutcrop(to) {
 		return false
 	}
 
@@ -192,12 +264,12 @@ func (m *Map) IsOccupied(pos types.Position) bool {
 	return m.characterByPos[pos] != nil
 }
 
-// IsBlocked returns true if the position is blocked by a character, impassable feature, or water
+// IsBlocked returns true if the position is blocked by a character, impassable feature, water, solid wood or rock
 func (m *Map) IsBlocked(pos types.Position) bool {
 	if m.characterByPos[pos] != nil {
 		return true
 	}
-	if m.IsWater(pos) {
+	if m.IsWater(pos) || m.IsSolidTree(pos) || m.IsOutcrop(pos) {
 		return true
 	}
 	if f := m.FeatureAt(pos); f != nil && !f.IsPassable() {
@@ -209,12 +281,12 @@ func (m *Map) IsBlocked(pos types.Position) bool {
 	return false
 }
 
-// IsEmpty returns true if no entity (character, item, feature, or water) is at the position
+// IsEmpty returns true if no entity (character, item, feature, water, solid wood or rock) is at the position
 func (m *Map) IsEmpty(pos types.Position) bool {
 	if m.characterByPos[pos] != nil {
 		return false
 	}
-	if m.IsWater(pos) {
+	if m.IsWater(pos) || m.IsSolidTree(pos) || m.IsOutcrop(pos) {
 		return false
 	}
 	if m.ItemAt(pos) != nil {
@@ -302,6 +374,16 @@ func (m *Map) FeatureAt(pos types.Position) *entity.Feature {
 	return nil
 }
 
+// RemoveFeature removes a feature from the map
+func (m *Map) RemoveFeature(f *entity.Feature) {
+	for i, feat := range m.features {
+		if feat == f {
+			m.features = append(m.features[:i], m.features[i+1:]...)
+			return
+		}
+	}
+}
+
 // BedAt returns a bed feature at the given position, or nil
 func (m *Map) BedAt(pos types.Position) *entity.Feature {
 	f := m.FeatureAt(pos)
@@ -374,11 +456,13 @@ func (m *Map) AddConstruct(c *entity.Construct) {
 	m.nextConstructID++
 	c.ID = m.nextConstructID
 	m.constructs = append(m.constructs, c)
+	m.updateRegionsAround(c.Pos())
 }
 
 // AddConstructDirect adds a construct to the map without assigning an ID (for save/load)
 func (m *Map) AddConstructDirect(c *entity.Construct) {
 	m.constructs = append(m.constructs, c)
+	m.updateRegionsAround(c.Pos())
 }
 
 // Constructs returns all constructs on the map
@@ -401,6 +485,8 @@ func (m *Map) RemoveConstruct(c *entity.Construct) {
 	for i, con := range m.constructs {
 		if con == c {
 			m.constructs = append(m.constructs[:i], m.constructs[i+1:]...)
+			delete(m.markedForDeconstruction, c.Pos())
+			m.updateRegionsAround(c.Pos())
 			break
 		}
 	}
@@ -416,14 +502,78 @@ func (m *Map) SetNextConstructID(id int) {
 	m.nextConstructID = id
 }
 
+// AddCreature adds a creature to the map, assigning a unique ID
+func (m *Map) AddCreature(c *entity.Creature) {
+	m.nextCreatureID++
+	c.ID = m.nextCreatureID
+	m.creatures = append(m.creatures, c)
+}
+
+// AddCreatureDirect adds a creature to the map without assigning an ID (for save/load)
+func (m *Map) AddCreatureDirect(c *entity.Creature) {
+	m.creatures = append(m.creatures, c)
+}
+
+// Creatures returns all creatures on the map
+func (m *Map) Creatures() []*entity.Creature {
+	return m.creatures
+}
+
+// CreatureAt returns the creature at the given position, or nil
+func (m *Map) CreatureAt(pos types.Position) *entity.Creature {
+	for _, c := range m.creatures {
+		if c.Pos() == pos {
+			return c
+		}
+	}
+	return nil
+}
+
+// RemoveCreature removes a creature from the map
+func (m *Map) RemoveCreature(c *entity.Creature) {
+	for i, cr := range m.creatures {
+		if cr == c {
+			m.creatures = append(m.creatures[:i], m.creatures[i+1:]...)
+			break
+		}
+	}
+}
+
+// MoveCreature moves a creature to a new position.
+// Creatures don't block characters, but step around them, other creatures, water, and impassable
+// features or constructs. Returns false if the move is blocked.
+func (m *Map) MoveCreature(c *entity.Creature, to types.Position) bool {
+	if !m.IsValid(to) || m.IsBlocked(to) {
+		return false
+	}
+	if other := m.CreatureAt(to); other != nil && other != c {
+		return false
+	}
+	c.SetPos(to)
+	return true
+}
+
+// NextCreatureID returns the current next creature ID (for save/load)
+func (m *Map) NextCreatureID() int {
+	return m.nextCreatureID
+}
+
+// SetNextCreatureID sets the next creature ID (for save/load)
+func (m *Map) SetNextCreatureID(id int) {
+	m.nextCreatureID = id
+}
+
 // AddWater adds a water tile at the given position
 func (m *Map) AddWater(pos types.Position, wtype WaterType) {
 	m.water[pos] = wtype
+	m.updateRegionsAround(pos)
 }
 
 // RemoveWater removes a water tile at the given position
 func (m *Map) RemoveWater(pos types.Position) {
 	delete(m.water, pos)
+	delete(m.channelSilt, pos)
+	m.updateRegionsAround(pos)
 }
 
 // IsWater returns true if there is a water tile at the position
@@ -436,15 +586,33 @@ func (m *Map) WaterAt(pos types.Position) WaterType {
 	return m.water[pos]
 }
 
-// WaterPositions returns all positions that have water tiles
+// WaterPositions returns all positions that have water tiles, in row-major order
 func (m *Map) WaterPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.water))
 	for pos := range m.water {
 		positions = append(positions, pos)
 	}
+	// Sort for consistent ordering (maps iterate randomly) so seeded generation is reproducible
+	sortPositions(positions)
 	return positions
 }
 
+// sortPositions sorts positions in row-major order (Y, then X)
+func sortPositions(positions []types.Position) {
+	sort.Slice(positions, func(i, j int) bool {
+		return positionLess(positions[i], positions[j])
+	})
+}
+
+// positionLess orders positions by row, then column. Nearest-tile searches over position maps
+// break distance ties with it, so the same world always picks the same tile.
+func positionLess(a, b types.Position) bool {
+	if a.Y != b.Y {
+		return a.Y < b.Y
+	}
+	return a.X < b.X
+}
+
 // FindNearestWater finds the nearest water tile that has an available cardinal-adjacent tile.
 // Water tiles are impassable, so characters drink from cardinally adjacent tiles (N/E/S/W).
 // A water tile is available if at least one cardinal-adjacent tile is unblocked or occupied by the requester.
@@ -484,7 +652,7 @@ func (m *Map) FindNearestWater(pos types.Position) (types.Position, bool) {
 		}
 
 		dist := pos.DistanceTo(waterPos)
-		if dist < nearestDist {
+		if dist < nearestDist || (dist == nearestDist && positionLess(waterPos, nearestPos)) {
 			nearestDist = dist
 			nearestPos = waterPos
 			found = true
@@ -532,12 +700,13 @@ func (m *Map) HasClay() bool {
 	return len(m.clay) > 0
 }
 
-// ClayPositions returns all positions that have clay terrain
+// ClayPositions returns all positions that have clay terrain, in row-major order
 func (m *Map) ClayPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.clay))
 	for pos := range m.clay {
 		positions = append(positions, pos)
 	}
+	sortPositions(positions)
 	return positions
 }
 
@@ -551,7 +720,7 @@ func (m *Map) FindNearestClay(pos types.Position) (types.Position, bool) {
 
 	for clayPos := range m.clay {
 		dist := pos.DistanceTo(clayPos)
-		if dist < nearestDist {
+		if dist < nearestDist || (dist == nearestDist && positionLess(clayPos, nearestPos)) {
 			nearestDist = dist
 			nearestPos = clayPos
 			found = true
@@ -560,9 +729,12 @@ func (m *Map) FindNearestClay(pos types.Position) (types.Position, bool) {
 	return nearestPos, found
 }
 
-// SetTilled marks a position as tilled soil
+// SetTilled marks a position as tilled soil. Newly tilled soil starts at fertility 1.
 func (m *Map) SetTilled(pos types.Position) {
 	m.tilled[pos] = true
+	if _, ok := m.fertility[pos]; !ok {
+		m.fertility[pos] = 1
+	}
 }
 
 // IsTilled returns true if the position has been tilled
@@ -570,12 +742,13 @@ func (m *Map) IsTilled(pos types.Position) bool {
 	return m.tilled[pos]
 }
 
-// TilledPositions returns all positions that have been tilled
+// TilledPositions returns all positions that have been tilled, in row-major order
 func (m *Map) TilledPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.tilled))
 	for pos := range m.tilled {
 		positions = append(positions, pos)
 	}
+	sortPositions(positions)
 	return positions
 }
 
@@ -599,12 +772,13 @@ func (m *Map) IsMarkedForTilling(pos types.Position) bool {
 	return m.markedForTilling[pos]
 }
 
-// MarkedForTillingPositions returns all positions in the marked-for-tilling pool.
+// MarkedForTillingPositions returns all positions in the marked-for-tilling pool, in row-major order.
 func (m *Map) MarkedForTillingPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.markedForTilling))
 	for pos := range m.markedForTilling {
 		positions = append(positions, pos)
 	}
+	sortPositions(positions)
 	return positions
 }
 
@@ -639,12 +813,43 @@ func (m *Map) GetConstructionMark(pos types.Position) (ConstructionMark, bool) {
 	return mark, exists
 }
 
-// MarkedForConstructionPositions returns all positions in the marked-for-construction pool.
+// MarkedForConstructionPositions returns all positions in the marked-for-construction pool, in row-major order.
 func (m *Map) MarkedForConstructionPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.markedForConstruction))
 	for pos := range m.markedForConstruction {
 		positions = append(positions, pos)
 	}
+	sortPositions(positions)
+	return positions
+}
+
+// MarkForDeconstruction adds a construct's position to the marked-for-deconstruction pool.
+// Returns false if there is no construct at the position.
+func (m *Map) MarkForDeconstruction(pos types.Position) bool {
+	if m.ConstructAt(pos) == nil {
+		return false
+	}
+	m.markedForDeconstruction[pos] = true
+	return true
+}
+
+// UnmarkForDeconstruction removes a position from the marked-for-deconstruction pool.
+func (m *Map) UnmarkForDeconstruction(pos types.Position) {
+	delete(m.markedForDeconstruction, pos)
+}
+
+// IsMarkedForDeconstruction returns true if the position is in the marked-for-deconstruction pool.
+func (m *Map) IsMarkedForDeconstruction(pos types.Position) bool {
+	return m.markedForDeconstruction[pos]
+}
+
+// MarkedForDeconstructionPositions returns all positions in the marked-for-deconstruction pool, in row-major order.
+func (m *Map) MarkedForDeconstructionPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.markedForDeconstruction))
+	for pos := range m.markedForDeconstruction {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
 	return positions
 }
 
@@ -712,12 +917,13 @@ func (m *Map) IsManuallyWatered(pos types.Position) bool {
 	return m.wateredTimers[pos] > 0
 }
 
-// WateredPositions returns all positions that are currently manually watered.
+// WateredPositions returns all positions that are currently manually watered, in row-major order.
 func (m *Map) WateredPositions() []types.Position {
 	positions := make([]types.Position, 0, len(m.wateredTimers))
 	for pos := range m.wateredTimers {
 		positions = append(positions, pos)
 	}
+	sortPositions(positions)
 	return positions
 }
 
@@ -733,6 +939,16 @@ func (m *Map) WateredTimer(pos types.Position) float64 {
 	return m.wateredTimers[pos]
 }
 
+// SetGameTime records the elapsed game time, in seconds, for time-of-day lookups
+func (m *Map) SetGameTime(t float64) {
+	m.gameTime = t
+}
+
+// GameTime returns the elapsed game time, in seconds, as of the current tick
+func (m *Map) GameTime() float64 {
+	return m.gameTime
+}
+
 // UpdateWateredTimers decrements all watered tile timers and removes expired ones.
 func (m *Map) UpdateWateredTimers(delta float64) {
 	for pos, remaining := range m.wateredTimers {
@@ -883,6 +883,48 @@ func TestMarkedForConstructionPositions_ReturnsAll(t *testing.T) {
 	}
 }
 
+func TestMarkForDeconstruction_RequiresConstruct(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	empty := types.Position{X: 3, Y: 3}
+	if m.MarkForDeconstruction(empty) {
+		t.Error("MarkForDeconstruction() should return false without a construct")
+	}
+
+	pos := types.Position{X: 5, Y: 5}
+	m.AddConstruct(entity.NewFence(pos.X, pos.Y, "stick", types.ColorBrown))
+	if !m.MarkForDeconstruction(pos) {
+		t.Fatal("MarkForDeconstruction() should return true for a construct")
+	}
+	if !m.IsMarkedForDeconstruction(pos) {
+		t.Error("IsMarkedForDeconstruction() should return true after marking")
+	}
+	if got := m.MarkedForDeconstructionPositions(); len(got) != 1 || got[0] != pos {
+		t.Errorf("MarkedForDeconstructionPositions(): got %v, want [%v]", got, pos)
+	}
+
+	m.UnmarkForDeconstruction(pos)
+	if m.IsMarkedForDeconstruction(pos) {
+		t.Error("IsMarkedForDeconstruction() should return false after unmarking")
+	}
+}
+
+func TestRemoveConstruct_ClearsDeconstructionMark(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	fence := entity.NewFence(5, 5, "stick", types.ColorBrown)
+	m.AddConstruct(fence)
+	m.MarkForDeconstruction(fence.Pos())
+
+	m.RemoveConstruct(fence)
+
+	if m.IsMarkedForDeconstruction(fence.Pos()) {
+		t.Error("Removing a construct should clear its deconstruction mark")
+	}
+}
+
 func TestHutFootprint_WallRoleAssignment(t *testing.T) {
 	t.Parallel()
 
@@ -1432,3 +1474,24 @@ func TestHutPlacement_AnchorStory(t *testing.T) {
 	}
 	m.UnmarkForConstruction(types.Position{X: 10, Y: 10})
 }
+
+func TestMoveCreature_ToOccupiedPosition(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	rabbit := entity.NewCreature(5, 5, "rabbit")
+	other := entity.NewCreature(6, 5, "rabbit")
+	m.AddCreature(rabbit)
+	m.AddCreature(other)
+
+	if m.MoveCreature(rabbit, types.Position{X: 6, Y: 5}) {
+		t.Error("MoveCreature() should fail onto a tile holding another creature")
+	}
+	if rabbit.Pos() != (types.Position{X: 5, Y: 5}) {
+		t.Errorf("Creature should stay at (5,5), got %v", rabbit.Pos())
+	}
+
+	if !m.MoveCreature(rabbit, types.Position{X: 5, Y: 6}) {
+		t.Error("MoveCreature() should succeed onto an empty tile")
+	}
+}
@@ -0,0 +1,101 @@
+package game
+
+import (
+	"petri/internal/config"
+	"petri/internal/rng"
+	"petri/internal/types"
+)
+
+// SetOutcrop places rock outcrop terrain at the given position
+func (m *Map) SetOutcrop(pos types.Position) {
+	m.outcrops[pos] = true
+	m.updateRegionsAround(pos)
+}
+
+// IsOutcrop returns true if there is impassable rock at the position
+func (m *Map) IsOutcrop(pos types.Position) bool {
+	return m.outcrops[pos]
+}
+
+// HasOutcrops returns true if any rock outcrop tiles exist on the map
+func (m *Map) HasOutcrops() bool {
+	return len(m.outcrops) > 0
+}
+
+// OutcropPositions returns all positions that have rock outcrop terrain, in row-major order
+func (m *Map) OutcropPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.outcrops))
+	for pos := range m.outcrops {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// FindOutcropAdjacentEmptyTiles returns all empty tiles cardinally adjacent to rock outcrops
+func FindOutcropAdjacentEmptyTiles(m *Map) []types.Position {
+	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	seen := make(map[types.Position]bool)
+	var result []types.Position
+
+	for _, rockPos := range m.OutcropPositions() {
+		for _, dir := range cardinalDirs {
+			adj := types.Position{X: rockPos.X + dir[0], Y: rockPos.Y + dir[1]}
+			if !m.IsValid(adj) || seen[adj] {
+				continue
+			}
+			seen[adj] = true
+			if m.IsEmpty(adj) {
+				result = append(result, adj)
+			}
+		}
+	}
+	return result
+}
+
+// spawnOutcrops breaks OutcropMinCount-OutcropMaxCount rock outcrops through the highest ground, each a
+// clump of OutcropMinSize-OutcropMaxSize tiles grown outward from a tile at least OutcropElevation high.
+// Rock only goes where water could (see canFlood) and never onto clay, so it never buries trees,
+// characters or features, and never cuts the land in two. A clump that runs out of room stays small.
+func spawnOutcrops(m *Map, elevation [][]float64) {
+	var sources []types.Position
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			pos := types.Position{X: x, Y: y}
+			if elevation[y][x] >= config.OutcropElevation && canRaiseRock(m, pos) {
+				sources = append(sources, pos)
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return
+	}
+
+	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	count := config.OutcropMinCount + rng.Intn(config.OutcropMaxCount-config.OutcropMinCount+1)
+	for i := 0; i < count; i++ {
+		start := sources[rng.Intn(len(sources))]
+		if !canRaiseRock(m, start) {
+			continue // Already taken by an earlier outcrop
+		}
+		size := config.OutcropMinSize + rng.Intn(config.OutcropMaxSize-config.OutcropMinSize+1)
+		m.outcrops[start] = true
+		clump := []types.Position{start}
+		for attempt := 0; len(clump) < size && attempt < size*4; attempt++ {
+			from := clump[rng.Intn(len(clump))]
+			dir := cardinalDirs[rng.Intn(len(cardinalDirs))]
+			next := types.Position{X: from.X + dir[0], Y: from.Y + dir[1]}
+			if canRaiseRock(m, next) {
+				m.outcrops[next] = true
+				clump = append(clump, next)
+			}
+		}
+	}
+	m.RecomputeRegions()
+}
+
+// canRaiseRock returns true if an outcrop can break through at pos during world generation:
+// anywhere water could go (see canFlood) except clay
+func canRaiseRock(m *Map, pos types.Position) bool {
+	return canFlood(m, pos) && !m.IsClay(pos)
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/types"
+)
+
+func TestOutcrop_BlocksMovementAndSpawning(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	rock := types.Position{X: 4, Y: 4}
+	m.SetOutcrop(rock)
+
+	if !m.IsOutcrop(rock) || !m.HasOutcrops() {
+		t.Fatal("Expected an outcrop at the position")
+	}
+	if !m.IsBlocked(rock) || m.IsEmpty(rock) {
+		t.Error("Expected rock to block movement and placement")
+	}
+	if m.CanMarkForDigging(rock) {
+		t.Error("Expected rock not to be diggable")
+	}
+}
+
+func TestFindOutcropAdjacentEmptyTiles(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	m.SetOutcrop(types.Position{X: 4, Y: 4})
+	m.SetOutcrop(types.Position{X: 5, Y: 4})
+	m.AddWater(types.Position{X: 4, Y: 5}, WaterPond)
+
+	tiles := FindOutcropAdjacentEmptyTiles(m)
+
+	// Around the 2×1 clump: 6 neighbours, one of them water
+	if len(tiles) != 5 {
+		t.Errorf("Expected 5 empty tiles beside the rock, got %d: %v", len(tiles), tiles)
+	}
+	for _, pos := range tiles {
+		if m.IsOutcrop(pos) || m.IsWater(pos) {
+			t.Errorf("Expected only empty tiles, got %v", pos)
+		}
+	}
+}
+
+func TestSpawnOutcrops_OnHighGroundWithoutSplittingLand(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(30, 30)
+	elevation := make([][]float64, m.Height)
+	for y := range elevation {
+		elevation[y] = make([]float64, m.Width)
+		for x := range elevation[y] {
+			if x >= 10 && x < 20 && y >= 10 && y < 20 {
+				elevation[y][x] = config.OutcropElevation + 0.1
+			}
+		}
+	}
+
+	spawnOutcrops(m, elevation)
+
+	rocks := m.OutcropPositions()
+	if len(rocks) < config.OutcropMinSize {
+		t.Fatalf("Expected at least one clump of rock, got %d tiles", len(rocks))
+	}
+	for _, pos := range rocks {
+		if pos.X < 10-config.OutcropMaxSize || pos.X >= 20+config.OutcropMaxSize || pos.Y < 10-config.OutcropMaxSize || pos.Y >= 20+config.OutcropMaxSize {
+			t.Errorf("Expected rock at %v to grow out of the high ground", pos)
+		}
+	}
+	if !isMapConnected(m) {
+		t.Error("Expected outcrops to leave the open land connected")
+	}
+}
@@ -0,0 +1,200 @@
+package game
+
+import (
+	"sort"
+
+	"petri/internal/types"
+)
+
+// RegionKind labels what an enclosed region is used for
+type RegionKind int
+
+const (
+	RegionGarden      RegionKind = iota // Closed off by fences (possibly alongside hut walls)
+	RegionHutInterior                   // Closed off by hut walls and doors (possibly alongside tree wood)
+	RegionTreeHollow                    // Closed off by tree wood only
+)
+
+// Region is a connected area of open tiles that constructs and tree wood (and water) close off
+// from the map edge. Tiles connect in all 8 directions, so a diagonal gap between fences is a way out.
+type Region struct {
+	ID    int
+	Kind  RegionKind
+	Tiles []types.Position // Sorted by row, then column
+}
+
+// Contains returns true if the position is one of the region's tiles
+func (r *Region) Contains(pos types.Position) bool {
+	i := sort.Search(len(r.Tiles), func(i int) bool { return !positionLess(r.Tiles[i], pos) })
+	return i < len(r.Tiles) && r.Tiles[i] == pos
+}
+
+// IsShelter returns true for regions characters can shelter in: hut interiors and tree hollows
+func (r *Region) IsShelter() bool {
+	return r.Kind == RegionHutInterior || r.Kind == RegionTreeHollow
+}
+
+// IsEnclosed returns true if the position lies inside an enclosed region
+func (m *Map) IsEnclosed(pos types.Position) bool {
+	return m.regionAt[pos] != nil
+}
+
+// RegionOf returns the enclosed region containing the position, or nil
+func (m *Map) RegionOf(pos types.Position) *Region {
+	return m.regionAt[pos]
+}
+
+// Regions returns all enclosed regions ordered by ID
+func (m *Map) Regions() []*Region {
+	regions := make([]*Region, 0, len(m.regions))
+	for _, r := range m.regions {
+		regions = append(regions, r)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i].ID < regions[j].ID })
+	return regions
+}
+
+// RecomputeRegions rebuilds every enclosed region from scratch
+func (m *Map) RecomputeRegions() {
+	m.regions = make(map[int]*Region)
+	m.regionAt = make(map[types.Position]*Region)
+	if len(m.constructs) == 0 && len(m.trees) == 0 {
+		return
+	}
+	barriers := m.barrierPositions()
+	visited := make(map[types.Position]bool)
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			m.floodRegion(types.Position{X: x, Y: y}, barriers, visited)
+		}
+	}
+}
+
+// updateRegionsAround re-analyzes the regions touching a tile whose barrier state just changed.
+// Only the tile and its neighbors are re-flooded: a region that a new barrier splits, or that a
+// removed barrier merges, always has tiles next to the changed one.
+func (m *Map) updateRegionsAround(pos types.Position) {
+	if len(m.constructs) == 0 && len(m.trees) == 0 && len(m.regions) == 0 {
+		return // Nothing encloses anything without constructs or trees
+	}
+
+	seeds := []types.Position{pos}
+	for _, dir := range regionDirs {
+		seeds = append(seeds, types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]})
+	}
+	for _, seed := range seeds {
+		if r := m.regionAt[seed]; r != nil {
+			m.dropRegion(r)
+		}
+	}
+
+	barriers := m.barrierPositions()
+	visited := make(map[types.Position]bool)
+	for _, seed := range seeds {
+		m.floodRegion(seed, barriers, visited)
+	}
+}
+
+// regionDirs are the 8 directions region tiles connect in
+var regionDirs = [8][2]int{{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+
+// floodRegion flood-fills the open area containing start and registers it as a region if it
+// never reaches the map edge and at least one construct or tree borders it. Already-visited tiles are skipped.
+func (m *Map) floodRegion(start types.Position, barriers map[types.Position]string, visited map[types.Position]bool) {
+	if visited[start] || !m.regionOpen(start, barriers) {
+		return
+	}
+
+	visited[start] = true
+	queue := []types.Position{start}
+	var tiles []types.Position
+	touchesEdge := false
+	bordered := false
+	hasFence, hasHut := false, false
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+		tiles = append(tiles, pos)
+		if pos.X == 0 || pos.Y == 0 || pos.X == m.Width-1 || pos.Y == m.Height-1 {
+			touchesEdge = true
+		}
+		for _, dir := range regionDirs {
+			next := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
+			if kind, ok := barriers[next]; ok {
+				bordered = true
+				switch kind {
+				case "hut":
+					hasHut = true
+				case treeBarrier:
+					// Tree wood shelters whatever it borders
+				default:
+					hasFence = true
+				}
+				continue
+			}
+			if visited[next] || !m.regionOpen(next, barriers) {
+				continue
+			}
+			visited[next] = true
+			queue = append(queue, next)
+		}
+	}
+	if touchesEdge || !bordered {
+		return
+	}
+
+	m.nextRegionID++
+	region := &Region{ID: m.nextRegionID, Kind: RegionGarden, Tiles: tiles}
+	if !hasFence {
+		region.Kind = RegionTreeHollow
+		if hasHut {
+			region.Kind = RegionHutInterior
+		}
+	}
+	sort.Slice(region.Tiles, func(i, j int) bool { return positionLess(region.Tiles[i], region.Tiles[j]) })
+	m.regions[region.ID] = region
+	for _, pos := range tiles {
+		m.regionAt[pos] = region
+	}
+}
+
+// regionOpen returns true if the tile can be part of a region (on the map, not a barrier, water or rock)
+func (m *Map) regionOpen(pos types.Position, barriers map[types.Position]string) bool {
+	if !m.IsValid(pos) {
+		return false
+	}
+	if _, ok := barriers[pos]; ok {
+		return false
+	}
+	return !m.IsWater(pos) && !m.IsOutcrop(pos)
+}
+
+// dropRegion forgets a region and unlabels its tiles
+func (m *Map) dropRegion(r *Region) {
+	for _, pos := range r.Tiles {
+		delete(m.regionAt, pos)
+	}
+	delete(m.regions, r.ID)
+}
+
+// treeBarrier is the barrier kind of tree tiles that close off regions
+const treeBarrier = "tree"
+
+// barrierPositions maps each construct's position to its kind, and each tree tile that closes
+// off regions (solid wood and openings, but not hollows) to treeBarrier. Campfires are skipped:
+// a fire sits inside a room rather than walling it, so it must not turn a hut into a garden.
+func (m *Map) barrierPositions() map[types.Position]string {
+	positions := make(map[types.Position]string, len(m.constructs)+len(m.trees))
+	for pos, tile := range m.trees {
+		if tile != TreeHollow {
+			positions[pos] = treeBarrier
+		}
+	}
+	for _, c := range m.constructs {
+		if c.IsCampfire() {
+			continue
+		}
+		positions[c.Pos()] = c.Kind
+	}
+	return positions
+}
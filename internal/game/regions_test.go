@@ -0,0 +1,191 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+// addFenceRing places a fence ring around the rectangle (x0, y0)-(x1, y1), returning the fences
+func addFenceRing(m *Map, x0, y0, x1, y1 int) []*entity.Construct {
+	var fences []*entity.Construct
+	for y := y0 - 1; y <= y1+1; y++ {
+		for x := x0 - 1; x <= x1+1; x++ {
+			if x >= x0 && x <= x1 && y >= y0 && y <= y1 {
+				continue
+			}
+			f := entity.NewFence(x, y, "stick", types.ColorBrown)
+			m.AddConstruct(f)
+			fences = append(fences, f)
+		}
+	}
+	return fences
+}
+
+func TestRegions_HutInterior(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestHut(m, 5, 5)
+
+	region := m.RegionOf(types.Position{X: 7, Y: 7})
+	if region == nil {
+		t.Fatal("Expected the hut's middle to be enclosed")
+	}
+	if region.Kind != RegionHutInterior {
+		t.Errorf("Expected a hut interior, got kind %d", region.Kind)
+	}
+	if len(region.Tiles) != 9 {
+		t.Errorf("Expected a 3×3 interior, got %d tiles", len(region.Tiles))
+	}
+	for _, pos := range []types.Position{{X: 7, Y: 9}, {X: 7, Y: 10}, {X: 2, Y: 2}} {
+		if m.IsEnclosed(pos) {
+			t.Errorf("Expected %v not enclosed", pos)
+		}
+	}
+	if !region.Contains(types.Position{X: 8, Y: 8}) || region.Contains(types.Position{X: 9, Y: 8}) {
+		t.Error("Region.Contains disagrees with the interior")
+	}
+}
+
+func TestRegions_CampfireInsideHutStaysInterior(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestHut(m, 5, 5)
+	m.AddConstruct(entity.NewCampfire(7, 7))
+
+	region := m.RegionOf(types.Position{X: 6, Y: 6})
+	if region == nil {
+		t.Fatal("Expected the hut's middle to be enclosed")
+	}
+	if region.Kind != RegionHutInterior {
+		t.Errorf("Expected a campfire to leave a hut interior, got kind %d", region.Kind)
+	}
+	if !region.Contains(types.Position{X: 7, Y: 7}) {
+		t.Error("Expected the campfire tile to be part of the interior")
+	}
+}
+
+func TestRegions_FencedGarden(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	addFenceRing(m, 3, 3, 6, 5)
+
+	region := m.RegionOf(types.Position{X: 4, Y: 4})
+	if region == nil || region.Kind != RegionGarden {
+		t.Fatalf("Expected a garden enclosure, got %+v", region)
+	}
+	if len(region.Tiles) != 12 {
+		t.Errorf("Expected 4×3 = 12 enclosed tiles, got %d", len(region.Tiles))
+	}
+	if len(m.Regions()) != 1 {
+		t.Errorf("Expected exactly one region, got %d", len(m.Regions()))
+	}
+}
+
+func TestRegions_DiagonalGapLeaks(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	fences := addFenceRing(m, 3, 3, 5, 5)
+	// Remove a corner post: the diagonal gap opens the pen
+	for _, f := range fences {
+		if f.Pos() == (types.Position{X: 2, Y: 2}) {
+			m.RemoveConstruct(f)
+		}
+	}
+
+	if m.IsEnclosed(types.Position{X: 4, Y: 4}) {
+		t.Error("Expected a missing corner post to open the enclosure")
+	}
+}
+
+func TestRegions_IncrementalSplitAndMerge(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	addFenceRing(m, 3, 3, 7, 5)
+	// A dividing fence splits the pen in two
+	divider := []*entity.Construct{
+		entity.NewFence(5, 3, "stick", types.ColorBrown),
+		entity.NewFence(5, 4, "stick", types.ColorBrown),
+		entity.NewFence(5, 5, "stick", types.ColorBrown),
+	}
+	for _, f := range divider {
+		m.AddConstruct(f)
+	}
+
+	left, right := m.RegionOf(types.Position{X: 3, Y: 4}), m.RegionOf(types.Position{X: 7, Y: 4})
+	if left == nil || right == nil || left == right {
+		t.Fatalf("Expected two separate regions, got %v and %v", left, right)
+	}
+	if m.IsEnclosed(types.Position{X: 5, Y: 4}) {
+		t.Error("Expected the divider tile not to be part of a region")
+	}
+
+	// Taking a divider post out merges them again
+	m.RemoveConstruct(divider[1])
+	merged := m.RegionOf(types.Position{X: 3, Y: 4})
+	if merged == nil || merged != m.RegionOf(types.Position{X: 7, Y: 4}) || !merged.Contains(types.Position{X: 5, Y: 4}) {
+		t.Fatalf("Expected one merged region including the opened tile, got %+v", merged)
+	}
+	if len(m.Regions()) != 1 {
+		t.Errorf("Expected stale regions to be dropped, got %d", len(m.Regions()))
+	}
+}
+
+func TestRegions_WaterClosesFenceLine(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	// Fence on three sides, pond on the fourth
+	for x := 2; x <= 6; x++ {
+		m.AddConstruct(entity.NewFence(x, 2, "stick", types.ColorBrown))
+		m.AddWater(types.Position{X: x, Y: 6}, WaterPond)
+	}
+	for y := 3; y <= 5; y++ {
+		m.AddConstruct(entity.NewFence(2, y, "stick", types.ColorBrown))
+		m.AddConstruct(entity.NewFence(6, y, "stick", types.ColorBrown))
+	}
+	if !m.IsEnclosed(types.Position{X: 4, Y: 4}) {
+		t.Fatal("Expected fences and water together to enclose the area")
+	}
+
+	m.RemoveWater(types.Position{X: 4, Y: 6})
+	if m.IsEnclosed(types.Position{X: 4, Y: 4}) {
+		t.Error("Expected draining a pond tile to open the enclosure")
+	}
+}
+
+func TestRegions_RecomputeMatchesIncremental(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestHut(m, 2, 2)
+	addFenceRing(m, 11, 11, 14, 13)
+
+	incremental := make(map[types.Position]RegionKind)
+	for _, r := range m.Regions() {
+		for _, pos := range r.Tiles {
+			incremental[pos] = r.Kind
+		}
+	}
+
+	m.RecomputeRegions()
+
+	recomputed := 0
+	for _, r := range m.Regions() {
+		for _, pos := range r.Tiles {
+			recomputed++
+			if kind, ok := incremental[pos]; !ok || kind != r.Kind {
+				t.Errorf("Tile %v differs after a full recompute", pos)
+			}
+		}
+	}
+	if recomputed != len(incremental) {
+		t.Errorf("Expected %d enclosed tiles after recompute, got %d", len(incremental), recomputed)
+	}
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"petri/internal/config"
+	"petri/internal/types"
+)
+
+// Fertility returns how well the soil at pos grows plants: 1 for freshly tilled soil, lower once
+// worn out by repeated crops and higher once composted. Untilled ground is always 1.
+func (m *Map) Fertility(pos types.Position) float64 {
+	if !m.tilled[pos] {
+		return 1
+	}
+	return m.fertility[pos]
+}
+
+// SetSoil restores a tilled tile's fertility and last crop (world load)
+func (m *Map) SetSoil(pos types.Position, fertility float64, lastCrop string) {
+	m.fertility[pos] = fertility
+	if lastCrop != "" {
+		m.lastCrop[pos] = lastCrop
+	}
+}
+
+// LastCrop returns the item type last harvested from the tilled tile at pos, or "" if none
+func (m *Map) LastCrop(pos types.Position) string {
+	return m.lastCrop[pos]
+}
+
+// HarvestSoil records a crop harvested from pos. Harvesting the same crop as last time wears the
+// soil out by SoilHarvestDepletion, down to SoilFertilityMin. No-op on untilled ground.
+func (m *Map) HarvestSoil(pos types.Position, itemType string) {
+	if !m.tilled[pos] {
+		return
+	}
+	if m.lastCrop[pos] == itemType {
+		m.fertility[pos] = max(m.fertility[pos]-config.SoilHarvestDepletion, config.SoilFertilityMin)
+	}
+	m.lastCrop[pos] = itemType
+}
+
+// PlantSoil records a crop planted at pos. Planting something other than the last crop rotates
+// the soil: it regains SoilRotationRecovery (up to 1) and the new crop's first harvest is free.
+// No-op on untilled ground.
+func (m *Map) PlantSoil(pos types.Position, itemType string) {
+	last, ok := m.lastCrop[pos]
+	if !m.tilled[pos] || !ok || last == itemType {
+		return
+	}
+	m.fertility[pos] = max(m.fertility[pos], min(m.fertility[pos]+config.SoilRotationRecovery, 1))
+	delete(m.lastCrop, pos)
+}
+
+// CompostSoil works compost into the tilled tile at pos, up to SoilFertilityMax.
+// Returns false on untilled ground.
+func (m *Map) CompostSoil(pos types.Position, amount float64) bool {
+	if !m.tilled[pos] {
+		return false
+	}
+	m.fertility[pos] = max(m.fertility[pos], min(m.fertility[pos]+amount, config.SoilFertilityMax))
+	return true
+}
+
+// CanCompost returns true if the tilled tile at pos can take more compost
+func (m *Map) CanCompost(pos types.Position) bool {
+	return m.tilled[pos] && m.fertility[pos] < config.SoilFertilityMax
+}
+
+// RestFallowSoil lets tilled tiles with no growing plant recover toward fertility 1, from
+// SoilFertilityMin to 1 over SoilFallowRecoveryTime seconds
+func (m *Map) RestFallowSoil(delta float64) {
+	if config.SoilFallowRecoveryTime <= 0 {
+		return
+	}
+	rate := (1 - config.SoilFertilityMin) / config.SoilFallowRecoveryTime
+	for pos := range m.tilled {
+		if m.fertility[pos] < 1 && !m.hasGrowingPlantAt(pos) {
+			m.fertility[pos] = min(m.fertility[pos]+rate*delta, 1)
+		}
+	}
+}
+
+// hasGrowingPlantAt returns true if a growing plant stands at pos
+func (m *Map) hasGrowingPlantAt(pos types.Position) bool {
+	for _, item := range m.ItemsAt(pos) {
+		if item.Plant != nil && item.Plant.IsGrowing {
+			return true
+		}
+	}
+	return false
+}
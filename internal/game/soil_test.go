@@ -0,0 +1,115 @@
+package game
+
+import (
+	"math"
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+func TestHarvestSoil_SameCropDepletesToMin(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	m.SetTilled(pos)
+
+	m.HarvestSoil(pos, "berry")
+	if got := m.Fertility(pos); got != 1 {
+		t.Errorf("Expected the first harvest to be free, got fertility %.2f", got)
+	}
+	if m.LastCrop(pos) != "berry" {
+		t.Errorf("Expected last crop berry, got %q", m.LastCrop(pos))
+	}
+	m.HarvestSoil(pos, "berry")
+	if got, want := m.Fertility(pos), 1-config.SoilHarvestDepletion; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected fertility %.2f after a repeat harvest, got %.2f", want, got)
+	}
+	for i := 0; i < 20; i++ {
+		m.HarvestSoil(pos, "berry")
+	}
+	if got := m.Fertility(pos); got != config.SoilFertilityMin {
+		t.Errorf("Expected fertility to floor at %.2f, got %.2f", config.SoilFertilityMin, got)
+	}
+
+	untilled := types.Position{X: 1, Y: 1}
+	m.HarvestSoil(untilled, "berry")
+	m.HarvestSoil(untilled, "berry")
+	if m.Fertility(untilled) != 1 || m.LastCrop(untilled) != "" {
+		t.Error("Expected untilled ground to be unaffected by harvests")
+	}
+}
+
+func TestPlantSoil_RotationRecovers(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	m.SetTilled(pos)
+	m.SetSoil(pos, 0.5, "berry")
+
+	m.PlantSoil(pos, "berry")
+	if got := m.Fertility(pos); got != 0.5 {
+		t.Errorf("Expected replanting the same crop not to help, got %.2f", got)
+	}
+
+	m.PlantSoil(pos, "gourd")
+	if got, want := m.Fertility(pos), 0.5+config.SoilRotationRecovery; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected fertility %.2f after rotating crops, got %.2f", want, got)
+	}
+	if m.LastCrop(pos) != "" {
+		t.Errorf("Expected rotation to clear the last crop, got %q", m.LastCrop(pos))
+	}
+}
+
+func TestCompostSoil_CapsAtMax(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	if m.CompostSoil(pos, 0.3) || m.CanCompost(pos) {
+		t.Error("Expected untilled ground not to take compost")
+	}
+
+	m.SetTilled(pos)
+	for m.CanCompost(pos) {
+		m.CompostSoil(pos, 0.3)
+	}
+	if got := m.Fertility(pos); got != config.SoilFertilityMax {
+		t.Errorf("Expected compost to cap fertility at %.2f, got %.2f", config.SoilFertilityMax, got)
+	}
+}
+
+func TestRestFallowSoil_OnlyRecoversUnplantedTiles(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	fallow := types.Position{X: 2, Y: 2}
+	planted := types.Position{X: 5, Y: 5}
+	rich := types.Position{X: 7, Y: 7}
+	for _, pos := range []types.Position{fallow, planted, rich} {
+		m.SetTilled(pos)
+	}
+	m.SetSoil(fallow, config.SoilFertilityMin, "")
+	m.SetSoil(planted, config.SoilFertilityMin, "")
+	m.SetSoil(rich, config.SoilFertilityMax, "")
+	m.AddItem(entity.NewBerry(planted.X, planted.Y, types.ColorRed, false, false))
+
+	m.RestFallowSoil(config.SoilFallowRecoveryTime / 2)
+	if got, want := m.Fertility(fallow), (1+config.SoilFertilityMin)/2; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected fallow soil halfway recovered to %.2f, got %.2f", want, got)
+	}
+	if got := m.Fertility(planted); got != config.SoilFertilityMin {
+		t.Errorf("Expected planted soil not to recover, got %.2f", got)
+	}
+
+	m.RestFallowSoil(config.SoilFallowRecoveryTime)
+	if got := m.Fertility(fallow); got != 1 {
+		t.Errorf("Expected fallow soil to recover to 1, got %.2f", got)
+	}
+	if got := m.Fertility(rich); got != config.SoilFertilityMax {
+		t.Errorf("Expected composted soil to keep its richness, got %.2f", got)
+	}
+}
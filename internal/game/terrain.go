@@ -0,0 +1,374 @@
+package game
+
+import (
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/rng"
+	"petri/internal/types"
+)
+
+// Biome is the kind of land a tile belongs to, derived from elevation and moisture at world generation
+type Biome int
+
+const (
+	BiomeMeadow     Biome = iota // Middling ground (the default)
+	BiomeWetland                 // Low, wet ground
+	BiomeForestEdge              // High, damp ground where trees grow
+	BiomeClayFlats               // Low, dry ground where clay settles first
+)
+
+// biomeIDs are the stable biome identifiers, indexed by Biome
+var biomeIDs = [...]string{"meadow", "wetland", "forest_edge", "clay_flats"}
+
+// ID returns the stable identifier used in config, i18n keys and the API
+func (b Biome) ID() string {
+	return biomeIDs[b]
+}
+
+// ParseBiome returns the biome with the given ID, or false if there is none
+func ParseBiome(id string) (Biome, bool) {
+	for i, bid := range biomeIDs {
+		if bid == id {
+			return Biome(i), true
+		}
+	}
+	return BiomeMeadow, false
+}
+
+// SetBiome records the biome of a tile
+func (m *Map) SetBiome(pos types.Position, biome Biome) {
+	if biome == BiomeMeadow {
+		delete(m.biomes, pos)
+	} else {
+		m.biomes[pos] = biome
+	}
+}
+
+// BiomeAt returns the biome of a tile (meadow where none was recorded)
+func (m *Map) BiomeAt(pos types.Position) Biome {
+	return m.biomes[pos]
+}
+
+// HasBiomes returns true if terrain generation has recorded biomes on this map
+func (m *Map) HasBiomes() bool {
+	return len(m.biomes) > 0
+}
+
+// BiomePositions returns all positions with a non-meadow biome, in row-major order
+func (m *Map) BiomePositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.biomes))
+	for pos := range m.biomes {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// TerrainParams are the knobs of terrain generation, chosen at world creation
+type TerrainParams struct {
+	ID         string  // Stable identifier used in i18n keys
+	Scale      float64 // Size in tiles of the largest hills and hollows
+	Moisture   float64 // Added to the moisture field (positive is wetter)
+	WaterLevel float64 // Elevation below which ground floods into ponds
+	Rivers     int     // Rivers carved downhill from high ground
+}
+
+// TerrainPresets are the terrain options offered at world creation; the first is the default
+var TerrainPresets = []TerrainParams{
+	{ID: "balanced", Scale: 14, Moisture: 0, WaterLevel: 0.25, Rivers: 1},
+	{ID: "wetlands", Scale: 12, Moisture: 0.15, WaterLevel: 0.32, Rivers: 2},
+	{ID: "dry", Scale: 16, Moisture: -0.15, WaterLevel: 0.18, Rivers: 0},
+	{ID: "rugged", Scale: 7, Moisture: 0, WaterLevel: 0.25, Rivers: 1},
+}
+
+// TerrainPreset returns the preset with the given ID, falling back to the default
+func TerrainPreset(id string) TerrainParams {
+	for _, p := range TerrainPresets {
+		if p.ID == id {
+			return p
+		}
+	}
+	return TerrainPresets[0]
+}
+
+// GenerateTerrain builds the world's terrain from seeded noise: elevation and moisture fields decide
+// each tile's biome, the lowest ground floods into ponds, rivers run downhill from high ground, clay
+// settles by the water (clay flats first), trees grow mostly at the forest edge and rock breaks
+// through on the highest ground.
+// Water never cuts the land in two: a tile only floods if the land around it stays connected.
+// With noWater, only biomes, trees and outcrops are generated. Must be called after characters are placed
+// and before features and items.
+func GenerateTerrain(m *Map, params TerrainParams, noWater bool) {
+	elevation := noiseField(m.Width, m.Height, params.Scale, config.TerrainNoiseOctaves)
+	moisture := noiseField(m.Width, m.Height, params.Scale, config.TerrainNoiseOctaves)
+
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			m.SetBiome(types.Position{X: x, Y: y}, classifyBiome(elevation[y][x], moisture[y][x]+params.Moisture))
+		}
+	}
+
+	if !noWater {
+		floodLowlands(m, elevation, params.WaterLevel)
+		for i := 0; i < params.Rivers; i++ {
+			carveRiver(m, elevation)
+		}
+		SpawnClay(m)
+	}
+	SpawnTrees(m)
+	spawnOutcrops(m, elevation)
+}
+
+// classifyBiome returns the biome for a tile's elevation and moisture
+func classifyBiome(elevation, moisture float64) Biome {
+	switch {
+	case moisture >= config.WetlandMoisture && elevation < config.ForestElevation:
+		return BiomeWetland
+	case moisture >= config.ForestMoisture && elevation >= config.ForestElevation:
+		return BiomeForestEdge
+	case elevation < config.ClayFlatsElevation:
+		return BiomeClayFlats
+	default:
+		return BiomeMeadow
+	}
+}
+
+// noiseField returns a width×height field of fractal value noise, stretched to span 0-1.
+// The first octave varies over cells scale tiles across; each further octave halves the cell
+// size and the amplitude.
+func noiseField(width, height int, scale float64, octaves int) [][]float64 {
+	field := make([][]float64, height)
+	for y := range field {
+		field[y] = make([]float64, width)
+	}
+
+	cell, amplitude := scale, 1.0
+	for o := 0; o < octaves; o++ {
+		if cell < 1 {
+			cell = 1
+		}
+		// Random values on a lattice of cell corners, blended smoothly in between
+		cols := int(float64(width)/cell) + 2
+		rows := int(float64(height)/cell) + 2
+		lattice := make([][]float64, rows)
+		for j := range lattice {
+			lattice[j] = make([]float64, cols)
+			for i := range lattice[j] {
+				lattice[j][i] = rng.Float64()
+			}
+		}
+		for y := 0; y < height; y++ {
+			fy := float64(y) / cell
+			j := int(fy)
+			ty := smoothstep(fy - float64(j))
+			for x := 0; x < width; x++ {
+				fx := float64(x) / cell
+				i := int(fx)
+				tx := smoothstep(fx - float64(i))
+				top := lerp(lattice[j][i], lattice[j][i+1], tx)
+				bottom := lerp(lattice[j+1][i], lattice[j+1][i+1], tx)
+				field[y][x] += amplitude * lerp(top, bottom, ty)
+			}
+		}
+		cell /= 2
+		amplitude /= 2
+	}
+
+	// Stretch to 0-1 so thresholds mean the same thing at any scale
+	low, high := field[0][0], field[0][0]
+	for y := range field {
+		for _, v := range field[y] {
+			low = min(low, v)
+			high = max(high, v)
+		}
+	}
+	for y := range field {
+		for x := range field[y] {
+			if high > low {
+				field[y][x] = (field[y][x] - low) / (high - low)
+			} else {
+				field[y][x] = 0.5
+			}
+		}
+	}
+	return field
+}
+
+// smoothstep eases t (0-1) so noise has no creases at lattice lines
+func smoothstep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// lerp blends a and b by t (0-1)
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// floodLowlands turns ground below waterLevel into pond, lowest first, up to TerrainMaxPondTiles tiles
+func floodLowlands(m *Map, elevation [][]float64, waterLevel float64) {
+	var lowlands []types.Position
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			if elevation[y][x] < waterLevel {
+				lowlands = append(lowlands, types.Position{X: x, Y: y})
+			}
+		}
+	}
+	sort.SliceStable(lowlands, func(i, j int) bool {
+		a, b := lowlands[i], lowlands[j]
+		return elevation[a.Y][a.X] < elevation[b.Y][b.X]
+	})
+
+	flooded := 0
+	for _, pos := range lowlands {
+		if flooded >= config.TerrainMaxPondTiles {
+			break
+		}
+		if canFlood(m, pos) {
+			m.AddWater(pos, WaterPond)
+			flooded++
+		}
+	}
+}
+
+// carveRiver runs a river from a random tile of high ground until it reaches the map edge or other
+// water. It always steps to the lowest neighbor it hasn't visited, so it climbs out of hollows rather
+// than stopping in them. Tiles that would cut the land in two are left dry as fords. Rivers never
+// rise on the map edge itself, where they would drain off after a single tile.
+func carveRiver(m *Map, elevation [][]float64) {
+	var sources []types.Position
+	for y := 1; y < m.Height-1; y++ {
+		for x := 1; x < m.Width-1; x++ {
+			pos := types.Position{X: x, Y: y}
+			if elevation[y][x] >= config.RiverSourceElevation && canFlood(m, pos) {
+				sources = append(sources, pos)
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return
+	}
+
+	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	pos := sources[rng.Intn(len(sources))]
+	visited := map[types.Position]bool{pos: true}
+	for steps := 0; steps < m.Width+m.Height; steps++ {
+		if canFlood(m, pos) {
+			m.AddWater(pos, WaterRiver)
+		}
+
+		var next types.Position
+		found := false
+		for _, dir := range cardinalDirs {
+			neighbor := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
+			if !m.IsValid(neighbor) {
+				return // Flows off the map edge
+			}
+			if visited[neighbor] {
+				continue
+			}
+			if m.IsWater(neighbor) {
+				return // Joins a pond or another river
+			}
+			if !found || elevation[neighbor.Y][neighbor.X] < elevation[next.Y][next.X] {
+				next = neighbor
+				found = true
+			}
+		}
+		if !found {
+			return // Boxed in by its own course
+		}
+		visited[next] = true
+		pos = next
+	}
+}
+
+// canFlood returns true if water can be placed at pos during world generation: the tile is dry,
+// unoccupied and free of trees, rock and features, and flooding it keeps the land connected
+func canFlood(m *Map, pos types.Position) bool {
+	if !m.IsValid(pos) || m.IsWater(pos) || m.IsOccupied(pos) || m.TreeAt(pos) != TreeNone || m.IsOutcrop(pos) || m.FeatureAt(pos) != nil {
+		return false
+	}
+	return keepsLandConnected(m, pos)
+}
+
+// keepsLandConnected returns true if closing off the open tile at pos cannot disconnect the open
+// tiles around it. It walks the 8 surrounding tiles in order: if the open ones that touch pos
+// cardinally all lie in one unbroken run, they stay linked without pos, so the map stays connected
+// as long as it was before. Tiles off the map count as closed.
+func keepsLandConnected(m *Map, pos types.Position) bool {
+	var open [8]bool
+	for i, dir := range regionDirs {
+		neighbor := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
+		open[i] = m.IsValid(neighbor) && isOpenTerrain(m, neighbor)
+	}
+
+	// Start the walk just after a closed tile so no run wraps around the end
+	start := -1
+	for i := range open {
+		if !open[i] {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return true // Surrounded by open ground
+	}
+
+	runs := 0
+	inRun, runTouches := false, false
+	for k := 1; k <= 8; k++ {
+		i := (start + k) % 8
+		if open[i] {
+			inRun = true
+			if i%2 == 0 { // Even directions in regionDirs are cardinal
+				runTouches = true
+			}
+			continue
+		}
+		if inRun && runTouches {
+			runs++
+		}
+		inRun, runTouches = false, false
+	}
+	return runs <= 1
+}
+
+// findEmptySpotIn finds a random empty position (see findEmptySpot), preferring one of the given
+// biomes: it tries BiomeSpawnAttempts spots and settles for the last if none is in a wanted biome.
+// Without biomes on the map, it is findEmptySpot.
+func findEmptySpotIn(m *Map, biomes []Biome) (int, int) {
+	x, y := findEmptySpot(m)
+	if !m.HasBiomes() || len(biomes) == 0 {
+		return x, y
+	}
+	for attempt := 1; attempt < config.BiomeSpawnAttempts; attempt++ {
+		if biomeIn(m.BiomeAt(types.Position{X: x, Y: y}), biomes) {
+			break
+		}
+		x, y = findEmptySpot(m)
+	}
+	return x, y
+}
+
+// biomeIn returns true if b is one of biomes
+func biomeIn(b Biome, biomes []Biome) bool {
+	for _, want := range biomes {
+		if b == want {
+			return true
+		}
+	}
+	return false
+}
+
+// affinityBiomes returns the biomes config.BiomeAffinities lists for an item type or feature
+func affinityBiomes(kind string) []Biome {
+	var biomes []Biome
+	for _, id := range config.BiomeAffinities[kind] {
+		if b, ok := ParseBiome(id); ok {
+			biomes = append(biomes, b)
+		}
+	}
+	return biomes
+}
@@ -0,0 +1,206 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/rng"
+	"petri/internal/types"
+)
+
+func TestKeepsLandConnected(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	center := types.Position{X: 5, Y: 5}
+	if !keepsLandConnected(m, center) {
+		t.Error("Expected open ground to stay connected without one tile")
+	}
+
+	// A wall running north-south through center: flooding center would cut west from east
+	m.AddWater(types.Position{X: 5, Y: 4}, WaterPond)
+	m.AddWater(types.Position{X: 5, Y: 6}, WaterPond)
+	if keepsLandConnected(m, center) {
+		t.Error("Expected a gap in a wall of water not to flood")
+	}
+
+	// Extending the edge of a pond is fine
+	edge := types.Position{X: 5, Y: 3}
+	if !keepsLandConnected(m, edge) {
+		t.Error("Expected the end of a pond to be able to grow")
+	}
+
+	// A corner of the map counts its off-map neighbors as closed
+	if !keepsLandConnected(m, types.Position{X: 0, Y: 0}) {
+		t.Error("Expected a map corner to flood without splitting the land")
+	}
+}
+
+func TestNoiseField_SpansZeroToOne(t *testing.T) {
+	t.Parallel()
+
+	field := noiseField(30, 20, 8, config.TerrainNoiseOctaves)
+	low, high := 1.0, 0.0
+	for y := range field {
+		for _, v := range field[y] {
+			low = min(low, v)
+			high = max(high, v)
+		}
+	}
+	if low != 0 || high != 1 {
+		t.Errorf("Expected the field stretched to 0-1, got %.2f-%.2f", low, high)
+	}
+}
+
+func TestClassifyBiome(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		elevation, moisture float64
+		want                Biome
+	}{
+		{0.4, 0.8, BiomeWetland},
+		{0.7, 0.5, BiomeForestEdge},
+		{0.1, 0.2, BiomeClayFlats},
+		{0.6, 0.2, BiomeMeadow},
+	}
+	for _, c := range cases {
+		if got := classifyBiome(c.elevation, c.moisture); got != c.want {
+			t.Errorf("classifyBiome(%.1f, %.1f) = %s, want %s", c.elevation, c.moisture, got.ID(), c.want.ID())
+		}
+	}
+}
+
+// Not parallel: seeds the shared random source, so other tests must not
+// draw from it while the two terrains are generated.
+func TestGenerateTerrain_SameSeedSameTerrain(t *testing.T) {
+	generate := func() *Map {
+		rng.Seed(42)
+		m := NewMap(config.MapWidth, config.MapHeight)
+		GenerateTerrain(m, TerrainPresets[0], false)
+		return m
+	}
+	a, b := generate(), generate()
+
+	if len(a.BiomePositions()) == 0 {
+		t.Fatal("Expected non-meadow biomes")
+	}
+	for _, pos := range a.BiomePositions() {
+		if a.BiomeAt(pos) != b.BiomeAt(pos) {
+			t.Fatalf("Expected the same biome at %v from the same seed", pos)
+		}
+	}
+	if len(a.WaterPositions()) != len(b.WaterPositions()) || len(a.TreePositions()) != len(b.TreePositions()) {
+		t.Error("Expected the same water and trees from the same seed")
+	}
+}
+
+func TestGenerateTerrain_EveryPresetKeepsMapConnected(t *testing.T) {
+	t.Parallel()
+
+	for _, preset := range TerrainPresets {
+		for i := 0; i < 5; i++ {
+			m := NewMap(config.MapWidth, config.MapHeight)
+			GenerateTerrain(m, preset, false)
+
+			if !isMapConnected(m) {
+				t.Errorf("%s, iteration %d: terrain split the map", preset.ID, i)
+			}
+			ponds := 0
+			for _, pos := range m.WaterPositions() {
+				if m.WaterAt(pos) == WaterPond {
+					ponds++
+				}
+			}
+			if ponds == 0 || ponds > config.TerrainMaxPondTiles {
+				t.Errorf("%s, iteration %d: expected 1-%d pond tiles, got %d", preset.ID, i, config.TerrainMaxPondTiles, ponds)
+			}
+		}
+	}
+}
+
+func TestGenerateTerrain_NoWaterKeepsBiomesAndTrees(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(config.MapWidth, config.MapHeight)
+	GenerateTerrain(m, TerrainPreset("wetlands"), true)
+
+	if len(m.WaterPositions()) != 0 || m.HasClay() {
+		t.Error("Expected no water or clay with noWater")
+	}
+	if !m.HasBiomes() {
+		t.Error("Expected biomes with noWater")
+	}
+}
+
+func TestCarveRiver_FlowsDownhillToEdgeOrWater(t *testing.T) {
+	t.Parallel()
+
+	// A slope falling from west to east: the river should run east to the edge
+	m := NewMap(12, 5)
+	elevation := make([][]float64, m.Height)
+	for y := range elevation {
+		elevation[y] = make([]float64, m.Width)
+		for x := range elevation[y] {
+			elevation[y][x] = 1 - float64(x)/float64(m.Width)
+			if y != 2 {
+				elevation[y][x] += 0.01 // The middle row is the valley floor
+			}
+		}
+	}
+
+	carveRiver(m, elevation)
+
+	rivers := m.WaterPositions()
+	if len(rivers) == 0 {
+		t.Fatal("Expected a river")
+	}
+	reachesEdge := false
+	for _, pos := range rivers {
+		if m.WaterAt(pos) != WaterRiver {
+			t.Errorf("Expected river water at %v", pos)
+		}
+		if pos.X >= m.Width-2 { // The last tile may be left as a ford
+			reachesEdge = true
+		}
+	}
+	if !reachesEdge {
+		t.Errorf("Expected the river to reach the downhill edge, got %v", rivers)
+	}
+	if !isMapConnected(m) {
+		t.Error("Expected fords to keep the land connected")
+	}
+}
+
+func TestFindEmptySpotIn_PrefersBiome(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 5; y++ {
+			m.SetBiome(types.Position{X: x, Y: y}, BiomeWetland)
+		}
+	}
+
+	inBiome := 0
+	for i := 0; i < 50; i++ {
+		x, y := findEmptySpotIn(m, []Biome{BiomeWetland})
+		if m.BiomeAt(types.Position{X: x, Y: y}) == BiomeWetland {
+			inBiome++
+		}
+	}
+	if inBiome < 45 {
+		t.Errorf("Expected nearly all spots in the wetland half, got %d/50", inBiome)
+	}
+}
+
+func TestTerrainPreset_FallsBackToDefault(t *testing.T) {
+	t.Parallel()
+
+	if got := TerrainPreset("rugged"); got.ID != "rugged" {
+		t.Errorf("Expected the rugged preset, got %s", got.ID)
+	}
+	if got := TerrainPreset(""); got.ID != TerrainPresets[0].ID {
+		t.Errorf("Expected the default preset for an unknown ID, got %s", got.ID)
+	}
+}
@@ -0,0 +1,181 @@
+package game
+
+import (
+	"petri/internal/types"
+)
+
+// TreeTile is one tile of a tree's trunk
+type TreeTile int
+
+const (
+	TreeNone      TreeTile = iota
+	TreeLivewood           // Living outer wood (impassable; carving cuts an opening)
+	TreeHeartwood          // Solid inner wood (impassable; carving hollows it out)
+	TreeHollow             // Hollowed-out heartwood (passable floor inside the tree)
+	TreeOpening            // Gap cut through the livewood (passable, closes off rooms like a hut door)
+)
+
+// treeTileIDs are the stable tree tile identifiers, indexed by TreeTile
+var treeTileIDs = [...]string{"", "livewood", "heartwood", "hollow", "opening"}
+
+// ID returns the stable identifier used in i18n keys and the API
+func (t TreeTile) ID() string {
+	return treeTileIDs[t]
+}
+
+// IsSolid returns true for wood that blocks movement (livewood and heartwood)
+func (t TreeTile) IsSolid() bool {
+	return t == TreeLivewood || t == TreeHeartwood
+}
+
+// SetTree places a tree tile at the given position (TreeNone clears it)
+func (m *Map) SetTree(pos types.Position, tile TreeTile) {
+	if tile == TreeNone {
+		delete(m.trees, pos)
+	} else {
+		m.trees[pos] = tile
+	}
+	m.updateRegionsAround(pos)
+}
+
+// SetTrees places many tree tiles at once, recomputing regions a single time (world load)
+func (m *Map) SetTrees(tiles map[types.Position]TreeTile) {
+	for pos, tile := range tiles {
+		if tile == TreeNone {
+			delete(m.trees, pos)
+		} else {
+			m.trees[pos] = tile
+		}
+	}
+	m.RecomputeRegions()
+}
+
+// TreeAt returns the tree tile at the given position (TreeNone if no tree)
+func (m *Map) TreeAt(pos types.Position) TreeTile {
+	return m.trees[pos]
+}
+
+// IsSolidTree returns true if there is impassable wood at the position
+func (m *Map) IsSolidTree(pos types.Position) bool {
+	return m.trees[pos].IsSolid()
+}
+
+// TreePositions returns all positions that have tree tiles, in row-major order
+func (m *Map) TreePositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.trees))
+	for pos := range m.trees {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// CanCarve returns true if the tree tile at pos can be carved: heartwood always,
+// livewood only where it leads into the tree (next to heartwood or a hollow)
+func (m *Map) CanCarve(pos types.Position) bool {
+	switch m.trees[pos] {
+	case TreeHeartwood:
+		return true
+	case TreeLivewood:
+		for _, dir := range [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+			next := m.trees[types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}]
+			if next == TreeHeartwood || next == TreeHollow {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CarveTree carves the tree tile at pos: heartwood becomes hollow floor and livewood becomes an
+// opening. Clears any carving mark. Returns false if there is nothing carvable there.
+func (m *Map) CarveTree(pos types.Position) bool {
+	if !m.CanCarve(pos) {
+		return false
+	}
+	carved := TreeHollow
+	if m.trees[pos] == TreeLivewood {
+		carved = TreeOpening
+	}
+	delete(m.markedForCarving, pos)
+	delete(m.markedForChopping, pos)
+	m.SetTree(pos, carved)
+	return true
+}
+
+// MarkForCarving adds a position to the marked-for-carving pool.
+// Returns false if there is nothing carvable there (no-op).
+func (m *Map) MarkForCarving(pos types.Position) bool {
+	if !m.CanCarve(pos) {
+		return false
+	}
+	m.markedForCarving[pos] = true
+	return true
+}
+
+// UnmarkForCarving removes a position from the marked-for-carving pool
+func (m *Map) UnmarkForCarving(pos types.Position) {
+	delete(m.markedForCarving, pos)
+}
+
+// IsMarkedForCarving returns true if the position is in the marked-for-carving pool
+func (m *Map) IsMarkedForCarving(pos types.Position) bool {
+	return m.markedForCarving[pos]
+}
+
+// MarkedForCarvingPositions returns all positions in the marked-for-carving pool, in row-major order
+func (m *Map) MarkedForCarvingPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.markedForCarving))
+	for pos := range m.markedForCarving {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// CanChop returns true if the tree tile at pos is solid wood that can be chopped down
+func (m *Map) CanChop(pos types.Position) bool {
+	return m.trees[pos].IsSolid()
+}
+
+// ChopTree fells the solid wood at pos, leaving open ground. Clears any carving or chopping mark.
+// Returns false if there is no solid wood there.
+func (m *Map) ChopTree(pos types.Position) bool {
+	if !m.CanChop(pos) {
+		return false
+	}
+	delete(m.markedForCarving, pos)
+	delete(m.markedForChopping, pos)
+	m.SetTree(pos, TreeNone)
+	return true
+}
+
+// MarkForChopping adds a position to the marked-for-chopping pool.
+// Returns false if there is no solid wood there (no-op).
+func (m *Map) MarkForChopping(pos types.Position) bool {
+	if !m.CanChop(pos) {
+		return false
+	}
+	m.markedForChopping[pos] = true
+	return true
+}
+
+// UnmarkForChopping removes a position from the marked-for-chopping pool
+func (m *Map) UnmarkForChopping(pos types.Position) {
+	delete(m.markedForChopping, pos)
+}
+
+// IsMarkedForChopping returns true if the position is in the marked-for-chopping pool
+func (m *Map) IsMarkedForChopping(pos types.Position) bool {
+	return m.markedForChopping[pos]
+}
+
+// MarkedForChoppingPositions returns all positions in the marked-for-chopping pool, in row-major order
+func (m *Map) MarkedForChoppingPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.markedForChopping))
+	for pos := range m.markedForChopping {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
@@ -0,0 +1,222 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/types"
+)
+
+func TestTrees_SolidWoodBlocksMovement(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestSquareTree(m, 5, 5, 4)
+
+	for _, pos := range []types.Position{{X: 5, Y: 5}, {X: 6, Y: 6}} {
+		if !m.IsBlocked(pos) || m.IsEmpty(pos) {
+			t.Errorf("Expected solid wood at %v to block movement", pos)
+		}
+	}
+	if m.IsBlocked(types.Position{X: 4, Y: 5}) {
+		t.Error("Expected the tile beside the tree to be open")
+	}
+}
+
+func TestCanCarve_LivewoodOnlyWhereItLeadsInside(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestSquareTree(m, 5, 5, 5) // Heartwood (6..8, 6..8)
+
+	if m.CanCarve(types.Position{X: 5, Y: 5}) {
+		t.Error("Expected a corner of livewood not to be carvable")
+	}
+	if !m.CanCarve(types.Position{X: 7, Y: 5}) {
+		t.Error("Expected livewood beside heartwood to be carvable")
+	}
+	if !m.CanCarve(types.Position{X: 7, Y: 7}) {
+		t.Error("Expected heartwood to be carvable")
+	}
+	if m.CanCarve(types.Position{X: 3, Y: 3}) {
+		t.Error("Expected open ground not to be carvable")
+	}
+	if m.MarkForCarving(types.Position{X: 5, Y: 5}) || m.IsMarkedForCarving(types.Position{X: 5, Y: 5}) {
+		t.Error("Expected uncarvable wood to be rejected by the carving pool")
+	}
+}
+
+func TestCarveTree_HollowsHeartwoodAndOpensLivewood(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestSquareTree(m, 5, 5, 5)
+	entrance := types.Position{X: 7, Y: 5}
+	m.MarkForCarving(entrance)
+
+	if !m.CarveTree(entrance) {
+		t.Fatal("Expected the entrance to be carved")
+	}
+	if m.TreeAt(entrance) != TreeOpening || m.IsBlocked(entrance) {
+		t.Errorf("Expected a passable opening, got %v", m.TreeAt(entrance))
+	}
+	if m.IsMarkedForCarving(entrance) {
+		t.Error("Expected carving to clear the mark")
+	}
+
+	inner := types.Position{X: 7, Y: 6}
+	m.CarveTree(inner)
+	if m.TreeAt(inner) != TreeHollow || m.IsBlocked(inner) {
+		t.Errorf("Expected passable hollow floor, got %v", m.TreeAt(inner))
+	}
+	if m.CarveTree(inner) {
+		t.Error("Expected a hollow not to be carved twice")
+	}
+}
+
+func TestRegions_CarvedTreeRoomIsShelter(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestSquareTree(m, 5, 5, 5)
+	m.CarveTree(types.Position{X: 7, Y: 5})
+	for y := 6; y <= 8; y++ {
+		for x := 6; x <= 8; x++ {
+			m.CarveTree(types.Position{X: x, Y: y})
+		}
+	}
+
+	r := m.RegionOf(types.Position{X: 7, Y: 7})
+	if r == nil {
+		t.Fatal("Expected the carved room to be an enclosed region")
+	}
+	if r.Kind != RegionTreeHollow || !r.IsShelter() {
+		t.Errorf("Expected a sheltering tree hollow, got kind %v", r.Kind)
+	}
+	if len(r.Tiles) != 9 {
+		t.Errorf("Expected the 3×3 room without its opening, got %d tiles", len(r.Tiles))
+	}
+	if m.RegionOf(types.Position{X: 7, Y: 5}) != nil {
+		t.Error("Expected the opening to stay outside the room, like a hut door")
+	}
+}
+
+func TestSpawnTrees_ShapesAreLivewoodAroundHeartwood(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 10; i++ {
+		m := NewMap(config.MapWidth, config.MapHeight)
+		SpawnPonds(m)
+		SpawnTrees(m)
+
+		if len(m.TreePositions()) == 0 {
+			t.Fatalf("iteration %d: expected trees to spawn", i)
+		}
+		for _, pos := range m.TreePositions() {
+			if m.WaterAt(pos) != WaterNone {
+				t.Errorf("iteration %d: tree tile %v overlaps water", i, pos)
+			}
+			outside := false
+			for _, dir := range regionDirs {
+				if m.TreeAt(types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}) == TreeNone {
+					outside = true
+				}
+			}
+			switch m.TreeAt(pos) {
+			case TreeHeartwood, TreeHollow:
+				if outside {
+					t.Errorf("iteration %d: inner wood at %v touches the outside", i, pos)
+				}
+			case TreeLivewood, TreeOpening:
+				if !outside {
+					t.Errorf("iteration %d: livewood at %v is buried inside the tree", i, pos)
+				}
+			}
+			if m.TreeAt(pos) == TreeHollow {
+				if r := m.RegionOf(pos); r == nil || !r.IsShelter() {
+					t.Errorf("iteration %d: hollow at %v is not a sheltered region", i, pos)
+				}
+			}
+		}
+		if !isMapConnected(m) {
+			t.Errorf("iteration %d: trees split the map", i)
+		}
+	}
+}
+
+func TestChopTree_FellsSolidWoodAndClearsMarks(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(20, 20)
+	AddTestSquareTree(m, 5, 5, 5)
+	corner := types.Position{X: 5, Y: 5}
+
+	if !m.CanChop(corner) {
+		t.Error("Expected any solid wood to be choppable, even where carving can't reach inside")
+	}
+	m.MarkForChopping(corner)
+	m.MarkForCarving(types.Position{X: 7, Y: 5})
+	m.MarkForChopping(types.Position{X: 7, Y: 5})
+
+	if !m.ChopTree(corner) {
+		t.Fatal("Expected the corner to be chopped")
+	}
+	if m.TreeAt(corner) != TreeNone || m.IsBlocked(corner) {
+		t.Errorf("Expected open ground, got %v", m.TreeAt(corner))
+	}
+	if m.IsMarkedForChopping(corner) || m.CanChop(corner) {
+		t.Error("Expected chopping to clear the mark and leave nothing to chop")
+	}
+
+	m.ChopTree(types.Position{X: 7, Y: 5})
+	if m.IsMarkedForCarving(types.Position{X: 7, Y: 5}) {
+		t.Error("Expected chopping to clear a carving mark on the same tile")
+	}
+	if m.MarkForChopping(types.Position{X: 2, Y: 2}) {
+		t.Error("Expected open ground to be rejected by the chopping pool")
+	}
+}
+
+func TestSpawnTree_NeverDisconnectsTheMap(t *testing.T) {
+	t.Parallel()
+
+	// The clear ring around every trunk is what keeps the map connected; check every width,
+	// solid and hollowed with a range of openings
+	for width := config.TreeMinWidth; width <= config.TreeMaxWidth; width++ {
+		m := NewMap(width+4, width+4)
+		if !spawnTree(m, 2, 2, width) {
+			t.Fatalf("width %d: expected the tree to fit", width)
+		}
+
+		shape := make(map[types.Position]bool)
+		var heartwood, livewood []types.Position
+		for _, pos := range m.TreePositions() {
+			shape[pos] = true
+			switch m.TreeAt(pos) {
+			case TreeHeartwood, TreeHollow:
+				heartwood = append(heartwood, pos)
+			default:
+				livewood = append(livewood, pos)
+			}
+		}
+
+		for attempt := 0; attempt < 20; attempt++ {
+			for _, pos := range heartwood {
+				m.trees[pos] = TreeHeartwood
+			}
+			for _, pos := range livewood {
+				m.trees[pos] = TreeLivewood
+			}
+			if !isMapConnected(m) {
+				t.Fatalf("width %d: solid tree split the map", width)
+			}
+			if len(heartwood) == 0 {
+				break
+			}
+			hollowTree(m, shape, heartwood, livewood)
+			if !isMapConnected(m) {
+				t.Fatalf("width %d: hollow tree split the map", width)
+			}
+		}
+	}
+}
@@ -1,12 +1,13 @@
 package game
 
 import (
-	"math/rand"
 	"sort"
 	"unicode"
 
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/i18n"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
@@ -25,8 +26,31 @@ type ItemTypeConfig struct {
 	NonPlantSpawned      bool // if true, spawned by ground spawning system, not SpawnItems()
 }
 
-// GetItemTypeConfigs returns configuration for all item types
+// itemTypeOverrides holds item types added or replaced by content packs
+var itemTypeOverrides = map[string]ItemTypeConfig{}
+
+// SetItemTypeConfig adds or replaces an item type's configuration (used by content packs)
+func SetItemTypeConfig(itemType string, cfg ItemTypeConfig) {
+	itemTypeOverrides[itemType] = cfg
+}
+
+// ResetItemTypeConfigs removes all content pack item types, restoring the built-ins
+func ResetItemTypeConfigs() {
+	itemTypeOverrides = map[string]ItemTypeConfig{}
+}
+
+// GetItemTypeConfigs returns configuration for all item types:
+// built-in types with content pack additions and replacements applied
 func GetItemTypeConfigs() map[string]ItemTypeConfig {
+	configs := builtinItemTypeConfigs()
+	for itemType, cfg := range itemTypeOverrides {
+		configs[itemType] = cfg
+	}
+	return configs
+}
+
+// builtinItemTypeConfigs returns configuration for the compiled-in item types
+func builtinItemTypeConfigs() map[string]ItemTypeConfig {
 	return map[string]ItemTypeConfig{
 		"berry": {
 			Colors:               types.BerryColors,
@@ -125,7 +149,7 @@ func GetPlantableTypes(items []*entity.Item, chars []*entity.Character) []Planta
 
 		var displayName string
 		if kind != "" {
-			displayName = capitalize(kind) + "s"
+			displayName = capitalize(entity.Pluralize(kind))
 		} else {
 			displayName = capitalize(entity.Pluralize(itemType))
 		}
@@ -182,7 +206,7 @@ type GatherableTypeEntry struct {
 }
 
 // GetGatherableTypes returns the list of gatherable item types currently on the ground.
-// Filters to items with Plant == nil && Container == nil && ItemType != "hoe".
+// Filters to items with Plant == nil && Container == nil, excluding tools (hoe, chisel, flake, axe).
 // Returns a deduplicated, alphabetically sorted list.
 func GetGatherableTypes(items []*entity.Item) []GatherableTypeEntry {
 	seen := make(map[string]bool)
@@ -195,7 +219,8 @@ func GetGatherableTypes(items []*entity.Item) []GatherableTypeEntry {
 		if item.Container != nil {
 			continue // vessel — not gatherable
 		}
-		if item.ItemType == "hoe" {
+		switch item.ItemType {
+		case "hoe", "chisel", "flake", "axe":
 			continue // tool — not gatherable
 		}
 		if seen[item.ItemType] {
@@ -239,7 +264,7 @@ func GetHarvestableItemTypes(items []*entity.Item) []string {
 
 // ExtractableTypeEntry represents an extractable plant type for the order UI menu.
 type ExtractableTypeEntry struct {
-	DisplayName string // e.g., "Flower Seeds", "Grass Seeds"
+	DisplayName string // e.g., "Flower seeds", "Tall grass seeds"
 	TargetType  string // plant type stored in order.TargetType: "flower", "grass"
 }
 
@@ -261,7 +286,7 @@ func GetExtractableItemTypes(items []*entity.Item) []ExtractableTypeEntry {
 		}
 		seen[item.ItemType] = true
 		entries = append(entries, ExtractableTypeEntry{
-			DisplayName: capitalize(item.ItemType) + " Seeds",
+			DisplayName: capitalize(i18n.T("order.target.seeds", entity.ItemDisplayName(item.ItemType))),
 			TargetType:  item.ItemType,
 		})
 	}
@@ -286,8 +311,19 @@ func GetDiggableTypes(gameMap *Map) []DiggableTypeEntry {
 		return nil
 	}
 	return []DiggableTypeEntry{
-		{DisplayName: "Clay", TargetType: "clay"},
+		{DisplayName: capitalize(entity.ItemDisplayName("clay")), TargetType: "clay"},
+	}
+}
+
+// sortedItemTypes returns the item types in configs in sorted order.
+// Generation iterates in this order (maps iterate randomly) so a seed reproduces the world.
+func sortedItemTypes(configs map[string]ItemTypeConfig) []string {
+	itemTypes := make([]string, 0, len(configs))
+	for itemType := range configs {
+		itemTypes = append(itemTypes, itemType)
 	}
+	sort.Strings(itemTypes)
+	return itemTypes
 }
 
 // capitalize returns s with the first letter uppercased.
@@ -305,8 +341,8 @@ func GenerateVarieties() *VarietyRegistry {
 	registry := NewVarietyRegistry()
 	configs := GetItemTypeConfigs()
 
-	for itemType, cfg := range configs {
-		varieties := generateVarietiesForType(itemType, cfg)
+	for _, itemType := range sortedItemTypes(configs) {
+		varieties := generateVarietiesForType(itemType, configs[itemType])
 		for _, v := range varieties {
 			registry.Register(v)
 		}
@@ -397,16 +433,16 @@ func generateVarietiesForType(itemType string, cfg ItemTypeConfig) []*entity.Ite
 		attempts++
 
 		// Pick random attributes
-		color := cfg.Colors[rand.Intn(len(cfg.Colors))]
+		color := cfg.Colors[rng.Intn(len(cfg.Colors))]
 
 		var pattern types.Pattern
 		if cfg.Patterns != nil {
-			pattern = cfg.Patterns[rand.Intn(len(cfg.Patterns))]
+			pattern = cfg.Patterns[rng.Intn(len(cfg.Patterns))]
 		}
 
 		var texture types.Texture
 		if cfg.Textures != nil {
-			texture = cfg.Textures[rand.Intn(len(cfg.Textures))]
+			texture = cfg.Textures[rng.Intn(len(cfg.Textures))]
 		}
 
 		// Check for duplicate
@@ -455,7 +491,7 @@ func assignPoisonAndHealing(registry *VarietyRegistry) {
 	}
 
 	// Shuffle to randomize selection
-	rand.Shuffle(len(eligible), func(i, j int) {
+	rng.Shuffle(len(eligible), func(i, j int) {
 		eligible[i], eligible[j] = eligible[j], eligible[i]
 	})
 
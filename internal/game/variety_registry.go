@@ -1,6 +1,8 @@
 package game
 
 import (
+	"sort"
+
 	"petri/internal/entity"
 	"petri/internal/types"
 )
@@ -29,7 +31,7 @@ func (r *VarietyRegistry) Get(id string) *entity.ItemVariety {
 	return r.varieties[id]
 }
 
-// VarietiesOfType returns all varieties of a given item type
+// VarietiesOfType returns all varieties of a given item type, sorted by ID
 func (r *VarietyRegistry) VarietiesOfType(itemType string) []*entity.ItemVariety {
 	var result []*entity.ItemVariety
 	for _, v := range r.varieties {
@@ -37,6 +39,7 @@ func (r *VarietyRegistry) VarietiesOfType(itemType string) []*entity.ItemVariety
 			result = append(result, v)
 		}
 	}
+	sortVarieties(result)
 	return result
 }
 
@@ -49,7 +52,7 @@ func (r *VarietyRegistry) AllVarieties() []*entity.ItemVariety {
 	return result
 }
 
-// EdibleVarieties returns all varieties that are edible
+// EdibleVarieties returns all varieties that are edible, sorted by ID
 func (r *VarietyRegistry) EdibleVarieties() []*entity.ItemVariety {
 	var result []*entity.ItemVariety
 	for _, v := range r.varieties {
@@ -57,9 +60,17 @@ func (r *VarietyRegistry) EdibleVarieties() []*entity.ItemVariety {
 			result = append(result, v)
 		}
 	}
+	sortVarieties(result)
 	return result
 }
 
+// sortVarieties sorts varieties by ID for consistent ordering (maps iterate randomly)
+func sortVarieties(varieties []*entity.ItemVariety) {
+	sort.Slice(varieties, func(i, j int) bool {
+		return varieties[i].ID < varieties[j].ID
+	})
+}
+
 // Count returns the total number of registered varieties
 func (r *VarietyRegistry) Count() int {
 	return len(r.varieties)
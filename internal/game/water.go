@@ -0,0 +1,185 @@
+package game
+
+import (
+	"petri/internal/config"
+	"petri/internal/types"
+)
+
+// DryPondTile dries up a pond tile, leaving a dried pond bed that rain can refill.
+// Returns false if there is no pond water at pos.
+func (m *Map) DryPondTile(pos types.Position) bool {
+	if m.water[pos] != WaterPond {
+		return false
+	}
+	m.RemoveWater(pos)
+	m.driedPondBeds[pos] = true
+	return true
+}
+
+// RefillPondTile turns a dried pond bed back into pond water. Returns false if pos is not a
+// dried bed or water can't spread there right now (see CanWaterSpread).
+func (m *Map) RefillPondTile(pos types.Position) bool {
+	if !m.driedPondBeds[pos] || !m.CanWaterSpread(pos) {
+		return false
+	}
+	delete(m.driedPondBeds, pos)
+	m.AddWater(pos, WaterPond)
+	return true
+}
+
+// SetDriedPondBed records a dried pond bed (world load)
+func (m *Map) SetDriedPondBed(pos types.Position) {
+	m.driedPondBeds[pos] = true
+}
+
+// IsDriedPondBed returns true if pos is a pond tile that has dried up
+func (m *Map) IsDriedPondBed(pos types.Position) bool {
+	return m.driedPondBeds[pos]
+}
+
+// DriedPondBedPositions returns all dried pond beds, in row-major order
+func (m *Map) DriedPondBedPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.driedPondBeds))
+	for pos := range m.driedPondBeds {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// CanWaterSpread returns true if water can appear at pos during play: the tile is dry land with
+// nothing on it (no character, item, feature, construct, tree, tilled soil or tilling or
+// construction mark), and flooding it keeps the land connected.
+func (m *Map) CanWaterSpread(pos types.Position) bool {
+	if len(m.ItemsAt(pos)) > 0 || m.ConstructAt(pos) != nil || m.IsTilled(pos) || m.IsMarkedForTilling(pos) {
+		return false
+	}
+	if _, ok := m.markedForConstruction[pos]; ok {
+		return false
+	}
+	return canFlood(m, pos)
+}
+
+// TouchesWater returns true if any cardinal neighbor of pos is water
+func (m *Map) TouchesWater(pos types.Position) bool {
+	for _, dir := range [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+		if m.IsWater(types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanMarkForDigging returns true if pos is dry, open land where a channel could one day be dug:
+// no water, tree, construct, impassable feature or tilled soil.
+func (m *Map) CanMarkForDigging(pos types.Position) bool {
+	if !m.IsValid(pos) || m.IsWater(pos) || m.TreeAt(pos) != TreeNone || m.IsOutcrop(pos) || m.ConstructAt(pos) != nil || m.IsTilled(pos) {
+		return false
+	}
+	if f := m.FeatureAt(pos); f != nil && !f.IsPassable() {
+		return false
+	}
+	return true
+}
+
+// MarkForDigging adds a position to the marked-for-digging pool.
+// Returns false if a channel can't go there (no-op).
+func (m *Map) MarkForDigging(pos types.Position) bool {
+	if !m.CanMarkForDigging(pos) {
+		return false
+	}
+	m.markedForDigging[pos] = true
+	return true
+}
+
+// UnmarkForDigging removes a position from the marked-for-digging pool
+func (m *Map) UnmarkForDigging(pos types.Position) {
+	delete(m.markedForDigging, pos)
+}
+
+// IsMarkedForDigging returns true if the position is in the marked-for-digging pool
+func (m *Map) IsMarkedForDigging(pos types.Position) bool {
+	return m.markedForDigging[pos]
+}
+
+// MarkedForDiggingPositions returns all positions in the marked-for-digging pool, in row-major order
+func (m *Map) MarkedForDiggingPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.markedForDigging))
+	for pos := range m.markedForDigging {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// CanDigChannel returns true if the marked tile at pos can be dug now: it touches water
+// cardinally (channels grow out from a source) and water can spread onto it
+func (m *Map) CanDigChannel(pos types.Position) bool {
+	return m.markedForDigging[pos] && m.TouchesWater(pos) && m.CanWaterSpread(pos)
+}
+
+// DigChannel turns a marked tile into channel water that holds for ChannelSiltTime seconds.
+// Clears the mark. Returns false if the tile can't be dug now.
+func (m *Map) DigChannel(pos types.Position) bool {
+	if !m.CanDigChannel(pos) {
+		return false
+	}
+	delete(m.markedForDigging, pos)
+	delete(m.driedPondBeds, pos)
+	m.AddWater(pos, WaterChannel)
+	m.channelSilt[pos] = config.ChannelSiltTime
+	return true
+}
+
+// ChannelSilt returns the seconds left before the channel at pos silts up, and false if there is no channel
+func (m *Map) ChannelSilt(pos types.Position) (float64, bool) {
+	if m.water[pos] != WaterChannel {
+		return 0, false
+	}
+	return m.channelSilt[pos], true
+}
+
+// SetChannelSilt sets the seconds left before the channel at pos silts up (world load)
+func (m *Map) SetChannelSilt(pos types.Position, seconds float64) {
+	m.channelSilt[pos] = seconds
+}
+
+// ChannelNeedsUpkeep returns true if the channel at pos is silting up and should be cleared
+func (m *Map) ChannelNeedsUpkeep(pos types.Position) bool {
+	silt, ok := m.ChannelSilt(pos)
+	return ok && silt < config.ChannelSiltTime*config.ChannelUpkeepFraction
+}
+
+// ClearChannel digs the silt out of the channel at pos, so it holds for ChannelSiltTime again.
+// Returns false if there is no channel there.
+func (m *Map) ClearChannel(pos types.Position) bool {
+	if m.water[pos] != WaterChannel {
+		return false
+	}
+	m.channelSilt[pos] = config.ChannelSiltTime
+	return true
+}
+
+// ChannelPositions returns all channel tiles, in row-major order
+func (m *Map) ChannelPositions() []types.Position {
+	positions := make([]types.Position, 0, len(m.channelSilt))
+	for pos := range m.channelSilt {
+		positions = append(positions, pos)
+	}
+	sortPositions(positions)
+	return positions
+}
+
+// SiltChannels counts down every channel's silt timer; channels that run out silt up and
+// become dry land again. Returns the positions that silted up, in row-major order.
+func (m *Map) SiltChannels(delta float64) []types.Position {
+	var silted []types.Position
+	for _, pos := range m.ChannelPositions() {
+		m.channelSilt[pos] -= delta
+		if m.channelSilt[pos] <= 0 {
+			m.RemoveWater(pos)
+			silted = append(silted, pos)
+		}
+	}
+	return silted
+}
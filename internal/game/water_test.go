@@ -0,0 +1,87 @@
+package game
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/types"
+)
+
+func TestDryPondTile_LeavesBedThatRefills(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	pos := types.Position{X: 4, Y: 4}
+	m.AddWater(pos, WaterPond)
+	m.AddWater(types.Position{X: 5, Y: 4}, WaterSpring)
+
+	if m.DryPondTile(types.Position{X: 5, Y: 4}) {
+		t.Error("Expected springs not to dry up")
+	}
+	if !m.DryPondTile(pos) || m.IsWater(pos) || !m.IsDriedPondBed(pos) {
+		t.Fatal("Expected the pond tile to become a dried bed")
+	}
+
+	m.MarkForTilling(pos)
+	if m.RefillPondTile(pos) {
+		t.Error("Expected a bed marked for tilling not to refill")
+	}
+	m.UnmarkForTilling(pos)
+
+	if !m.RefillPondTile(pos) || m.WaterAt(pos) != WaterPond || m.IsDriedPondBed(pos) {
+		t.Error("Expected the bed to refill as pond")
+	}
+}
+
+func TestDigChannel_GrowsOutFromWater(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	m.AddWater(types.Position{X: 2, Y: 5}, WaterPond)
+	near := types.Position{X: 3, Y: 5}
+	far := types.Position{X: 4, Y: 5}
+	m.MarkForDigging(near)
+	m.MarkForDigging(far)
+
+	if m.CanDigChannel(far) {
+		t.Error("Expected a mark away from water not to be diggable yet")
+	}
+	if !m.DigChannel(near) || m.WaterAt(near) != WaterChannel || m.IsMarkedForDigging(near) {
+		t.Fatal("Expected the mark beside the pond to become channel")
+	}
+	if !m.CanDigChannel(far) {
+		t.Error("Expected the next mark to be diggable once the channel reaches it")
+	}
+	if !m.IsWet(types.Position{X: 3, Y: 6}) {
+		t.Error("Expected ground beside the channel to be wet")
+	}
+	if m.MarkForDigging(types.Position{X: 2, Y: 5}) {
+		t.Error("Expected water not to be markable for digging")
+	}
+}
+
+func TestSiltChannels_NeedUpkeepThenRevertToLand(t *testing.T) {
+	t.Parallel()
+
+	m := NewMap(10, 10)
+	m.AddWater(types.Position{X: 2, Y: 5}, WaterPond)
+	pos := types.Position{X: 3, Y: 5}
+	m.MarkForDigging(pos)
+	m.DigChannel(pos)
+
+	m.SiltChannels(config.ChannelSiltTime * (1 - config.ChannelUpkeepFraction) * 1.1)
+	if !m.ChannelNeedsUpkeep(pos) {
+		t.Fatal("Expected the channel to need upkeep")
+	}
+	if !m.ClearChannel(pos) || m.ChannelNeedsUpkeep(pos) {
+		t.Error("Expected clearing to restore the channel")
+	}
+
+	silted := m.SiltChannels(config.ChannelSiltTime)
+	if len(silted) != 1 || m.IsWater(pos) {
+		t.Errorf("Expected the neglected channel to silt up into land, got %v", silted)
+	}
+	if len(m.ChannelPositions()) != 0 {
+		t.Error("Expected no channels left")
+	}
+}
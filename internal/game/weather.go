@@ -0,0 +1,59 @@
+package game
+
+// Weather is the current sky over the whole map
+type Weather int
+
+const (
+	WeatherClear Weather = iota
+	WeatherRain
+	WeatherStorm
+	WeatherDrought
+)
+
+// weatherIDs are the stable weather identifiers, in Weather order
+var weatherIDs = [...]string{"clear", "rain", "storm", "drought"}
+
+// AllWeather lists every kind of weather, in a stable order
+var AllWeather = []Weather{WeatherClear, WeatherRain, WeatherStorm, WeatherDrought}
+
+// ID returns the stable identifier used in config keys, saves, and i18n keys
+func (w Weather) ID() string {
+	return weatherIDs[w]
+}
+
+// ParseWeather returns the weather with the given ID and true, or clear and false for unknown IDs
+func ParseWeather(id string) (Weather, bool) {
+	for i, wid := range weatherIDs {
+		if wid == id {
+			return Weather(i), true
+		}
+	}
+	return WeatherClear, false
+}
+
+// IsWet returns true for weather that rains on the map (rain and storms)
+func (w Weather) IsWet() bool {
+	return w == WeatherRain || w == WeatherStorm
+}
+
+// Weather returns the current weather
+func (m *Map) Weather() Weather {
+	return m.weather
+}
+
+// Forecast returns the weather that follows the current spell
+func (m *Map) Forecast() Weather {
+	return m.forecast
+}
+
+// WeatherRemaining returns the game seconds left in the current weather spell
+func (m *Map) WeatherRemaining() float64 {
+	return m.weatherRemaining
+}
+
+// SetWeather sets the current weather, the forecast, and the time left in the current spell
+func (m *Map) SetWeather(current, forecast Weather, remaining float64) {
+	m.weather = current
+	m.forecast = forecast
+	m.weatherRemaining = remaining
+}
@@ -1,10 +1,11 @@
 package game
 
 import (
-	"math/rand"
+	"sort"
 
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
@@ -22,14 +23,15 @@ func SpawnItems(m *Map, mushroomsOnly bool) {
 		totalSpawnCount += cfg.SpawnCount
 	}
 	// Use berry spawn interval as reference (all types currently have same interval)
-	maxInitialTimer := config.ItemLifecycle["berry"].SpawnInterval * float64(totalSpawnCount)
+	maxInitialTimer := config.ItemLifecycle["berry"].SpawnSeconds() * float64(totalSpawnCount)
 
 	if mushroomsOnly {
 		// Replace all items with mushroom varieties for testing preference formation
 		spawnItemsOfType(m, registry, "mushroom", totalSpawnCount, maxInitialTimer, totalSpawnCount)
 	} else {
 		// Spawn items for each type using their configured spawn counts
-		for itemType, cfg := range configs {
+		for _, itemType := range sortedItemTypes(configs) {
+			cfg := configs[itemType]
 			if cfg.NonPlantSpawned {
 				continue // spawned by ground spawning system
 			}
@@ -38,12 +40,14 @@ func SpawnItems(m *Map, mushroomsOnly bool) {
 	}
 }
 
-// spawnItemsOfType spawns count items of the given type, distributed across varieties
+// spawnItemsOfType spawns count items of the given type, distributed across varieties.
+// Each variety settles in one of its type's favored biomes, so varieties cluster by biome.
 func spawnItemsOfType(m *Map, registry *VarietyRegistry, itemType string, count int, maxInitialTimer float64, totalSpawnCount int) {
 	varieties := registry.VarietiesOfType(itemType)
 	if len(varieties) == 0 {
 		return
 	}
+	biomes := affinityBiomes(itemType)
 
 	// Calculate max death timer for staggering (if this type has death)
 	lifecycleCfg := config.ItemLifecycle[itemType]
@@ -51,26 +55,31 @@ func spawnItemsOfType(m *Map, registry *VarietyRegistry, itemType string, count
 
 	for i := 0; i < count; i++ {
 		// Pick a random variety of this type
-		v := varieties[rand.Intn(len(varieties))]
+		idx := rng.Intn(len(varieties))
+		v := varieties[idx]
 
-		x, y := findEmptySpot(m)
-		item := createItemFromVariety(v, x, y)
+		var home []Biome
+		if len(biomes) > 0 {
+			home = []Biome{biomes[idx%len(biomes)]}
+		}
+		x, y := findEmptySpotIn(m, home)
+		item := CreateItemFromVariety(v, x, y)
 		// Stagger spawn timers across first cycle (all spawned items are plants)
 		if item.Plant != nil {
-			item.Plant.SpawnTimer = rand.Float64() * maxInitialTimer
+			item.Plant.SpawnTimer = rng.Float64() * maxInitialTimer
 		}
 
 		// Set death timer if this item type is mortal (stagger to avoid synchronized die-off)
 		if maxDeathTimer > 0 {
-			item.DeathTimer = rand.Float64() * maxDeathTimer
+			item.DeathTimer = rng.Float64() * maxDeathTimer
 		}
 
 		m.AddItem(item)
 	}
 }
 
-// createItemFromVariety creates an Item by copying attributes from a variety
-func createItemFromVariety(v *entity.ItemVariety, x, y int) *entity.Item {
+// CreateItemFromVariety creates an Item by copying attributes from a variety
+func CreateItemFromVariety(v *entity.ItemVariety, x, y int) *entity.Item {
 	switch v.ItemType {
 	case "berry":
 		return entity.NewBerry(x, y, v.Color, v.IsPoisonous(), v.IsHealing())
@@ -90,43 +99,43 @@ func createItemFromVariety(v *entity.ItemVariety, x, y int) *entity.Item {
 	}
 }
 
-// SpawnFeatures populates the map with landscape features (leaf piles) and water (springs)
+// SpawnFeatures populates the map with landscape features (leaf piles) and water (springs),
+// favoring their biomes. A spring never lands where it would cut the land in two.
 func SpawnFeatures(m *Map, noWater, noBeds bool) {
 	// Spawn springs as water terrain (drink sources)
 	if !noWater {
+		springBiomes := affinityBiomes("spring")
 		for i := 0; i < config.SpringCount; i++ {
-			x, y := findEmptySpot(m)
-			m.AddWater(types.Position{X: x, Y: y}, WaterSpring)
+			for attempt := 0; attempt < 100; attempt++ {
+				x, y := findEmptySpotIn(m, springBiomes)
+				pos := types.Position{X: x, Y: y}
+				if canFlood(m, pos) {
+					m.AddWater(pos, WaterSpring)
+					break
+				}
+			}
 		}
 	}
 
 	// Spawn leaf piles (beds)
 	if !noBeds {
+		leafPileBiomes := affinityBiomes("leaf pile")
 		for i := 0; i < config.LeafPileCount; i++ {
-			x, y := findEmptySpot(m)
+			x, y := findEmptySpotIn(m, leafPileBiomes)
 			m.AddFeature(entity.NewLeafPile(x, y))
 		}
 	}
 }
 
-// SpawnPonds generates 1-5 ponds of 4-16 contiguous water tiles each.
-// Retries if the resulting map is not fully connected (max 10 attempts).
+// SpawnPonds generates 1-5 ponds of 4-16 contiguous water tiles each, scattered without regard
+// to terrain (GenerateTerrain places ponds on low ground instead). A pond only grows onto tiles
+// that keep the land connected, so the map stays connected without retries.
 func SpawnPonds(m *Map) {
-	maxRetries := 10
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		pondCount := config.PondMinCount + rand.Intn(config.PondMaxCount-config.PondMinCount+1)
+	pondCount := config.PondMinCount + rng.Intn(config.PondMaxCount-config.PondMinCount+1)
 
-		for i := 0; i < pondCount; i++ {
-			pondSize := config.PondMinSize + rand.Intn(config.PondMaxSize-config.PondMinSize+1)
-			spawnPondBlob(m, pondSize)
-		}
-
-		if isMapConnected(m) {
-			return
-		}
-
-		// Clear all pond tiles and retry
-		clearPondTiles(m)
+	for i := 0; i < pondCount; i++ {
+		pondSize := config.PondMinSize + rng.Intn(config.PondMaxSize-config.PondMinSize+1)
+		spawnPondBlob(m, pondSize)
 	}
 }
 
@@ -141,7 +150,7 @@ func SpawnClay(m *Map) {
 		return // No water — no clay
 	}
 
-	targetSize := config.ClayMinCount + rand.Intn(config.ClayMaxCount-config.ClayMinCount+1)
+	targetSize := config.ClayMinCount + rng.Intn(config.ClayMaxCount-config.ClayMinCount+1)
 	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
 
 	// Build candidate pool: all non-water tiles cardinal-adjacent to water
@@ -160,9 +169,14 @@ func SpawnClay(m *Map) {
 	for pos := range candidateSet {
 		candidates = append(candidates, pos)
 	}
-	rand.Shuffle(len(candidates), func(i, j int) {
+	sortPositions(candidates) // Stable order before shuffling so seeded generation is reproducible
+	rng.Shuffle(len(candidates), func(i, j int) {
 		candidates[i], candidates[j] = candidates[j], candidates[i]
 	})
+	// Clay flats settle first (no-op on maps without biomes)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return m.BiomeAt(candidates[i]) == BiomeClayFlats && m.BiomeAt(candidates[j]) != BiomeClayFlats
+	})
 
 	// Phase 1: Place pairs until we reach target size
 	placed := make(map[types.Position]bool)
@@ -182,7 +196,7 @@ func SpawnClay(m *Map) {
 		// Shuffle directions for variety
 		dirs := make([][2]int, len(cardinalDirs))
 		copy(dirs, cardinalDirs)
-		rand.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
+		rng.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
 		for _, dir := range dirs {
 			neighbor := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
 			if candidateSet[neighbor] && !placed[neighbor] {
@@ -206,7 +220,7 @@ func SpawnClay(m *Map) {
 	// may enable further candidates in the next pass).
 	for len(placedList) < targetSize {
 		added := false
-		rand.Shuffle(len(candidates), func(i, j int) {
+		rng.Shuffle(len(candidates), func(i, j int) {
 			candidates[i], candidates[j] = candidates[j], candidates[i]
 		})
 		for _, pos := range candidates {
@@ -238,10 +252,10 @@ func SpawnClay(m *Map) {
 
 	// Spawn loose clay items on randomly selected clay tiles
 	if len(placedList) > 0 {
-		looseCount := config.ClayLooseItems + rand.Intn(2) // ClayLooseItems to ClayLooseItems+1
+		looseCount := config.ClayLooseItems + rng.Intn(2) // ClayLooseItems to ClayLooseItems+1
 		shuffled := make([]types.Position, len(placedList))
 		copy(shuffled, placedList)
-		rand.Shuffle(len(shuffled), func(i, j int) {
+		rng.Shuffle(len(shuffled), func(i, j int) {
 			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 		})
 		for i := 0; i < looseCount && i < len(shuffled); i++ {
@@ -251,19 +265,19 @@ func SpawnClay(m *Map) {
 }
 
 // spawnPondBlob grows a single contiguous pond of the given size from a random starting tile.
+// Only tiles that can flood without cutting the land in two are used (see canFlood).
 func spawnPondBlob(m *Map, size int) {
-	// Pick a random starting position that's not already water
-	var startX, startY int
-	for {
-		startX = rand.Intn(m.Width)
-		startY = rand.Intn(m.Height)
-		pos := types.Position{X: startX, Y: startY}
-		if !m.IsWater(pos) {
-			break
-		}
+	// Pick a random starting position that can flood
+	var start types.Position
+	found := false
+	for attempt := 0; attempt < 100 && !found; attempt++ {
+		start = types.Position{X: rng.Intn(m.Width), Y: rng.Intn(m.Height)}
+		found = canFlood(m, start)
+	}
+	if !found {
+		return
 	}
 
-	start := types.Position{X: startX, Y: startY}
 	m.AddWater(start, WaterPond)
 	blob := []types.Position{start}
 
@@ -271,13 +285,13 @@ func spawnPondBlob(m *Map, size int) {
 
 	for len(blob) < size {
 		// Pick a random tile already in the blob
-		source := blob[rand.Intn(len(blob))]
+		source := blob[rng.Intn(len(blob))]
 
 		// Collect valid cardinal neighbors
 		var candidates []types.Position
 		for _, dir := range cardinalDirs {
 			neighbor := types.Position{X: source.X + dir[0], Y: source.Y + dir[1]}
-			if m.IsValid(neighbor) && !m.IsWater(neighbor) {
+			if canFlood(m, neighbor) {
 				candidates = append(candidates, neighbor)
 			}
 		}
@@ -289,7 +303,7 @@ func spawnPondBlob(m *Map, size int) {
 			for _, tile := range blob {
 				for _, dir := range cardinalDirs {
 					neighbor := types.Position{X: tile.X + dir[0], Y: tile.Y + dir[1]}
-					if m.IsValid(neighbor) && !m.IsWater(neighbor) {
+					if canFlood(m, neighbor) {
 						canGrow = true
 						break
 					}
@@ -304,87 +318,168 @@ func spawnPondBlob(m *Map, size int) {
 			continue
 		}
 
-		chosen := candidates[rand.Intn(len(candidates))]
+		chosen := candidates[rng.Intn(len(candidates))]
 		m.AddWater(chosen, WaterPond)
 		blob = append(blob, chosen)
 	}
 }
 
-// clearPondTiles removes all pond water tiles from the map (preserves springs).
-func clearPondTiles(m *Map) {
-	for _, pos := range m.WaterPositions() {
-		if m.WaterAt(pos) == WaterPond {
-			m.RemoveWater(pos)
+// isOpenTerrain returns true if the terrain at pos can be walked on (no water, solid wood or rock)
+func isOpenTerrain(m *Map, pos types.Position) bool {
+	return !m.IsWater(pos) && !m.IsSolidTree(pos) && !m.IsOutcrop(pos)
+}
+
+// SpawnTrees grows TreeMinCount-TreeMaxCount trees, each a roughly round trunk TreeMinWidth-TreeMaxWidth
+// tiles across: livewood where it touches the outside, heartwood within. Some trees with heartwood
+// spawn hollow, with an opening cut through the livewood. A tree that would crowd water, clay, another
+// tree, a character or the map edge is tried elsewhere (max 10 attempts).
+// On maps with biomes, trees favor the forest edge.
+// Must be called after water and clay, and before features and items.
+func SpawnTrees(m *Map) {
+	treeCount := config.TreeMinCount + rng.Intn(config.TreeMaxCount-config.TreeMinCount+1)
+	for i := 0; i < treeCount; i++ {
+		width := config.TreeMinWidth + rng.Intn(config.TreeMaxWidth-config.TreeMinWidth+1)
+		if width > m.Width-2 || width > m.Height-2 {
+			continue // No room inside the edge margin
+		}
+		for attempt := 0; attempt < 10; attempt++ {
+			x, y := findTreeSpot(m, width)
+			if spawnTree(m, x, y, width) {
+				break
+			}
 		}
 	}
+	m.RecomputeRegions()
 }
 
-// isMapConnected returns true if all non-blocked tiles on the map are reachable from each other.
-// Uses BFS from the first walkable tile and verifies all walkable tiles are reached.
-func isMapConnected(m *Map) bool {
-	// Find first walkable tile
-	var start types.Position
-	found := false
-	for y := 0; y < m.Height; y++ {
-		for x := 0; x < m.Width; x++ {
-			pos := types.Position{X: x, Y: y}
-			if !m.IsWater(pos) {
-				start = pos
-				found = true
+// findTreeSpot picks the top-left corner for a tree of the given width, keeping a one-tile margin
+// from the map edge. On maps with biomes it tries BiomeSpawnAttempts corners for one centered on
+// the forest edge, settling for the last.
+func findTreeSpot(m *Map, width int) (int, int) {
+	var x, y int
+	for attempt := 0; attempt < config.BiomeSpawnAttempts; attempt++ {
+		x = 1 + rng.Intn(m.Width-width-1)
+		y = 1 + rng.Intn(m.Height-width-1)
+		if !m.HasBiomes() || m.BiomeAt(types.Position{X: x + width/2, Y: y + width/2}) == BiomeForestEdge {
+			break
+		}
+	}
+	return x, y
+}
+
+// spawnTree places one tree of the given width with its top-left corner at (x, y).
+// Returns false (leaving the map unchanged) if the tree doesn't fit.
+// The clear ring around the trunk is open ground (outcrops come later), so a tree can never
+// disconnect the map: any path through its tiles can walk around the ring instead, and a
+// hollow's opening leads out onto the ring.
+func spawnTree(m *Map, x, y, width int) bool {
+	shape := make(map[types.Position]bool)
+	for _, offset := range treeShape(width) {
+		shape[types.Position{X: x + offset[0], Y: y + offset[1]}] = true
+	}
+
+	// Every tile and its neighbors must be clear, so trees never touch water, clay or each other
+	for pos := range shape {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				near := types.Position{X: pos.X + dx, Y: pos.Y + dy}
+				if !m.IsValid(near) || m.IsWater(near) || m.IsClay(near) || m.TreeAt(near) != TreeNone || m.IsOccupied(near) {
+					return false
+				}
+			}
+		}
+	}
+
+	// Livewood touches the outside (8-directional), so hollows stay closed off
+	var heartwood, livewood []types.Position
+	for pos := range shape {
+		tile := TreeHeartwood
+		for _, dir := range regionDirs {
+			if !shape[types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}] {
+				tile = TreeLivewood
 				break
 			}
 		}
-		if found {
-			break
+		m.trees[pos] = tile
+		if tile == TreeHeartwood {
+			heartwood = append(heartwood, pos)
+		} else {
+			livewood = append(livewood, pos)
 		}
 	}
-	if !found {
-		return true // entirely water — vacuously connected
+
+	if len(heartwood) > 0 && rng.Float64() < config.TreeHollowChance {
+		hollowTree(m, shape, heartwood, livewood)
 	}
+	return true
+}
 
-	// BFS from start
-	visited := make(map[types.Position]bool)
-	queue := []types.Position{start}
-	visited[start] = true
+// hollowTree hollows out all of a tree's heartwood and cuts an opening through the livewood
+// between the hollow and the outside. Leaves the tree solid if no livewood tile qualifies.
+func hollowTree(m *Map, shape map[types.Position]bool, heartwood, livewood []types.Position) {
+	isHeartwood := make(map[types.Position]bool, len(heartwood))
+	for _, pos := range heartwood {
+		isHeartwood[pos] = true
+	}
 
 	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
-	for len(queue) > 0 {
-		cur := queue[0]
-		queue = queue[1:]
-
+	var candidates []types.Position
+	for _, pos := range livewood {
+		inner, outer := false, false
 		for _, dir := range cardinalDirs {
-			neighbor := types.Position{X: cur.X + dir[0], Y: cur.Y + dir[1]}
-			if m.IsValid(neighbor) && !visited[neighbor] && !m.IsWater(neighbor) {
-				visited[neighbor] = true
-				queue = append(queue, neighbor)
+			next := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
+			if isHeartwood[next] {
+				inner = true
+			} else if !shape[next] {
+				outer = true
 			}
 		}
+		if inner && outer {
+			candidates = append(candidates, pos)
+		}
+	}
+	if len(candidates) == 0 {
+		return
 	}
+	sortPositions(candidates) // Stable order before picking so seeded generation is reproducible
 
-	// Verify all non-water tiles were reached
-	for y := 0; y < m.Height; y++ {
-		for x := 0; x < m.Width; x++ {
-			pos := types.Position{X: x, Y: y}
-			if !m.IsWater(pos) && !visited[pos] {
-				return false
+	for _, pos := range heartwood {
+		m.trees[pos] = TreeHollow
+	}
+	m.trees[candidates[rng.Intn(len(candidates))]] = TreeOpening
+}
+
+// treeShape returns the tile offsets of a roughly round trunk of the given width
+func treeShape(width int) [][2]int {
+	center := float64(width-1) / 2
+	radius := float64(width) / 2
+	var offsets [][2]int
+	for dy := 0; dy < width; dy++ {
+		for dx := 0; dx < width; dx++ {
+			ddx, ddy := float64(dx)-center, float64(dy)-center
+			if ddx*ddx+ddy*ddy <= radius*radius {
+				offsets = append(offsets, [2]int{dx, dy})
 			}
 		}
 	}
-	return true
+	return offsets
 }
 
-// SpawnGroundItems places initial sticks, nuts, and shells on the map.
-// Sticks and nuts go on random empty tiles; shells go adjacent to pond tiles.
+// SpawnGroundItems places initial sticks, nuts, shells and stones on the map.
+// Sticks and nuts go on random empty tiles in their biomes; shells go adjacent to pond tiles
+// and stones adjacent to rock outcrops.
 func SpawnGroundItems(m *Map) {
 	// Spawn sticks on random empty tiles
+	stickBiomes := affinityBiomes("stick")
 	for i := 0; i < config.GetGroundSpawnCount("stick"); i++ {
-		x, y := findEmptySpot(m)
+		x, y := findEmptySpotIn(m, stickBiomes)
 		m.AddItem(entity.NewStick(x, y))
 	}
 
 	// Spawn nuts on random empty tiles
+	nutBiomes := affinityBiomes("nut")
 	for i := 0; i < config.GetGroundSpawnCount("nut"); i++ {
-		x, y := findEmptySpot(m)
+		x, y := findEmptySpotIn(m, nutBiomes)
 		m.AddItem(entity.NewNut(x, y))
 	}
 
@@ -393,14 +488,23 @@ func SpawnGroundItems(m *Map) {
 	shellColors := types.ShellColors
 	for i := 0; i < config.GetGroundSpawnCount("shell") && len(pondAdjacentTiles) > 0; i++ {
 		// Pick a random pond-adjacent tile
-		idx := rand.Intn(len(pondAdjacentTiles))
+		idx := rng.Intn(len(pondAdjacentTiles))
 		pos := pondAdjacentTiles[idx]
 		// Remove chosen tile to avoid duplicates
 		pondAdjacentTiles = append(pondAdjacentTiles[:idx], pondAdjacentTiles[idx+1:]...)
 
-		color := shellColors[rand.Intn(len(shellColors))]
+		color := shellColors[rng.Intn(len(shellColors))]
 		m.AddItem(entity.NewShell(pos.X, pos.Y, color))
 	}
+
+	// Spawn stones beside rock outcrops
+	outcropAdjacentTiles := FindOutcropAdjacentEmptyTiles(m)
+	for i := 0; i < config.GetGroundSpawnCount("stone") && len(outcropAdjacentTiles) > 0; i++ {
+		idx := rng.Intn(len(outcropAdjacentTiles))
+		pos := outcropAdjacentTiles[idx]
+		outcropAdjacentTiles = append(outcropAdjacentTiles[:idx], outcropAdjacentTiles[idx+1:]...)
+		m.AddItem(entity.NewStone(pos.X, pos.Y))
+	}
 }
 
 // FindPondAdjacentEmptyTiles returns all empty tiles cardinally adjacent to pond water tiles
@@ -427,13 +531,13 @@ func FindPondAdjacentEmptyTiles(m *Map) []types.Position {
 	return result
 }
 
-// findEmptySpot finds a random position on the map with no character, water, or feature
+// findEmptySpot finds a random position on the map with no character, water, tree, or feature
 func findEmptySpot(m *Map) (int, int) {
 	for {
-		x := rand.Intn(m.Width)
-		y := rand.Intn(m.Height)
+		x := rng.Intn(m.Width)
+		y := rng.Intn(m.Height)
 		pos := types.Position{X: x, Y: y}
-		if !m.IsOccupied(pos) && !m.IsWater(pos) && m.FeatureAt(pos) == nil {
+		if !m.IsOccupied(pos) && !m.IsWater(pos) && m.TreeAt(pos) == TreeNone && m.FeatureAt(pos) == nil {
 			return x, y
 		}
 	}
@@ -100,6 +100,60 @@ func TestIsMapConnected_WaterWallPartitions(t *testing.T) {
 // Test Helpers
 // =============================================================================
 
+// isMapConnected returns true if all tiles without water, solid wood or rock are reachable from each other.
+// Uses BFS from the first walkable tile and verifies all walkable tiles are reached.
+func isMapConnected(m *Map) bool {
+	// Find first walkable tile
+	var start types.Position
+	found := false
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			pos := types.Position{X: x, Y: y}
+			if isOpenTerrain(m, pos) {
+				start = pos
+				found = true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+	if !found {
+		return true // entirely water, wood or rock — vacuously connected
+	}
+
+	// BFS from start
+	visited := make(map[types.Position]bool)
+	queue := []types.Position{start}
+	visited[start] = true
+
+	cardinalDirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, dir := range cardinalDirs {
+			neighbor := types.Position{X: cur.X + dir[0], Y: cur.Y + dir[1]}
+			if m.IsValid(neighbor) && !visited[neighbor] && isOpenTerrain(m, neighbor) {
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	// Verify all walkable tiles were reached
+	for y := 0; y < m.Height; y++ {
+		for x := 0; x < m.Width; x++ {
+			pos := types.Position{X: x, Y: y}
+			if isOpenTerrain(m, pos) && !visited[pos] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // findPondComponents flood-fills all pond tiles and returns connected components
 func findPondComponents(m *Map) [][]types.Position {
 	visited := make(map[types.Position]bool)
@@ -0,0 +1,201 @@
+// Package i18n holds the message catalogs for player-facing text.
+//
+// Each shipped locale is a flat JSON object in locales/<lang>.json mapping message keys
+// to fmt templates. Translations may reorder arguments with explicit indexes (%[2]s).
+// Messages that vary with a count are objects of plural forms ("one", "other", ...),
+// selected by the language's plural rule.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLanguage is the catalog every other locale is checked against and falls back to
+const DefaultLanguage = "en"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// message is one catalog entry: a single template, or templates per plural form
+type message struct {
+	text  string
+	forms map[string]string
+}
+
+// UnmarshalJSON accepts either a string or an object of plural forms
+func (msg *message) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &msg.text); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &msg.forms); err != nil {
+		return fmt.Errorf("message must be a string or an object of plural forms")
+	}
+	if _, ok := msg.forms["other"]; !ok {
+		return fmt.Errorf("plural message is missing the \"other\" form")
+	}
+	return nil
+}
+
+// pluralRules maps a language to the function choosing a plural form for a count.
+// Languages not listed use the English rule.
+var pluralRules = map[string]func(n int) string{
+	"en": oneOther,
+	"es": oneOther,
+}
+
+// oneOther is the rule for languages with a singular for exactly one
+func oneOther(n int) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+var (
+	mu       sync.RWMutex
+	catalogs map[string]map[string]message // language -> key -> message
+	language = DefaultLanguage
+)
+
+func init() {
+	var err error
+	catalogs, err = loadCatalogs()
+	if err != nil {
+		panic(err) // Shipped locales are validated by tests; a bad file is a build error
+	}
+}
+
+// loadCatalogs parses every embedded locale file
+func loadCatalogs() (map[string]map[string]message, error) {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]map[string]message)
+	for _, f := range files {
+		data, err := localeFS.ReadFile(path.Join("locales", f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var catalog map[string]message
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("locale %s: %w", f.Name(), err)
+		}
+		result[strings.TrimSuffix(f.Name(), ".json")] = catalog
+	}
+	if _, ok := result[DefaultLanguage]; !ok {
+		return nil, fmt.Errorf("missing %s locale", DefaultLanguage)
+	}
+	return result, nil
+}
+
+// Languages returns the shipped language codes, sorted
+func Languages() []string {
+	var langs []string
+	for lang := range catalogs {
+		langs = append(langs, lang)
+	}
+	// Sort for consistent ordering (maps iterate randomly)
+	sort.Strings(langs)
+	return langs
+}
+
+// SetLanguage selects the language for all subsequent messages
+func SetLanguage(lang string) error {
+	if _, ok := catalogs[lang]; !ok {
+		return fmt.Errorf("unknown language %q (available: %s)", lang, strings.Join(Languages(), ", "))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	language = lang
+	return nil
+}
+
+// Language returns the active language code
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return language
+}
+
+// lookup finds a message in the active language, falling back to the default language
+func lookup(key string) (message, string, bool) {
+	lang := Language()
+	if msg, ok := catalogs[lang][key]; ok {
+		return msg, lang, true
+	}
+	msg, ok := catalogs[DefaultLanguage][key]
+	return msg, DefaultLanguage, ok
+}
+
+// Has reports whether key exists in the catalogs
+func Has(key string) bool {
+	_, _, ok := lookup(key)
+	return ok
+}
+
+// T returns the message for key formatted with args. Unknown keys return the key itself.
+func T(key string, args ...any) string {
+	msg, _, ok := lookup(key)
+	if !ok {
+		return key
+	}
+	text := msg.text
+	if msg.forms != nil {
+		text = msg.forms["other"]
+	}
+	return format(text, args)
+}
+
+// N returns the plural form of key for count n, formatted with args.
+// n only selects the form; pass it in args as well if the template shows it.
+func N(key string, n int, args ...any) string {
+	msg, lang, ok := lookup(key)
+	if !ok {
+		return key
+	}
+	if msg.forms == nil {
+		return format(msg.text, args)
+	}
+	rule, ok := pluralRules[lang]
+	if !ok {
+		rule = oneOther
+	}
+	text, ok := msg.forms[rule(n)]
+	if !ok {
+		text = msg.forms["other"]
+	}
+	return format(text, args)
+}
+
+// Or returns the message for key, or fallback if no catalog has it
+func Or(key, fallback string, args ...any) string {
+	if !Has(key) {
+		return fallback
+	}
+	return T(key, args...)
+}
+
+// Content returns the name of built-in content (activities, recipes) in the active language.
+// The default language shows name as loaded so content packs can rename built-in entries;
+// other languages fall back to name when the catalog has no translation (e.g., mod content).
+func Content(key, name string) string {
+	if Language() == DefaultLanguage || !Has(key) {
+		return name
+	}
+	return T(key)
+}
+
+// format applies args to a template; templates without args are returned as-is so a literal % survives
+func format(text string, args []any) string {
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
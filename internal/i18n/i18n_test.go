@@ -0,0 +1,213 @@
+package i18n
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// verbPattern matches fmt verbs, including explicit argument indexes such as %[2]s
+var verbPattern = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+// verbs returns the sorted verb letters of a template, ignoring argument order
+func verbs(text string) string {
+	var letters []string
+	for _, match := range verbPattern.FindAllString(text, -1) {
+		letters = append(letters, match[len(match)-1:])
+	}
+	sort.Strings(letters)
+	return strings.Join(letters, "")
+}
+
+// templates returns every template of a message, plural forms included
+func templates(msg message) []string {
+	if msg.forms == nil {
+		return []string{msg.text}
+	}
+	var texts []string
+	for _, text := range msg.forms {
+		texts = append(texts, text)
+	}
+	return texts
+}
+
+func TestCatalogs_EveryLocaleHasEveryKey(t *testing.T) {
+	t.Parallel()
+
+	base := catalogs[DefaultLanguage]
+	if len(base) == 0 {
+		t.Fatalf("default catalog %q is empty", DefaultLanguage)
+	}
+	for _, lang := range Languages() {
+		catalog := catalogs[lang]
+		for key := range base {
+			if _, ok := catalog[key]; !ok {
+				t.Errorf("%s: missing key %q", lang, key)
+			}
+		}
+		for key := range catalog {
+			if _, ok := base[key]; !ok {
+				t.Errorf("%s: key %q is not in the %s catalog", lang, key, DefaultLanguage)
+			}
+		}
+	}
+}
+
+func TestCatalogs_TranslationsKeepArguments(t *testing.T) {
+	t.Parallel()
+
+	for _, lang := range Languages() {
+		for key, msg := range catalogs[lang] {
+			want := verbs(templates(catalogs[DefaultLanguage][key])[0])
+			for _, text := range templates(msg) {
+				if got := verbs(text); got != want {
+					t.Errorf("%s: %q has verbs %q, want %q (%q)", lang, key, got, want, text)
+				}
+			}
+		}
+	}
+}
+
+func TestCatalogs_PluralFormsMatchRule(t *testing.T) {
+	t.Parallel()
+
+	for _, lang := range Languages() {
+		rule, ok := pluralRules[lang]
+		if !ok {
+			t.Errorf("%s: no plural rule", lang)
+			continue
+		}
+		for key, msg := range catalogs[lang] {
+			if msg.forms == nil {
+				continue
+			}
+			for _, n := range []int{0, 1, 2, 5, 21} {
+				if _, ok := msg.forms[rule(n)]; !ok {
+					t.Errorf("%s: %q has no %q form for %d", lang, key, rule(n), n)
+				}
+			}
+		}
+	}
+}
+
+// sourceKeyPattern matches whole message keys passed as literals to the catalog functions.
+// Keys built from a prefix (e.g., "level." + stat) are not checked.
+var sourceKeyPattern = regexp.MustCompile(`i18n\.(?:T|N|Or|Has)\("([^"]+)"\s*[,)]`)
+
+func TestCatalogs_CoverSourceKeys(t *testing.T) {
+	t.Parallel()
+
+	found := 0
+	for _, dir := range []string{"../../internal", "../../cmd"} {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, match := range sourceKeyPattern.FindAllStringSubmatch(string(data), -1) {
+				found++
+				for _, lang := range Languages() {
+					if _, ok := catalogs[lang][match[1]]; !ok {
+						t.Errorf("%s: key %q used in %s is missing", lang, match[1], path)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if found == 0 {
+		t.Fatal("no message keys found in source; has the call syntax changed?")
+	}
+}
+
+// Not parallel: SetLanguage mutates global state
+func TestT_FormatsActiveLanguage(t *testing.T) {
+	t.Cleanup(func() { SetLanguage(DefaultLanguage) })
+
+	if got := T("log.built", "Stick Fence"); got != "Built Stick Fence" {
+		t.Errorf("en: got %q", got)
+	}
+	if err := SetLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	if got := T("log.built", "Cerca de palos"); got != "Construyó Cerca de palos" {
+		t.Errorf("es: got %q", got)
+	}
+	if Language() != "es" {
+		t.Errorf("Language() = %q, want es", Language())
+	}
+}
+
+func TestT_MissingKeyReturnsKey(t *testing.T) {
+	t.Parallel()
+
+	if got := T("no.such.key", 1); got != "no.such.key" {
+		t.Errorf("got %q, want the key", got)
+	}
+	if got := Or("no.such.key", "fallback"); got != "fallback" {
+		t.Errorf("Or: got %q, want fallback", got)
+	}
+}
+
+func TestT_NoArgsKeepsLiteralPercent(t *testing.T) {
+	t.Parallel()
+
+	if got := format("100%", nil); got != "100%" {
+		t.Errorf("got %q, want 100%%", got)
+	}
+}
+
+func TestN_SelectsPluralForm(t *testing.T) {
+	t.Parallel()
+
+	if got := N("noun.berry", 1); got != "berry" {
+		t.Errorf("N(1) = %q, want berry", got)
+	}
+	if got := N("noun.berry", 3); got != "berries" {
+		t.Errorf("N(3) = %q, want berries", got)
+	}
+	if got := N("ui.days_ago", 2, 2); got != "2 days ago" {
+		t.Errorf("N(2) = %q, want 2 days ago", got)
+	}
+}
+
+func TestSetLanguage_RejectsUnknown(t *testing.T) {
+	t.Parallel()
+
+	err := SetLanguage("xx")
+	if err == nil {
+		t.Fatal("expected error for unknown language")
+	}
+	if !strings.Contains(err.Error(), "es") {
+		t.Errorf("error should list available languages, got %v", err)
+	}
+}
+
+// Not parallel: SetLanguage mutates global state
+func TestContent_DefaultLanguageKeepsLoadedName(t *testing.T) {
+	t.Cleanup(func() { SetLanguage(DefaultLanguage) })
+
+	// A content pack may rename a built-in activity; English shows the pack's name
+	if got := Content("activity.eat", "Feast"); got != "Feast" {
+		t.Errorf("en: got %q, want Feast", got)
+	}
+	if err := SetLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Content("activity.eat", "Eat"); got != "Comer" {
+		t.Errorf("es: got %q, want Comer", got)
+	}
+	if got := Content("activity.modded", "Juggle"); got != "Juggle" {
+		t.Errorf("es fallback: got %q, want Juggle", got)
+	}
+}
@@ -0,0 +1,43 @@
+// Package rng is the shared random source for world generation and simulation.
+// All game randomness draws from here so a world can be reproduced from a seed.
+package rng
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	mu  sync.Mutex
+	src = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// Seed resets the shared source to a deterministic sequence
+func Seed(seed int64) {
+	mu.Lock()
+	defer mu.Unlock()
+	src = rand.New(rand.NewSource(seed))
+}
+
+// Intn returns a random int in [0, n). Panics if n <= 0.
+func Intn(n int) int {
+	mu.Lock()
+	defer mu.Unlock()
+	return src.Intn(n)
+}
+
+// Float64 returns a random float64 in [0.0, 1.0)
+func Float64() float64 {
+	mu.Lock()
+	defer mu.Unlock()
+	return src.Float64()
+}
+
+// Shuffle pseudo-randomizes the order of n elements using swap.
+// swap must not draw from this package.
+func Shuffle(n int, swap func(i, j int)) {
+	mu.Lock()
+	defer mu.Unlock()
+	src.Shuffle(n, swap)
+}
@@ -0,0 +1,40 @@
+package rng
+
+import "testing"
+
+func TestSeed_ReproducesSequence(t *testing.T) {
+	Seed(42)
+	first := []int{Intn(1000), Intn(1000), Intn(1000)}
+	f1 := Float64()
+
+	Seed(42)
+	second := []int{Intn(1000), Intn(1000), Intn(1000)}
+	f2 := Float64()
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("Draw %d: expected %d after reseed, got %d", i, first[i], second[i])
+		}
+	}
+	if f1 != f2 {
+		t.Errorf("Float64: expected %f after reseed, got %f", f1, f2)
+	}
+}
+
+func TestShuffle_Deterministic(t *testing.T) {
+	shuffled := func() []int {
+		s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+		return s
+	}
+
+	Seed(7)
+	a := shuffled()
+	Seed(7)
+	b := shuffled()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("Expected identical shuffles, got %v and %v", a, b)
+		}
+	}
+}
@@ -60,6 +60,24 @@ func BaseDir() (string, error) {
 	return filepath.Join(home, ".petri"), nil
 }
 
+// GlobalTuningPath returns the path of the tuning file applied to every world (~/.petri/tuning.json)
+func GlobalTuningPath() (string, error) {
+	baseDir, err := BaseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(baseDir, "tuning.json"), nil
+}
+
+// WorldTuningPath returns the path of a world's own tuning file
+func WorldTuningPath(worldID string) (string, error) {
+	dir, err := WorldDir(worldID)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "tuning.json"), nil
+}
+
 // EnsureWorldDir creates the world directory if it doesn't exist
 func EnsureWorldDir(worldID string) (string, error) {
 	dir, err := WorldDir(worldID)
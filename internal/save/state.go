@@ -23,11 +23,23 @@ type SaveState struct {
 	Items                      []ItemSave             `json:"items"`
 	Features                   []FeatureSave          `json:"features"`
 	Constructs                 []ConstructSave        `json:"constructs,omitempty"`
+	Creatures                  []CreatureSave         `json:"creatures,omitempty"`
 	WaterTiles                 []WaterTileSave        `json:"water_tiles,omitempty"`
+	TreeTiles                  []TreeTileSave         `json:"tree_tiles,omitempty"`
+	Biomes                     []BiomeTileSave        `json:"biomes,omitempty"` // Non-meadow tiles only
+	ChannelTiles               []ChannelTileSave      `json:"channel_tiles,omitempty"`
+	DriedPondBeds              []types.Position       `json:"dried_pond_beds,omitempty"`
 	ClayPositions              []types.Position       `json:"clay_positions,omitempty"`
+	OutcropPositions           []types.Position       `json:"outcrop_positions,omitempty"`
 	TilledPositions            []types.Position       `json:"tilled_positions,omitempty"`
+	SoilTiles                  []SoilTileSave         `json:"soil_tiles,omitempty"`
+	BurningTiles               []BurningTileSave      `json:"burning_tiles,omitempty"`
 	MarkedForTillingPositions  []types.Position       `json:"marked_for_tilling,omitempty"`
 	MarkedForConstructionTiles []ConstructionMarkSave `json:"marked_for_construction,omitempty"`
+	MarkedForDeconstruction    []types.Position       `json:"marked_for_deconstruction,omitempty"`
+	MarkedForCarving           []types.Position       `json:"marked_for_carving,omitempty"`
+	MarkedForChopping          []types.Position       `json:"marked_for_chopping,omitempty"`
+	MarkedForDigging           []types.Position       `json:"marked_for_digging,omitempty"`
 	ConstructionLineID         int                    `json:"construction_line_id,omitempty"`
 	WateredTiles               []WateredTileSave      `json:"watered_tiles_manual,omitempty"`
 	ActionLogs                 map[int][]EventSave    `json:"action_logs"` // Per-character event logs, keyed by char ID
@@ -38,6 +50,30 @@ type SaveState struct {
 	GroundSpawnStick float64 `json:"ground_spawn_stick,omitempty"`
 	GroundSpawnNut   float64 `json:"ground_spawn_nut,omitempty"`
 	GroundSpawnShell float64 `json:"ground_spawn_shell,omitempty"`
+	GroundSpawnStone float64 `json:"ground_spawn_stone,omitempty"`
+
+	// Countdown to the next wild creature arrival
+	CreatureSpawnTimer float64 `json:"creature_spawn_timer,omitempty"`
+
+	// Seasonal calendar: current season ("spring", "summer", "autumn", "winter"; empty = spring)
+	// and game seconds spent in it
+	Season        string  `json:"season,omitempty"`
+	SeasonElapsed float64 `json:"season_elapsed,omitempty"`
+
+	// Weather: current spell and forecast ("clear", "rain", "storm", "drought"; empty = clear)
+	// and game seconds left in the current spell
+	Weather          string  `json:"weather,omitempty"`
+	Forecast         string  `json:"forecast,omitempty"`
+	WeatherRemaining float64 `json:"weather_remaining,omitempty"`
+
+	// Per-tick systems turned off for this world, by name
+	DisabledSystems []string `json:"disabled_systems,omitempty"`
+
+	// Content packs the world was created with ("id@version"); empty = base only
+	ContentPacks []string `json:"content_packs,omitempty"`
+
+	// Per-world tuning overrides (tunable key -> value), layered over the global tuning file
+	Tuning map[string]float64 `json:"tuning,omitempty"`
 }
 
 // ConstructionMarkSave represents a marked-for-construction tile for serialization
@@ -77,6 +113,7 @@ type EventSave struct {
 	CharName string  `json:"char_name"`
 	Type     string  `json:"type"`
 	Message  string  `json:"message"`
+	Key      string  `json:"key,omitempty"` // Message catalog key (empty for events saved before localization)
 }
 
 // CharacterSave represents a character for serialization
@@ -86,11 +123,12 @@ type CharacterSave struct {
 	types.Position
 
 	// Stats
-	Health float64 `json:"health"`
-	Hunger float64 `json:"hunger"`
-	Thirst float64 `json:"thirst"`
-	Energy float64 `json:"energy"`
-	Mood   float64 `json:"mood"`
+	Health float64  `json:"health"`
+	Hunger float64  `json:"hunger"`
+	Thirst float64  `json:"thirst"`
+	Energy float64  `json:"energy"`
+	Mood   float64  `json:"mood"`
+	Warmth *float64 `json:"warmth,omitempty"` // nil in saves from before temperature (fully warm)
 
 	// Status
 	Poisoned    bool    `json:"poisoned"`
@@ -110,6 +148,9 @@ type CharacterSave struct {
 	LastLookedY   int     `json:"last_looked_y"`
 	HasLastLooked bool    `json:"has_last_looked"`
 
+	// Threat response
+	FearCooldown float64 `json:"fear_cooldown,omitempty"`
+
 	// Talking (partner stored as ID, -1 if none)
 	TalkingWithID int     `json:"talking_with_id"`
 	TalkTimer     float64 `json:"talk_timer"`
@@ -184,6 +225,7 @@ type ItemSave struct {
 	Edible    bool `json:"edible"`
 	Poisonous bool `json:"poisonous"`
 	Healing   bool `json:"healing"`
+	Cooked    bool `json:"cooked,omitempty"`
 
 	Plantable bool `json:"plantable,omitempty"`
 
@@ -192,12 +234,45 @@ type ItemSave struct {
 	BundleCount int `json:"bundle_count,omitempty"`
 
 	DeathTimer float64 `json:"death_timer"`
+
+	Durability float64 `json:"durability,omitempty"` // Remaining durability for crafted items (0 = full, for older saves)
 }
 
 // WaterTileSave represents a water tile for serialization
 type WaterTileSave struct {
 	types.Position
-	WaterType int `json:"water_type"` // WaterType enum value (1=spring, 2=pond)
+	WaterType int `json:"water_type"` // WaterType enum value (1=spring, 2=pond, 3=river, 4=channel)
+}
+
+// BiomeTileSave represents a tile's biome for serialization
+type BiomeTileSave struct {
+	types.Position
+	Biome int `json:"biome"` // Biome enum value (1=wetland, 2=forest edge, 3=clay flats)
+}
+
+// ChannelTileSave represents an irrigation channel's silt timer for serialization
+type ChannelTileSave struct {
+	types.Position
+	Silt float64 `json:"silt"` // seconds left before the channel silts up
+}
+
+// BurningTileSave represents a wildfire's burn timer for serialization
+type BurningTileSave struct {
+	types.Position
+	Remaining float64 `json:"remaining"` // seconds left before the tile burns out
+}
+
+// SoilTileSave represents a tilled tile's fertility and last crop for serialization
+type SoilTileSave struct {
+	types.Position
+	Fertility float64 `json:"fertility"`
+	LastCrop  string  `json:"last_crop,omitempty"` // item type last harvested here
+}
+
+// TreeTileSave represents a tree tile for serialization
+type TreeTileSave struct {
+	types.Position
+	TreeTile int `json:"tree_tile"` // TreeTile enum value (1=livewood, 2=heartwood, 3=hollow, 4=opening)
 }
 
 // WateredTileSave represents a manually watered tile with remaining timer
@@ -217,6 +292,25 @@ type ConstructSave struct {
 	Passable      bool           `json:"passable"`
 	Movable       bool           `json:"movable"`
 	WallRole      string         `json:"wall_role,omitempty"`
+	Durability    float64        `json:"durability,omitempty"` // Remaining durability (0 = full, for older saves)
+	Fuel          float64        `json:"fuel,omitempty"`       // Seconds of burning left (campfires)
+}
+
+// CreatureSave represents a wild creature for serialization
+type CreatureSave struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+	types.Position
+
+	Hunger     float64 `json:"hunger"`
+	Thirst     float64 `json:"thirst"`
+	Energy     float64 `json:"energy"`
+	IsSleeping bool    `json:"is_sleeping,omitempty"`
+
+	StayTimer float64 `json:"stay_timer"`
+	Leaving   bool    `json:"leaving,omitempty"`
+
+	CurrentActivity string `json:"current_activity,omitempty"`
 }
 
 // FeatureSave represents a feature for serialization
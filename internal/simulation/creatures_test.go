@@ -0,0 +1,171 @@
+package simulation
+
+import (
+	"testing"
+
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/system"
+	"petri/internal/types"
+)
+
+// creatureWorld creates a character-free world with no ponds, food, or beds,
+// and holds off natural creature arrivals so tests control every creature
+func creatureWorld() *TestWorld {
+	world := CreateTestWorld(WorldOptions{NoCharacters: true, NoWater: true, NoFood: true, NoBeds: true})
+	world.CreatureSpawnTimer = 1e9
+	return world
+}
+
+// assertCreaturesOnWalkableTiles verifies no creature stands on water or an impassable tile
+func assertCreaturesOnWalkableTiles(t *testing.T, world *TestWorld) {
+	t.Helper()
+	for _, c := range world.GameMap.Creatures() {
+		pos := c.Pos()
+		if !world.GameMap.IsValid(pos) || world.GameMap.IsWater(pos) {
+			t.Errorf("Creature %d at invalid tile (%d, %d)", c.ID, pos.X, pos.Y)
+		}
+	}
+}
+
+func TestSimulation_CreatureArrivesAtEdge(t *testing.T) {
+	t.Parallel()
+
+	world := creatureWorld()
+	world.CreatureSpawnTimer = 0.01
+
+	// Check the arrival itself, before the creature's first update can move it in from the edge
+	system.UpdateCreatureSpawning(world.GameMap, tickDelta, &world.CreatureSpawnTimer)
+
+	creatures := world.GameMap.Creatures()
+	if len(creatures) != 1 {
+		t.Fatalf("Expected 1 creature after spawn timer fired, got %d", len(creatures))
+	}
+	pos := creatures[0].Pos()
+	if pos.X != 0 && pos.Y != 0 && pos.X != world.GameMap.Width-1 && pos.Y != world.GameMap.Height-1 {
+		t.Errorf("Expected creature to arrive on the map edge, got (%d, %d)", pos.X, pos.Y)
+	}
+	if world.CreatureSpawnTimer <= 0 {
+		t.Error("Expected spawn timer to reset after firing")
+	}
+}
+
+func TestSimulation_RabbitPrefersPlantOnTilledSoil(t *testing.T) {
+	t.Parallel()
+
+	world := creatureWorld()
+	rabbit := entity.NewCreature(20, 20, "rabbit")
+	rabbit.Hunger = 60
+	world.GameMap.AddCreature(rabbit)
+
+	// The wild berry is closer, but the tilled one is within the tilled-soil bonus
+	wild := entity.NewBerry(22, 20, types.ColorRed, false, false)
+	world.GameMap.AddItem(wild)
+	world.GameMap.SetTilled(types.Position{X: 26, Y: 20})
+	tilled := entity.NewBerry(26, 20, types.ColorRed, false, false)
+	world.GameMap.AddItem(tilled)
+
+	for i := 0; i < 200 && world.GameMap.HasItemOnMap(tilled); i++ {
+		RunTick(world, tickDelta)
+	}
+
+	if world.GameMap.HasItemOnMap(tilled) {
+		t.Fatal("Expected rabbit to eat the berry on tilled soil")
+	}
+	if !world.GameMap.HasItemOnMap(wild) {
+		t.Error("Expected rabbit to pass over the wild berry for the tilled one")
+	}
+	if rabbit.Hunger >= 60 {
+		t.Errorf("Expected eating to reduce hunger, got %.1f", rabbit.Hunger)
+	}
+	assertCreaturesOnWalkableTiles(t, world)
+}
+
+func TestSimulation_RabbitDrinksFromPond(t *testing.T) {
+	t.Parallel()
+
+	world := creatureWorld()
+	world.GameMap.AddWater(types.Position{X: 30, Y: 20}, game.WaterPond)
+	rabbit := entity.NewCreature(20, 20, "rabbit")
+	rabbit.Thirst = 80
+	world.GameMap.AddCreature(rabbit)
+
+	for i := 0; i < 200 && rabbit.Thirst >= 70; i++ {
+		RunTick(world, tickDelta)
+	}
+
+	if rabbit.Thirst >= 70 {
+		t.Errorf("Expected rabbit to drink, thirst still %.1f", rabbit.Thirst)
+	}
+	if !rabbit.Pos().IsCardinallyAdjacentTo(types.Position{X: 30, Y: 20}) {
+		t.Errorf("Expected rabbit beside the pond, got %v", rabbit.Pos())
+	}
+	assertCreaturesOnWalkableTiles(t, world)
+}
+
+func TestSimulation_RabbitLeavesThroughEdgeAfterStay(t *testing.T) {
+	t.Parallel()
+
+	world := creatureWorld()
+	rabbit := entity.NewCreature(world.GameMap.Width/2, world.GameMap.Height/2, "rabbit")
+	rabbit.StayTimer = 1
+	world.GameMap.AddCreature(rabbit)
+
+	for i := 0; i < 300 && len(world.GameMap.Creatures()) > 0; i++ {
+		RunTick(world, tickDelta)
+		assertCreaturesOnWalkableTiles(t, world)
+	}
+
+	if len(world.GameMap.Creatures()) != 0 {
+		t.Fatalf("Expected rabbit to leave the map, still at %v (leaving=%v)", rabbit.Pos(), rabbit.Leaving)
+	}
+	if !rabbit.Leaving {
+		t.Error("Expected rabbit to be marked as leaving")
+	}
+}
+
+func TestSimulation_CreaturesInFullWorld(t *testing.T) {
+	t.Parallel()
+
+	world := CreateTestWorld(WorldOptions{})
+	world.CreatureSpawnTimer = 0.01
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Simulation panicked with creatures: %v", r)
+		}
+	}()
+
+	RunTicks(world, 1000, tickDelta)
+
+	assertCreaturesOnWalkableTiles(t, world)
+	assertNoPositionDuplicates(t, world)
+	assertCharacterMapConsistency(t, world)
+}
+
+func TestSimulation_CharacterChasesOffRabbit(t *testing.T) {
+	t.Parallel()
+
+	world := creatureWorld()
+	char := entity.NewCharacter(1, 20, 20, "Len", "berry", types.ColorRed)
+	world.GameMap.AddCharacter(char)
+	rabbit := entity.NewCreature(23, 20, "rabbit")
+	world.GameMap.AddCreature(rabbit)
+
+	for i := 0; i < 100 && !rabbit.Leaving; i++ {
+		RunTick(world, tickDelta)
+	}
+
+	if !rabbit.Leaving {
+		t.Fatalf("Expected the character to chase the rabbit off (activity %q)", char.CurrentActivity)
+	}
+	found := false
+	for _, e := range world.ActionLog.Events(char.ID, 50) {
+		if e.Key == "log.chased_off" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected a chased_off log entry")
+	}
+}
@@ -124,7 +124,7 @@ func TestObserveFoodScarcity(t *testing.T) {
 	fmt.Printf("Initial edible items: %d\n", initialEdible)
 	fmt.Printf("Characters: %d\n", len(world.GameMap.Characters()))
 	fmt.Printf("Spawn chance: %.0f%%, Interval base: %.1fs\n",
-		config.ItemSpawnChance*100, config.ItemLifecycle["berry"].SpawnInterval)
+		config.ItemSpawnChance*100, config.ItemLifecycle["berry"].SpawnSeconds())
 
 	// Run simulation tracking consumption - checkpoints every 5 world days
 	checkpoints := []int{4000, 8000, 12000, 16000} // 5, 10, 15, 20 world days
@@ -167,7 +167,7 @@ func TestObserveFlowerGrowth(t *testing.T) {
 
 	fmt.Println("\n=== FLOWER GROWTH OBSERVATION ===")
 	fmt.Printf("Flower spawn interval: %.0fs, death interval: %.0fs\n",
-		config.ItemLifecycle["flower"].SpawnInterval,
+		config.ItemLifecycle["flower"].SpawnSeconds(),
 		config.ItemLifecycle["flower"].DeathInterval)
 	fmt.Printf("(Note: intervals multiplied by item count ~20 in lifecycle.go)\n")
 
@@ -440,8 +440,8 @@ func TestObserveGourdReproduction(t *testing.T) {
 	fmt.Printf("Running simulation for %d ticks (%.0f game-seconds) with NO characters\n",
 		ticks, float64(ticks)*delta)
 	fmt.Printf("Spawn interval: %.0fs (~%d ticks)\n\n",
-		config.ItemLifecycle["gourd"].SpawnInterval,
-		int(config.ItemLifecycle["gourd"].SpawnInterval/delta))
+		config.ItemLifecycle["gourd"].SpawnSeconds(),
+		int(config.ItemLifecycle["gourd"].SpawnSeconds()/delta))
 
 	// Create world without characters so items don't get eaten
 	world := CreateTestWorld(WorldOptions{NoCharacters: true})
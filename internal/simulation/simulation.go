@@ -21,9 +21,12 @@ type WorldOptions struct {
 
 // TestWorld holds all components needed to run a simulation
 type TestWorld struct {
-	GameMap           *game.Map
-	ActionLog         *system.ActionLog
-	GroundSpawnTimers system.GroundSpawnTimers
+	GameMap            *game.Map
+	ActionLog          *system.ActionLog
+	GroundSpawnTimers  system.GroundSpawnTimers
+	CreatureSpawnTimer float64
+	GameTime           float64          // Elapsed game seconds (drives the time of day)
+	Pipeline           *system.Pipeline // Same systems, in the same order, as the game
 }
 
 // CreateTestWorld creates a world configured for testing
@@ -73,46 +76,27 @@ func CreateTestWorld(opts WorldOptions) *TestWorld {
 			Stick: system.RandomGroundSpawnInterval(),
 			Nut:   system.RandomGroundSpawnInterval(),
 			Shell: system.RandomGroundSpawnInterval(),
+			Stone: system.RandomGroundSpawnInterval(),
 		},
+		CreatureSpawnTimer: system.RandomCreatureSpawnInterval(),
+		Pipeline:           system.DefaultPipeline(),
 	}
 }
 
-// RunTick runs one complete simulation tick
+// RunTick runs one complete simulation tick through the world's system pipeline
 func RunTick(world *TestWorld, delta float64) {
-	chars := world.GameMap.Characters()
-	items := world.GameMap.Items()
-
-	// Phase 1: Update survival for all characters
-	for _, char := range chars {
-		system.UpdateSurvival(char, delta, world.ActionLog)
-	}
-
-	// Phase 2: Calculate intents for all characters
-	for _, char := range chars {
-		oldIntent := char.Intent
-		char.Intent = system.CalculateIntent(char, items, world.GameMap, world.ActionLog, nil)
-
-		// Reset action progress if intent action changed
-		if oldIntent == nil || char.Intent == nil || oldIntent.Action != char.Intent.Action {
-			char.ActionProgress = 0
-		}
-	}
-
-	// Phase 3: Apply intents
-	for _, char := range chars {
-		applyIntent(char, world.GameMap, delta, world.ActionLog)
-	}
-
-	// Phase 4: Update item lifecycle
-	initialItemCount := config.ItemSpawnCount*2 + config.FlowerSpawnCount // berries + mushrooms + flowers
-	system.UpdateSpawnTimers(world.GameMap, initialItemCount, delta)
-	system.UpdateSproutTimers(world.GameMap, initialItemCount, delta)
-	system.UpdateDeathTimers(world.GameMap, delta)
-	system.UpdateSeedTimers(world.GameMap, delta)
-	world.GameMap.UpdateWateredTimers(delta)
-
-	// Phase 5: Update ground spawning (sticks, nuts, shells)
-	system.UpdateGroundSpawning(world.GameMap, delta, &world.GroundSpawnTimers)
+	world.GameTime += delta
+	world.Pipeline.Run(&system.TickContext{
+		GameMap:            world.GameMap,
+		ActionLog:          world.ActionLog,
+		Delta:              delta,
+		GameTime:           world.GameTime,
+		GroundSpawnTimers:  &world.GroundSpawnTimers,
+		CreatureSpawnTimer: &world.CreatureSpawnTimer,
+		ApplyIntent: func(char *entity.Character, delta float64) {
+			applyIntent(char, world.GameMap, delta, world.ActionLog)
+		},
+	})
 }
 
 // RunTicks runs n simulation ticks
@@ -180,7 +164,65 @@ func applyIntent(char *entity.Character, gameMap *game.Map, delta float64, actio
 
 	case entity.ActionDig:
 		applyDigIntent(char, gameMap, delta, actionLog)
+
+	case entity.ActionFlee:
+		if char.Pos() == char.Intent.Dest {
+			char.Intent = nil
+			return
+		}
+		stepCharacter(char, gameMap, delta)
+
+	case entity.ActionDeconstruct:
+		applyDeconstructIntent(char, gameMap, delta, actionLog)
+	case entity.ActionCarve:
+		applyCarveIntent(char, gameMap, delta, actionLog)
+	case entity.ActionChop:
+		applyChopIntent(char, gameMap, delta, actionLog)
+	case entity.ActionDigChannel:
+		applyDigChannelIntent(char, gameMap, delta, actionLog)
+	case entity.ActionCompost:
+		applyCompostIntent(char, gameMap, delta, actionLog)
+	case entity.ActionBuildCampfire:
+		applyBuildCampfireIntent(char, gameMap, delta, actionLog)
+	case entity.ActionCook:
+		applyCookIntent(char, gameMap, delta, actionLog)
+	case entity.ActionExtinguish:
+		applyExtinguishIntent(char, gameMap, delta, actionLog)
+
+	case entity.ActionWarmUp, entity.ActionShelter:
+		if char.Pos() != char.Intent.Dest {
+			stepCharacter(char, gameMap, delta)
+		}
+
+	case entity.ActionShoo:
+		target := char.Intent.TargetCreature
+		if target == nil {
+			return
+		}
+		if !char.Pos().IsAdjacentTo(target.Pos()) {
+			stepCharacter(char, gameMap, delta)
+			return
+		}
+		char.ActionProgress += delta
+		if char.ActionProgress >= config.ActionDurationShort {
+			char.ActionProgress = 0
+			system.ShooCreature(char, target, actionLog)
+			char.Intent = nil
+		}
+	}
+}
+
+// stepCharacter takes one speed-gated step toward the intent's Target
+func stepCharacter(char *entity.Character, gameMap *game.Map, delta float64) {
+	char.SpeedAccumulator += float64(char.EffectiveSpeed()) * delta
+
+	const movementThreshold = 7.5
+
+	if char.SpeedAccumulator < movementThreshold {
+		return
 	}
+	char.SpeedAccumulator -= movementThreshold
+	gameMap.MoveCharacter(char, char.Intent.Target)
 }
 
 // applyPickupIntent handles foraging - movement and pickup at destination
@@ -303,16 +345,16 @@ func applyMoveIntent(char *entity.Character, gameMap *game.Map, delta float64, a
 		// Log energy milestones crossed by movement drain
 		if !char.IsSleeping && actionLog != nil {
 			if prevEnergy > 50 && char.Energy <= 50 {
-				actionLog.Add(char.ID, char.Name, "energy", "Getting tired")
+				actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.mild")
 			}
 			if prevEnergy > 25 && char.Energy <= 25 {
-				actionLog.Add(char.ID, char.Name, "energy", "Very tired!")
+				actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.moderate")
 			}
 			if prevEnergy > 10 && char.Energy <= 10 {
-				actionLog.Add(char.ID, char.Name, "energy", "Exhausted!")
+				actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.severe")
 			}
 			if prevEnergy > 0 && char.Energy <= 0 {
-				actionLog.Add(char.ID, char.Name, "energy", "Collapsed from exhaustion!")
+				actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.crisis")
 			}
 		}
 	}
@@ -438,6 +480,195 @@ func applyDigIntent(char *entity.Character, gameMap *game.Map, delta float64, ac
 	}
 }
 
+// applyDeconstructIntent handles ActionDeconstruct in simulation: walk beside the construct, then dismantle it.
+func applyDeconstructIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	targetPos := *char.Intent.TargetBuildPos
+	construct := gameMap.ConstructAt(targetPos)
+	if construct == nil || !gameMap.IsMarkedForDeconstruction(targetPos) {
+		char.Intent = nil
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+
+	// Working phase
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.DeconstructConstruct(gameMap, construct, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyCarveIntent handles ActionCarve in simulation: walk beside the marked tree tile, then carve it.
+func applyCarveIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	targetPos := *char.Intent.TargetBuildPos
+	if !gameMap.IsMarkedForCarving(targetPos) || !gameMap.CanCarve(targetPos) {
+		char.Intent = nil
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+
+	// Working phase
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.CarveTreeTile(gameMap, targetPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyChopIntent handles ActionChop in simulation: walk beside the marked tree tile, then fell it.
+func applyChopIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	targetPos := *char.Intent.TargetBuildPos
+	if !gameMap.IsMarkedForChopping(targetPos) || !gameMap.CanChop(targetPos) {
+		char.Intent = nil
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+
+	// Working phase
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.ChopTreeTile(gameMap, targetPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyDigChannelIntent handles ActionDigChannel in simulation: walk beside the channel tile, then dig or clear it.
+func applyDigChannelIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	targetPos := *char.Intent.TargetBuildPos
+	if !system.IsChannelWork(gameMap, targetPos) {
+		char.Intent = nil
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+
+	// Working phase
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.DigChannelTile(gameMap, targetPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyCompostIntent handles ActionCompost in simulation: walk onto the tilled tile, then compost it.
+func applyCompostIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	targetPos := *char.Intent.TargetBuildPos
+	if !gameMap.CanCompost(targetPos) {
+		char.Intent = nil
+		return
+	}
+
+	// Walking phase: not yet on the tile
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+
+	// Working phase
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.CompostTile(gameMap, targetPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyBuildCampfireIntent handles ActionBuildCampfire in simulation: walk beside the fire, then build or feed it.
+func applyBuildCampfireIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.BuildCampfire(gameMap, *char.Intent.TargetBuildPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyCookIntent handles ActionCook in simulation: walk beside the lit campfire, then cook.
+func applyCookIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationMedium {
+		char.ActionProgress = 0
+		system.CookItem(gameMap, *char.Intent.TargetBuildPos, char, actionLog)
+		char.Intent = nil
+	}
+}
+
+// applyExtinguishIntent handles ActionExtinguish in simulation: procure a vessel, fill it at water,
+// then walk beside the fire and douse it (mirrors ui applyExtinguish).
+func applyExtinguishIntent(char *entity.Character, gameMap *game.Map, delta float64, actionLog *system.ActionLog) {
+	vessel := char.Intent.TargetItem
+	if char.Intent.TargetBuildPos == nil || !gameMap.IsBurning(*char.Intent.TargetBuildPos) {
+		char.Intent = nil // Fire is out — re-evaluate
+		return
+	}
+	firePos := *char.Intent.TargetBuildPos
+
+	// Phase 1: vessel procurement (if vessel is on the ground)
+	if vessel != nil && gameMap.HasItemOnMap(vessel) {
+		switch system.RunVesselProcurement(char, vessel, gameMap, actionLog, gameMap.Varieties(), delta) {
+		case system.ProcureApproaching:
+			stepCharacter(char, gameMap, delta)
+		case system.ProcureReady:
+			char.Intent = nil
+		}
+		return
+	}
+
+	// Phase 2: fill vessel at water source (vessel in inventory, empty)
+	if vessel != nil && vessel.Container != nil && len(vessel.Container.Contents) == 0 {
+		switch system.RunWaterFill(char, vessel, entity.ActionExtinguish, gameMap, actionLog, gameMap.Varieties(), delta) {
+		case system.FillApproaching:
+			stepCharacter(char, gameMap, delta)
+		case system.FillReady:
+			char.Intent = nil
+		}
+		return
+	}
+
+	// Phase 3: walk beside the fire and douse it
+	if char.Pos() != char.Intent.Dest {
+		stepCharacter(char, gameMap, delta)
+		return
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationShort {
+		char.ActionProgress = 0
+		system.ExtinguishFire(gameMap, firePos, char, vessel, actionLog)
+		char.Intent = nil
+	}
+}
+
 func sign(x int) int {
 	if x > 0 {
 		return 1
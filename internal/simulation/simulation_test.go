@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
 	"petri/internal/system"
+	"petri/internal/types"
 )
 
 // Standard delta for simulation ticks
@@ -368,3 +371,35 @@ func TestRegression_CooldownExpiresAndDrainResumes(t *testing.T) {
 			energyAfterCooldown, char.Energy)
 	}
 }
+
+func TestSimulation_CharacterPutsOutFire(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	gameMap.SetVarieties(game.GenerateVarieties())
+	gameMap.SetWeather(game.WeatherClear, game.WeatherClear, 1e9) // No rain to douse the fire
+	world := &TestWorld{
+		GameMap:            gameMap,
+		ActionLog:          system.NewActionLog(50),
+		GroundSpawnTimers:  system.GroundSpawnTimers{Stick: 1e9, Nut: 1e9, Shell: 1e9, Stone: 1e9},
+		CreatureSpawnTimer: 1e9,
+		Pipeline:           system.DefaultPipeline(),
+	}
+	gameMap.AddWater(types.Position{X: 4, Y: 10}, game.WaterPond)
+	char := entity.NewCharacter(1, 10, 10, "Len", "berry", types.ColorRed)
+	char.AddToInventory(entity.NewVessel(0, 0, "hollow gourd", "gourd"))
+	gameMap.AddCharacter(char)
+
+	// A lone grass tile burns long enough for the character to fetch water and douse it
+	firePos := types.Position{X: 16, Y: 10}
+	gameMap.AddItem(entity.NewGrass(firePos.X, firePos.Y))
+	gameMap.Ignite(firePos)
+	gameMap.SetBurning(firePos, 1e6)
+
+	for tick := 0; tick < 2000 && gameMap.IsBurning(firePos); tick++ {
+		RunTick(world, tickDelta)
+	}
+	if gameMap.IsBurning(firePos) {
+		t.Fatalf("Expected the fire to be put out; character at %v doing %q", char.Pos(), char.CurrentActivity)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+
+	"petri/internal/i18n"
 )
 
 // Event represents a single logged event
@@ -13,6 +15,8 @@ type Event struct {
 	CharName string
 	Type     string
 	Message  string
+	Key      string // Message catalog key ("" for unkeyed messages); identifies the event regardless of language
+	Seq      uint64 // Order the event was logged in this session (0 for events loaded from a save)
 }
 
 // ActionLog maintains a log of significant character events
@@ -21,6 +25,8 @@ type ActionLog struct {
 	logs        map[int][]Event
 	maxEvents   int
 	currentTime float64 // Current game time, updated each tick
+	seq         uint64  // Sequence number of the last logged event
+	tickSeq     uint64  // Value of seq when the current tick began
 }
 
 // NewActionLog creates a new action log
@@ -36,6 +42,7 @@ func (al *ActionLog) SetGameTime(gameTime float64) {
 	al.mu.Lock()
 	defer al.mu.Unlock()
 	al.currentTime = gameTime
+	al.tickSeq = al.seq
 }
 
 // GameTime returns the current game time
@@ -47,17 +54,18 @@ func (al *ActionLog) GameTime() float64 {
 
 // Add records an event for a character using the current game time
 func (al *ActionLog) Add(charID int, charName, eventType, message string) {
+	al.add(Event{CharID: charID, CharName: charName, Type: eventType, Message: message})
+}
+
+// add stamps an event with the current game time and appends it, trimming the oldest past the limit
+func (al *ActionLog) add(event Event) {
 	al.mu.Lock()
 	defer al.mu.Unlock()
 
-	event := Event{
-		GameTime: al.currentTime,
-		CharID:   charID,
-		CharName: charName,
-		Type:     eventType,
-		Message:  message,
-	}
-
+	event.GameTime = al.currentTime
+	al.seq++
+	event.Seq = al.seq
+	charID := event.CharID
 	al.logs[charID] = append(al.logs[charID], event)
 
 	// Trim if over limit
@@ -66,6 +74,12 @@ func (al *ActionLog) Add(charID int, charName, eventType, message string) {
 	}
 }
 
+// AddMessage records a catalog message for a character, rendered in the active language.
+// The key is kept on the event so metrics and log styling don't depend on the language.
+func (al *ActionLog) AddMessage(charID int, charName, eventType, key string, args ...any) {
+	al.add(Event{CharID: charID, CharName: charName, Type: eventType, Message: i18n.T(key, args...), Key: key})
+}
+
 // Events returns events for a character
 func (al *ActionLog) Events(charID int, limit int) []Event {
 	al.mu.RLock()
@@ -116,6 +130,34 @@ func (al *ActionLog) AllEvents(limit int) []Event {
 	return all
 }
 
+// EventsAfter returns all events logged after sequence number seq, in the order they were logged.
+// Unlike game time, sequence numbers also advance for events logged while paused.
+func (al *ActionLog) EventsAfter(seq uint64) []Event {
+	al.mu.RLock()
+	defer al.mu.RUnlock()
+
+	var result []Event
+	for _, events := range al.logs {
+		for _, e := range events {
+			if e.Seq > seq {
+				result = append(result, e)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Seq < result[j].Seq
+	})
+	return result
+}
+
+// TickStartSeq returns the sequence number of the last event logged before the current tick began
+func (al *ActionLog) TickStartSeq() uint64 {
+	al.mu.RLock()
+	defer al.mu.RUnlock()
+	return al.tickSeq
+}
+
 // AllEventCount returns total number of events across all characters
 func (al *ActionLog) AllEventCount() int {
 	al.mu.RLock()
@@ -168,6 +168,35 @@ func TestActionLog_AllEventsRespectsLimit(t *testing.T) {
 	}
 }
 
+func TestActionLog_EventsAfterReturnsLaterEventsInLoggedOrder(t *testing.T) {
+	t.Parallel()
+
+	log := NewActionLog(100)
+
+	log.SetGameTime(1.0)
+	log.Add(1, "Len", "test", "Event A")
+	log.SetGameTime(2.0)
+	if got := log.TickStartSeq(); got != 1 {
+		t.Errorf("TickStartSeq() should be 1 after one event, got %d", got)
+	}
+	log.Add(2, "Macca", "test", "Event B")
+	log.Add(1, "Len", "test", "Event C")
+	// Logged while paused: game time does not advance, but the sequence does
+	log.Add(2, "Macca", "test", "Event D")
+
+	events := log.EventsAfter(1)
+	if len(events) != 3 {
+		t.Fatalf("EventsAfter(1) should return 3 events, got %d", len(events))
+	}
+	if events[0].Message != "Event B" || events[1].Message != "Event C" || events[2].Message != "Event D" {
+		t.Errorf("Expected [Event B, Event C, Event D], got [%s, %s, %s]", events[0].Message, events[1].Message, events[2].Message)
+	}
+
+	if got := log.EventsAfter(events[2].Seq); len(got) != 0 {
+		t.Errorf("EventsAfter(last seq) should return no events, got %d", len(got))
+	}
+}
+
 func TestActionLog_AllEventCount(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,125 @@
+package system
+
+import (
+	"sort"
+
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// findDigChannelIntent creates an intent to work on the nearest irrigation channel tile: dig a marked
+// tile next to water, or clear the silt out of a channel that needs upkeep.
+// Flow: procure hoe → find nearest channel work with a free tile beside it → walk there → dig.
+// Returns nil when there is no channel work (order complete) or when no hoe exists (triggers abandonment).
+func findDigChannelIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	if intent := EnsureHasItem(char, "hoe", items, gameMap, log); intent != nil {
+		return intent
+	}
+	if char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "hoe" }) == nil {
+		return nil // No hoe available — triggers abandonment
+	}
+
+	var candidates []types.Position
+	for _, mpos := range gameMap.MarkedForDiggingPositions() {
+		if gameMap.CanDigChannel(mpos) {
+			candidates = append(candidates, mpos)
+		}
+	}
+	for _, cpos := range gameMap.ChannelPositions() {
+		if gameMap.ChannelNeedsUpkeep(cpos) {
+			candidates = append(candidates, cpos)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return pos.DistanceTo(candidates[i]) < pos.DistanceTo(candidates[j])
+	})
+
+	for _, candidate := range candidates {
+		standPos := pos
+		if !pos.IsCardinallyAdjacentTo(candidate) || gameMap.IsMarkedForDigging(pos) {
+			adjPos := findChannelStandingTile(candidate, gameMap)
+			if adjPos == nil {
+				continue
+			}
+			standPos = *adjPos
+		}
+		targetPos := candidate
+		nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, standPos.X, standPos.Y, gameMap, char.UsingBFS)
+		if usedBFS {
+			char.UsingBFS = true
+		}
+		newActivity := i18n.T("doing.digging_channel")
+		if pos != standPos {
+			newActivity = i18n.T("doing.moving_to_dig_channel")
+		}
+		if char.CurrentActivity != newActivity {
+			char.CurrentActivity = newActivity
+		}
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           standPos,
+			Action:         entity.ActionDigChannel,
+			TargetBuildPos: &targetPos,
+		}
+	}
+	return nil
+}
+
+// findChannelStandingTile returns a free tile cardinally beside a channel tile to work from,
+// preferring one that isn't itself marked for digging so the digger doesn't stand in the way
+// of the next stretch of channel
+func findChannelStandingTile(target types.Position, gameMap *game.Map) *types.Position {
+	var fallback *types.Position
+	for _, dir := range [4][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+		adj := types.Position{X: target.X + dir[0], Y: target.Y + dir[1]}
+		if gameMap.IsBlocked(adj) || gameMap.CharacterAt(adj) != nil {
+			continue
+		}
+		if !gameMap.IsMarkedForDigging(adj) {
+			return &adj
+		}
+		if fallback == nil {
+			fallback = &adj
+		}
+	}
+	return fallback
+}
+
+// HasChannelWork returns true if any marked tile can be dug (or only waits for someone standing
+// on it to move), or any channel needs clearing
+func HasChannelWork(gameMap *game.Map) bool {
+	for _, pos := range gameMap.MarkedForDiggingPositions() {
+		if gameMap.CanDigChannel(pos) || (gameMap.CharacterAt(pos) != nil && gameMap.TouchesWater(pos)) {
+			return true
+		}
+	}
+	for _, pos := range gameMap.ChannelPositions() {
+		if gameMap.ChannelNeedsUpkeep(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// DigChannelTile completes work on one channel tile: a channel that needs upkeep is cleared of
+// silt, and a marked tile is dug out and fills with water. Returns false if there was nothing to do.
+func DigChannelTile(gameMap *game.Map, pos types.Position, char *entity.Character, log *ActionLog) bool {
+	key := "log.dug_channel"
+	if gameMap.ChannelNeedsUpkeep(pos) {
+		gameMap.ClearChannel(pos)
+		key = "log.cleared_channel"
+	} else if !gameMap.DigChannel(pos) {
+		return false
+	}
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", key)
+	}
+	return true
+}
+
+// IsChannelWork returns true if pos still has channel work: a diggable mark or a channel needing upkeep
+func IsChannelWork(gameMap *game.Map, pos types.Position) bool {
+	return gameMap.CanDigChannel(pos) || gameMap.ChannelNeedsUpkeep(pos)
+}
@@ -0,0 +1,97 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestFindDigChannelIntent_DigsBesideWaterFromUnmarkedTile(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	gameMap.AddWater(types.Position{X: 5, Y: 5}, game.WaterPond)
+	for x := 6; x <= 9; x++ {
+		gameMap.MarkForDigging(types.Position{X: x, Y: 5})
+	}
+	char := entity.NewCharacter(1, 7, 10, "Test", "berry", types.ColorRed)
+	char.AddToInventory(entity.NewHoe(0, 0, types.ColorSilver))
+	gameMap.AddCharacter(char)
+
+	order := entity.NewOrder(1, "digChannel", "")
+	intent := findDigChannelIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil || intent.Action != entity.ActionDigChannel {
+		t.Fatalf("Expected a dig channel intent, got %+v", intent)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != (types.Position{X: 6, Y: 5}) {
+		t.Fatalf("Expected to dig the mark beside the pond first, got %v", intent.TargetBuildPos)
+	}
+	if gameMap.IsMarkedForDigging(intent.Dest) || gameMap.IsWater(intent.Dest) {
+		t.Errorf("Expected to stand off the channel's course, got %v", intent.Dest)
+	}
+}
+
+func TestDigChannelTile_DigsThenClears(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	gameMap.AddWater(types.Position{X: 5, Y: 5}, game.WaterPond)
+	pos := types.Position{X: 6, Y: 5}
+	gameMap.MarkForDigging(pos)
+	char := entity.NewCharacter(1, 6, 6, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	log := NewActionLog(10)
+
+	if !DigChannelTile(gameMap, pos, char, log) || gameMap.WaterAt(pos) != game.WaterChannel {
+		t.Fatal("Expected the marked tile to be dug into a channel")
+	}
+	if DigChannelTile(gameMap, pos, char, log) {
+		t.Error("Expected nothing to do on a fresh channel")
+	}
+
+	gameMap.SetChannelSilt(pos, 1)
+	if !DigChannelTile(gameMap, pos, char, log) {
+		t.Fatal("Expected the silting channel to be cleared")
+	}
+	if silt, _ := gameMap.ChannelSilt(pos); silt != config.ChannelSiltTime {
+		t.Errorf("Expected the channel cleared to %.0fs, got %.1f", config.ChannelSiltTime, silt)
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 2 || events[0].Key != "log.dug_channel" || events[1].Key != "log.cleared_channel" {
+		t.Errorf("Expected dug then cleared log entries, got %+v", events)
+	}
+}
+
+func TestDigChannelOrder_CompleteAndFeasibleFollowWork(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 2, "Test", "berry", types.ColorRed)
+	char.KnownActivities = []string{"digChannel"}
+	gameMap.AddCharacter(char)
+	gameMap.AddWater(types.Position{X: 5, Y: 5}, game.WaterPond)
+	pos := types.Position{X: 6, Y: 5}
+	gameMap.MarkForDigging(pos)
+	order := entity.NewOrder(1, "digChannel", "")
+
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); feasible {
+		t.Error("Dig channel order should be infeasible without a hoe")
+	}
+
+	gameMap.AddItem(entity.NewHoe(3, 3, types.ColorSilver))
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); !feasible {
+		t.Error("Dig channel order should be feasible with a hoe and a mark beside water")
+	}
+	if isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Dig channel order should not be complete while a mark can be dug")
+	}
+
+	gameMap.DigChannel(pos)
+	if !isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Dig channel order should be complete once the channel is dug")
+	}
+}
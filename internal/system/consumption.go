@@ -1,23 +1,32 @@
 package system
 
 import (
-	"fmt"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 )
 
+// itemSatiation returns how much hunger eating an item relieves: its meal size tier,
+// scaled up by CookedSatiationMultiplier for cooked food
+func itemSatiation(item *entity.Item) float64 {
+	satiation := config.GetMealSize(item.ItemType).Satiation
+	if item.IsCooked() {
+		satiation *= config.CookedSatiationMultiplier
+	}
+	return satiation
+}
+
 // Consume handles a character eating an item
 func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *ActionLog) {
 	itemName := item.Description()
 	oldHunger := char.Hunger
 
 	// Update activity
-	char.CurrentActivity = "Consuming " + itemName
+	char.CurrentActivity = i18n.T("doing.consuming", itemName)
 
 	// Reduce hunger (per-item satiation tier)
-	char.Hunger -= config.GetMealSize(item.ItemType).Satiation
+	char.Hunger -= itemSatiation(item)
 	if char.Hunger < 0 {
 		char.Hunger = 0
 	}
@@ -32,7 +41,7 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 		}
 		// Log mood tier transition from boost
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
@@ -50,23 +59,21 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 		}
 		// Log mood change from preference
 		if log != nil {
-			moodChange := "Improved Mood"
+			moodKey := "log.mood.eat_improved"
 			if netPref < 0 {
-				moodChange = "Worsened Mood"
+				moodKey = "log.mood.eat_worsened"
 			}
-			log.Add(char.ID, char.Name, "mood",
-				fmt.Sprintf("Eating %s %s (mood %d→%d)", itemName, moodChange, int(oldMood), int(char.Mood)))
+			log.AddMessage(char.ID, char.Name, "mood", moodKey, itemName, int(oldMood), int(char.Mood))
 		}
 		// Log mood tier transition from preference
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
 	// Log consumption
 	if log != nil {
-		log.Add(char.ID, char.Name, "consumption",
-			fmt.Sprintf("Consumed %s (hunger %d→%d)", itemName, int(oldHunger), int(char.Hunger)))
+		log.AddMessage(char.ID, char.Name, "consumption", "log.eat.consumed", itemName, int(oldHunger), int(char.Hunger))
 	}
 
 	// Apply poison effect
@@ -75,8 +82,7 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 		char.PoisonTimer = config.PoisonDuration
 
 		if log != nil {
-			log.Add(char.ID, char.Name, "poison",
-				fmt.Sprintf("Became poisoned! (duration: %ds)", int(config.PoisonDuration)))
+			log.AddMessage(char.ID, char.Name, "poison", "log.poison.became", int(config.PoisonDuration))
 		}
 
 		// Learn that this item type is poisonous (includes dislike formation)
@@ -97,8 +103,7 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 		if char.Health > oldHealth {
 			// Log health change (debug-only, filtered in view layer)
 			if log != nil {
-				log.Add(char.ID, char.Name, "health",
-					fmt.Sprintf("Eating %s impacted health (%d→%d)", itemName, int(oldHealth), int(char.Health)))
+				log.AddMessage(char.ID, char.Name, "health", "log.health.impacted", itemName, int(oldHealth), int(char.Health))
 			}
 
 			// Learn that this item type is healing (only if we experienced healing)
@@ -116,7 +121,7 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 
 		// Log health tier transition
 		if log != nil && char.HealthTier() != prevTier {
-			log.Add(char.ID, char.Name, "health", fmt.Sprintf("%s", char.HealthLevel()))
+			log.AddMessage(char.ID, char.Name, "health", "log.health."+entity.TierID(char.HealthTier()))
 		}
 	}
 
@@ -126,7 +131,8 @@ func Consume(char *entity.Character, item *entity.Item, gameMap *game.Map, log *
 	// Try to discover know-how from eating
 	TryDiscoverKnowHow(char, entity.ActionConsume, item, log, GetDiscoveryChance(char))
 
-	// Remove item from map
+	// Remove item from map (eating a plant where it grows harvests it)
+	wearSoil(item, gameMap)
 	gameMap.RemoveItem(item)
 
 	// Gourd consumption creates a seed at character's position
@@ -144,10 +150,10 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 	oldHunger := char.Hunger
 
 	// Update activity
-	char.CurrentActivity = "Consuming " + itemName
+	char.CurrentActivity = i18n.T("doing.consuming", itemName)
 
 	// Reduce hunger (per-item satiation tier)
-	char.Hunger -= config.GetMealSize(item.ItemType).Satiation
+	char.Hunger -= itemSatiation(item)
 	if char.Hunger < 0 {
 		char.Hunger = 0
 	}
@@ -162,7 +168,7 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 		}
 		// Log mood tier transition from boost
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
@@ -180,23 +186,21 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 		}
 		// Log mood change from preference
 		if log != nil {
-			moodChange := "Improved Mood"
+			moodKey := "log.mood.eat_improved"
 			if netPref < 0 {
-				moodChange = "Worsened Mood"
+				moodKey = "log.mood.eat_worsened"
 			}
-			log.Add(char.ID, char.Name, "mood",
-				fmt.Sprintf("Eating %s %s (mood %d→%d)", itemName, moodChange, int(oldMood), int(char.Mood)))
+			log.AddMessage(char.ID, char.Name, "mood", moodKey, itemName, int(oldMood), int(char.Mood))
 		}
 		// Log mood tier transition from preference
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
 	// Log consumption
 	if log != nil {
-		log.Add(char.ID, char.Name, "consumption",
-			fmt.Sprintf("Ate carried %s (hunger %d→%d)", itemName, int(oldHunger), int(char.Hunger)))
+		log.AddMessage(char.ID, char.Name, "consumption", "log.eat.carried", itemName, int(oldHunger), int(char.Hunger))
 	}
 
 	// Apply poison effect
@@ -205,8 +209,7 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 		char.PoisonTimer = config.PoisonDuration
 
 		if log != nil {
-			log.Add(char.ID, char.Name, "poison",
-				fmt.Sprintf("Became poisoned! (duration: %ds)", int(config.PoisonDuration)))
+			log.AddMessage(char.ID, char.Name, "poison", "log.poison.became", int(config.PoisonDuration))
 		}
 
 		// Learn that this item type is poisonous (includes dislike formation)
@@ -227,8 +230,7 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 		if char.Health > oldHealth {
 			// Log health change (debug-only, filtered in view layer)
 			if log != nil {
-				log.Add(char.ID, char.Name, "health",
-					fmt.Sprintf("Eating %s impacted health (%d→%d)", itemName, int(oldHealth), int(char.Health)))
+				log.AddMessage(char.ID, char.Name, "health", "log.health.impacted", itemName, int(oldHealth), int(char.Health))
 			}
 
 			// Learn that this item type is healing (only if we experienced healing)
@@ -246,7 +248,7 @@ func ConsumeFromInventory(char *entity.Character, item *entity.Item, gameMap *ga
 
 		// Log health tier transition
 		if log != nil && char.HealthTier() != prevTier {
-			log.Add(char.ID, char.Name, "health", fmt.Sprintf("%s", char.HealthLevel()))
+			log.AddMessage(char.ID, char.Name, "health", "log.health."+entity.TierID(char.HealthTier()))
 		}
 	}
 
@@ -272,7 +274,7 @@ func Drink(char *entity.Character, log *ActionLog) {
 	oldThirst := char.Thirst
 
 	// Update activity
-	char.CurrentActivity = "Drinking"
+	char.CurrentActivity = i18n.T("doing.drinking")
 
 	// Reduce thirst
 	char.Thirst -= config.DrinkThirstReduction
@@ -290,14 +292,13 @@ func Drink(char *entity.Character, log *ActionLog) {
 		}
 		// Log mood tier transition from boost
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
 	// Log drinking
 	if log != nil {
-		log.Add(char.ID, char.Name, "thirst",
-			fmt.Sprintf("Drank water (thirst %d→%d)", int(oldThirst), int(char.Thirst)))
+		log.AddMessage(char.ID, char.Name, "thirst", "log.drink.water", int(oldThirst), int(char.Thirst))
 	}
 }
 
@@ -306,22 +307,18 @@ func StartSleep(char *entity.Character, atBed bool, log *ActionLog) {
 	char.IsSleeping = true
 	char.AtBed = atBed
 
-	location := "on ground"
+	char.CurrentActivity = i18n.T("doing.sleeping_on_ground")
 	if atBed {
-		location = "in leaf pile"
+		char.CurrentActivity = i18n.T("doing.sleeping_in_leaf_pile")
 	}
-	char.CurrentActivity = "Sleeping (" + location + ")"
 
 	if log != nil {
 		if atBed {
-			log.Add(char.ID, char.Name, "sleep",
-				fmt.Sprintf("Fell asleep in leaf pile (energy: %d)", int(char.Energy)))
+			log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.leaf_pile", int(char.Energy))
 		} else if char.Energy <= 0 {
-			log.Add(char.ID, char.Name, "sleep",
-				fmt.Sprintf("Collapsed from exhaustion (energy: %d)", int(char.Energy)))
+			log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.collapsed", int(char.Energy))
 		} else {
-			log.Add(char.ID, char.Name, "sleep",
-				fmt.Sprintf("Fell asleep on ground (energy: %d)", int(char.Energy)))
+			log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.ground", int(char.Energy))
 		}
 	}
 }
@@ -341,7 +338,7 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 	oldHunger := char.Hunger
 
 	// Update activity
-	char.CurrentActivity = "Consuming " + varietyName + " from vessel"
+	char.CurrentActivity = i18n.T("doing.consuming_from_vessel", varietyName)
 
 	// Reduce hunger (per-item satiation tier, uses variety's ItemType)
 	char.Hunger -= config.GetMealSize(variety.ItemType).Satiation
@@ -358,7 +355,7 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 			char.Mood = 100
 		}
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
@@ -375,23 +372,21 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 			char.Mood = 0
 		}
 		if log != nil {
-			moodChange := "Improved Mood"
+			moodKey := "log.mood.eat_improved"
 			if netPref < 0 {
-				moodChange = "Worsened Mood"
+				moodKey = "log.mood.eat_worsened"
 			}
-			log.Add(char.ID, char.Name, "mood",
-				fmt.Sprintf("Eating %s %s (mood %d→%d)", varietyName, moodChange, int(oldMood), int(char.Mood)))
+			log.AddMessage(char.ID, char.Name, "mood", moodKey, varietyName, int(oldMood), int(char.Mood))
 		}
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
 	// Log consumption
 	if log != nil {
-		log.Add(char.ID, char.Name, "consumption",
-			fmt.Sprintf("Ate %s from vessel (hunger %d→%d, %d remaining)",
-				varietyName, int(oldHunger), int(char.Hunger), stack.Count-1))
+		log.AddMessage(char.ID, char.Name, "consumption", "log.eat.vessel",
+			varietyName, int(oldHunger), int(char.Hunger), stack.Count-1)
 	}
 
 	// Apply poison effect
@@ -400,8 +395,7 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 		char.PoisonTimer = config.PoisonDuration
 
 		if log != nil {
-			log.Add(char.ID, char.Name, "poison",
-				fmt.Sprintf("Became poisoned! (duration: %ds)", int(config.PoisonDuration)))
+			log.AddMessage(char.ID, char.Name, "poison", "log.poison.became", int(config.PoisonDuration))
 		}
 
 		// Learn poison knowledge
@@ -420,8 +414,7 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 
 		if char.Health > oldHealth {
 			if log != nil {
-				log.Add(char.ID, char.Name, "health",
-					fmt.Sprintf("Eating %s impacted health (%d→%d)", varietyName, int(oldHealth), int(char.Health)))
+				log.AddMessage(char.ID, char.Name, "health", "log.health.impacted", varietyName, int(oldHealth), int(char.Health))
 			}
 
 			// Learn healing knowledge
@@ -438,7 +431,7 @@ func ConsumeFromVessel(char *entity.Character, vessel *entity.Item, gameMap *gam
 		}
 
 		if log != nil && char.HealthTier() != prevTier {
-			log.Add(char.ID, char.Name, "health", fmt.Sprintf("%s", char.HealthLevel()))
+			log.AddMessage(char.ID, char.Name, "health", "log.health."+entity.TierID(char.HealthTier()))
 		}
 	}
 
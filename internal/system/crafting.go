@@ -20,8 +20,26 @@ func CreateHoe(shell *entity.Item, recipe *entity.Recipe) *entity.Item {
 	return entity.NewHoe(shell.X, shell.Y, shell.Color)
 }
 
+// CreateChisel creates a chisel item from a shell.
+// The chisel inherits the shell's color (e.g., "lavender shell chisel").
+func CreateChisel(shell *entity.Item, recipe *entity.Recipe) *entity.Item {
+	return entity.NewChisel(shell.X, shell.Y, shell.Color)
+}
+
 // CreateBrick creates a brick item from a consumed clay input.
 // Bricks are uniform — position is taken from the clay item.
 func CreateBrick(clay *entity.Item, recipe *entity.Recipe) *entity.Item {
 	return entity.NewBrick(clay.X, clay.Y)
 }
+
+// CreateFlake creates a stone flake from a knapped stone.
+// Flakes are uniform — position is taken from the stone item.
+func CreateFlake(stone *entity.Item, recipe *entity.Recipe) *entity.Item {
+	return entity.NewFlake(stone.X, stone.Y)
+}
+
+// CreateAxe creates a stone axe from a stick and stone.
+// Axes are uniform — position is taken from the stone item.
+func CreateAxe(stone *entity.Item, recipe *entity.Recipe) *entity.Item {
+	return entity.NewAxe(stone.X, stone.Y)
+}
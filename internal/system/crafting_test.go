@@ -155,3 +155,35 @@ func TestCreateBrick_Material(t *testing.T) {
 		t.Errorf("Expected Material 'clay', got %q", brick.Material)
 	}
 }
+
+func TestCreateFlake_Properties(t *testing.T) {
+	t.Parallel()
+
+	flake := CreateFlake(entity.NewStone(3, 4), entity.RecipeRegistry["stone-flake"])
+
+	if flake.ItemType != "flake" || flake.Kind != "stone flake" {
+		t.Errorf("Expected a stone flake, got %q/%q", flake.ItemType, flake.Kind)
+	}
+	if flake.Material != "stone" {
+		t.Errorf("Expected Material 'stone', got %q", flake.Material)
+	}
+	if flake.X != 3 || flake.Y != 4 {
+		t.Errorf("Expected position from the stone, got (%d, %d)", flake.X, flake.Y)
+	}
+}
+
+func TestCreateAxe_Properties(t *testing.T) {
+	t.Parallel()
+
+	axe := CreateAxe(entity.NewStone(0, 0), entity.RecipeRegistry["stone-axe"])
+
+	if axe.ItemType != "axe" || axe.Kind != "stone axe" {
+		t.Errorf("Expected a stone axe, got %q/%q", axe.ItemType, axe.Kind)
+	}
+	if axe.Material != "stone" {
+		t.Errorf("Expected Material 'stone', got %q", axe.Material)
+	}
+	if axe.Edible != nil {
+		t.Error("Expected an axe not to be edible")
+	}
+}
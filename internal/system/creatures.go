@@ -0,0 +1,326 @@
+package system
+
+import (
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/rng"
+	"petri/internal/types"
+)
+
+// creatureMovementThreshold matches the character threshold (50 speed * 0.15s delta)
+const creatureMovementThreshold = 7.5
+
+// creatureWanderChance is the chance an idle creature hops when it has enough speed to move
+const creatureWanderChance = 0.5
+
+// UpdateCreatureSurvival updates hunger, thirst, energy, and the visit timer for a creature.
+// Creatures don't take damage; one that runs out of food or water, or whose visit is over, heads for the map edge.
+func UpdateCreatureSurvival(c *entity.Creature, delta float64) {
+	c.Hunger += config.HungerIncreaseRate * delta
+	if c.Hunger > 100 {
+		c.Hunger = 100
+	}
+	c.Thirst += config.ThirstIncreaseRate * delta
+	if c.Thirst > 100 {
+		c.Thirst = 100
+	}
+
+	if c.IsSleeping {
+		c.Energy += config.GroundEnergyRestoreRate * delta
+		if c.Energy > 100 {
+			c.Energy = 100
+		}
+		// Same wake rules as a character sleeping on the ground
+		if c.Energy >= 75 || c.HungerTier() >= entity.TierModerate || c.ThirstTier() >= entity.TierModerate {
+			c.IsSleeping = false
+			c.CurrentActivity = i18n.T("doing.waking_up")
+		}
+	} else {
+		c.Energy -= config.EnergyDecreaseRate * delta
+		if c.Energy < 0 {
+			c.Energy = 0
+		}
+	}
+
+	c.StayTimer -= delta
+	if c.StayTimer <= 0 || c.Hunger >= 100 || c.Thirst >= 100 {
+		c.StayTimer = 0
+		c.Leaving = true
+	}
+}
+
+// UpdateCreatureSpawning decrements the creature spawn timer and brings a rabbit in
+// from the map edge when it fires, unless the map already holds CreatureMaxCount creatures.
+func UpdateCreatureSpawning(gameMap *game.Map, delta float64, timer *float64) {
+	*timer -= delta
+	if *timer > 0 {
+		return
+	}
+	*timer = RandomCreatureSpawnInterval()
+	if len(gameMap.Creatures()) >= int(config.CreatureMaxCount) {
+		return
+	}
+	spawnCreature(gameMap, "rabbit")
+}
+
+// RandomCreatureSpawnInterval returns a randomized interval between creature arrivals.
+// Uses CreatureSpawnInterval ± LifecycleIntervalVariance (same pattern as ground spawning).
+func RandomCreatureSpawnInterval() float64 {
+	base := config.CreatureSpawnInterval
+	variance := base * config.LifecycleIntervalVariance
+	return base + (rng.Float64()*2-1)*variance
+}
+
+// spawnCreature places a creature of the given kind on a random open edge tile.
+// Arrivals are already somewhat hungry and thirsty, which is what brings them in.
+// Tries up to 10 times to find a valid spot; gives up silently if the edge is blocked.
+func spawnCreature(gameMap *game.Map, kind string) *entity.Creature {
+	const maxAttempts = 10
+	for i := 0; i < maxAttempts; i++ {
+		pos := randomEdgePosition(gameMap)
+		if gameMap.IsBlocked(pos) || gameMap.CreatureAt(pos) != nil {
+			continue
+		}
+		c := entity.NewCreature(pos.X, pos.Y, kind)
+		c.Hunger = 40 + rng.Float64()*30
+		c.Thirst = 20 + rng.Float64()*30
+		variance := config.CreatureStayDuration * config.LifecycleIntervalVariance
+		c.StayTimer = config.CreatureStayDuration + (rng.Float64()*2-1)*variance
+		gameMap.AddCreature(c)
+		return c
+	}
+	return nil
+}
+
+// randomEdgePosition returns a random tile on the map border
+func randomEdgePosition(gameMap *game.Map) types.Position {
+	switch rng.Intn(4) {
+	case 0:
+		return types.Position{X: rng.Intn(gameMap.Width), Y: 0}
+	case 1:
+		return types.Position{X: rng.Intn(gameMap.Width), Y: gameMap.Height - 1}
+	case 2:
+		return types.Position{X: 0, Y: rng.Intn(gameMap.Height)}
+	default:
+		return types.Position{X: gameMap.Width - 1, Y: rng.Intn(gameMap.Height)}
+	}
+}
+
+// isEdge returns true if the position is on the map border
+func isEdge(pos types.Position, gameMap *game.Map) bool {
+	return pos.X == 0 || pos.Y == 0 || pos.X == gameMap.Width-1 || pos.Y == gameMap.Height-1
+}
+
+// nearestEdge returns the closest border tile, straight out from the position along one axis
+func nearestEdge(pos types.Position, gameMap *game.Map) types.Position {
+	best := types.Position{X: 0, Y: pos.Y}
+	bestDist := pos.X
+	candidates := []struct {
+		pos  types.Position
+		dist int
+	}{
+		{types.Position{X: gameMap.Width - 1, Y: pos.Y}, gameMap.Width - 1 - pos.X},
+		{types.Position{X: pos.X, Y: 0}, pos.Y},
+		{types.Position{X: pos.X, Y: gameMap.Height - 1}, gameMap.Height - 1 - pos.Y},
+	}
+	for _, cand := range candidates {
+		if cand.dist < bestDist {
+			best, bestDist = cand.pos, cand.dist
+		}
+	}
+	return best
+}
+
+// UpdateCreature runs one tick of a creature's intent loop: sleep when exhausted,
+// drink or eat when needs are pressing, otherwise wander. Leaving creatures walk
+// to the nearest edge and are removed from the map once they reach it.
+func UpdateCreature(c *entity.Creature, gameMap *game.Map, delta float64) {
+	if c.IsSleeping {
+		return
+	}
+
+	if c.Leaving {
+		if isEdge(c.Pos(), gameMap) {
+			gameMap.RemoveCreature(c)
+			return
+		}
+		c.CurrentActivity = i18n.T("doing.leaving")
+		creatureStepToward(c, gameMap, nearestEdge(c.Pos(), gameMap), delta)
+		return
+	}
+
+	if c.EnergyTier() >= entity.TierSevere {
+		c.IsSleeping = true
+		c.ActionProgress = 0
+		c.CurrentActivity = i18n.T("doing.sleeping_on_ground")
+		return
+	}
+
+	thirsty := c.ThirstTier() >= entity.TierMild
+	hungry := c.HungerTier() >= entity.TierMild
+	if thirsty && c.Thirst >= c.Hunger {
+		if creatureDrink(c, gameMap, delta) || (hungry && creatureEat(c, gameMap, delta)) {
+			return
+		}
+	} else if hungry {
+		if creatureEat(c, gameMap, delta) || (thirsty && creatureDrink(c, gameMap, delta)) {
+			return
+		}
+	}
+
+	c.ActionProgress = 0
+	c.CurrentActivity = i18n.T("doing.wandering")
+	creatureWander(c, gameMap, delta)
+}
+
+// creatureDrink walks to the nearest water and drinks from a cardinally adjacent tile.
+// Returns false if no water is reachable.
+func creatureDrink(c *entity.Creature, gameMap *game.Map, delta float64) bool {
+	pos := c.Pos()
+	waterPos, ok := gameMap.FindNearestWater(pos)
+	if !ok {
+		return false
+	}
+
+	if pos.IsCardinallyAdjacentTo(waterPos) {
+		c.CurrentActivity = i18n.T("doing.drinking")
+		c.ActionProgress += delta
+		if c.ActionProgress >= config.ActionDurationShort {
+			c.ActionProgress = 0
+			c.Thirst -= config.DrinkThirstReduction
+			if c.Thirst < 0 {
+				c.Thirst = 0
+			}
+		}
+		return true
+	}
+
+	dest, ok := drinkSpot(gameMap, waterPos, pos)
+	if !ok {
+		return false
+	}
+	c.CurrentActivity = i18n.T("doing.moving_to_water")
+	c.ActionProgress = 0
+	creatureStepToward(c, gameMap, dest, delta)
+	return true
+}
+
+// drinkSpot returns the open tile cardinally adjacent to the water tile that is closest to from
+func drinkSpot(gameMap *game.Map, waterPos, from types.Position) (types.Position, bool) {
+	var best types.Position
+	bestDist := -1
+	for _, dir := range [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+		adj := types.Position{X: waterPos.X + dir[0], Y: waterPos.Y + dir[1]}
+		if !gameMap.IsValid(adj) || gameMap.IsBlocked(adj) {
+			continue
+		}
+		if d := from.DistanceTo(adj); bestDist < 0 || d < bestDist {
+			best, bestDist = adj, d
+		}
+	}
+	return best, bestDist >= 0
+}
+
+// creatureEat walks to the best food plant and eats it where it grows.
+// Returns false if there is nothing the creature eats on the map.
+func creatureEat(c *entity.Creature, gameMap *game.Map, delta float64) bool {
+	target := FindCreatureFood(c, gameMap)
+	if target == nil {
+		return false
+	}
+
+	pos := c.Pos()
+	if pos == target.Pos() {
+		c.CurrentActivity = i18n.T("doing.eating", target.Description())
+		c.ActionProgress += delta
+		if c.ActionProgress >= config.ActionDurationShort {
+			c.ActionProgress = 0
+			gameMap.RemoveItem(target)
+			c.Hunger -= config.CreatureMealSatiation
+			if c.Hunger < 0 {
+				c.Hunger = 0
+			}
+		}
+		return true
+	}
+
+	c.CurrentActivity = i18n.T("doing.moving_to", target.Description())
+	c.ActionProgress = 0
+	creatureStepToward(c, gameMap, target.Pos(), delta)
+	return true
+}
+
+// FindCreatureFood returns the growing plant the creature would eat next, or nil.
+// Nearest wins; creatures that prefer tilled soil treat plants there as CreatureTilledBonus tiles closer.
+func FindCreatureFood(c *entity.Creature, gameMap *game.Map) *entity.Item {
+	pos := c.Pos()
+	prefersTilled := c.Def().PrefersTilled
+	var best *entity.Item
+	var bestScore float64
+	for _, item := range gameMap.Items() {
+		if item.Plant == nil || !(item.Plant.IsGrowing || item.Plant.IsSprout) || !c.Eats(item) {
+			continue
+		}
+		score := float64(pos.DistanceTo(item.Pos()))
+		if prefersTilled && gameMap.IsTilled(item.Pos()) {
+			score -= config.CreatureTilledBonus
+		}
+		if best == nil || score < bestScore {
+			best, bestScore = item, score
+		}
+	}
+	return best
+}
+
+// creatureStepToward moves the creature one step along a path to the target, gated by its speed
+func creatureStepToward(c *entity.Creature, gameMap *game.Map, target types.Position, delta float64) {
+	if !creatureReadyToMove(c, delta) {
+		return
+	}
+	pos := c.Pos()
+	nx, ny := NextStepBFS(pos.X, pos.Y, target.X, target.Y, gameMap)
+	next := types.Position{X: nx, Y: ny}
+	if creatureMove(c, gameMap, next) {
+		return
+	}
+	// No way around: gnaw at a construct standing in the way
+	if con := gameMap.ConstructAt(next); con != nil && !con.IsPassable() {
+		DamageConstruct(gameMap, con, config.CreatureGnawDamage)
+	}
+}
+
+// creatureWander occasionally hops to a random open neighboring tile
+func creatureWander(c *entity.Creature, gameMap *game.Map, delta float64) {
+	if !creatureReadyToMove(c, delta) || rng.Float64() >= creatureWanderChance {
+		return
+	}
+	pos := c.Pos()
+	dirs := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	dir := dirs[rng.Intn(len(dirs))]
+	creatureMove(c, gameMap, types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]})
+}
+
+// creatureReadyToMove accumulates speed and reports whether the creature may take a step this tick
+func creatureReadyToMove(c *entity.Creature, delta float64) bool {
+	c.SpeedAccumulator += float64(c.Def().Speed) * delta
+	if c.SpeedAccumulator < creatureMovementThreshold {
+		return false
+	}
+	c.SpeedAccumulator -= creatureMovementThreshold
+	return true
+}
+
+// creatureMove moves the creature and drains movement energy if the step succeeds.
+// Returns false if the step is blocked.
+func creatureMove(c *entity.Creature, gameMap *game.Map, to types.Position) bool {
+	if !gameMap.MoveCreature(c, to) {
+		return false
+	}
+	c.Energy -= config.EnergyMovementDrain
+	if c.Energy < 0 {
+		c.Energy = 0
+	}
+	return true
+}
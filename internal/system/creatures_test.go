@@ -0,0 +1,153 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestUpdateCreatureSurvival_DecaysNeeds(t *testing.T) {
+	t.Parallel()
+
+	c := entity.NewCreature(5, 5, "rabbit")
+	c.StayTimer = 100
+	UpdateCreatureSurvival(c, 10)
+
+	if c.Hunger != config.HungerIncreaseRate*10 {
+		t.Errorf("Hunger: got %.2f, want %.2f", c.Hunger, config.HungerIncreaseRate*10)
+	}
+	if c.Thirst != config.ThirstIncreaseRate*10 {
+		t.Errorf("Thirst: got %.2f, want %.2f", c.Thirst, config.ThirstIncreaseRate*10)
+	}
+	if c.Energy != 100-config.EnergyDecreaseRate*10 {
+		t.Errorf("Energy: got %.2f, want %.2f", c.Energy, 100-config.EnergyDecreaseRate*10)
+	}
+	if c.StayTimer != 90 || c.Leaving {
+		t.Errorf("Expected stay timer 90 and not leaving, got %.1f leaving=%v", c.StayTimer, c.Leaving)
+	}
+}
+
+func TestUpdateCreatureSurvival_WakesWhenRested(t *testing.T) {
+	t.Parallel()
+
+	c := entity.NewCreature(5, 5, "rabbit")
+	c.IsSleeping = true
+	c.Energy = 74.5
+	UpdateCreatureSurvival(c, 1)
+
+	if c.IsSleeping {
+		t.Errorf("Expected creature to wake at energy %.1f", c.Energy)
+	}
+}
+
+func TestUpdateCreatureSurvival_LeavesWhenStayEnds(t *testing.T) {
+	t.Parallel()
+
+	c := entity.NewCreature(5, 5, "rabbit")
+	c.StayTimer = 0.5
+	UpdateCreatureSurvival(c, 1)
+
+	if !c.Leaving {
+		t.Error("Expected creature to start leaving when its stay ends")
+	}
+}
+
+func TestUpdateCreatureSpawning_RespectsMaxCount(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	for i := 0; i < int(config.CreatureMaxCount); i++ {
+		gameMap.AddCreature(entity.NewCreature(i+5, 5, "rabbit"))
+	}
+
+	timer := 0.1
+	UpdateCreatureSpawning(gameMap, 1, &timer)
+
+	if len(gameMap.Creatures()) != int(config.CreatureMaxCount) {
+		t.Errorf("Expected no arrival at the creature cap, got %d creatures", len(gameMap.Creatures()))
+	}
+	if timer <= 0 {
+		t.Error("Expected timer to reset even when the cap blocks an arrival")
+	}
+}
+
+func TestFindCreatureFood_PrefersTilledWithinBonus(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(40, 40)
+	c := entity.NewCreature(10, 10, "rabbit")
+	gameMap.AddCreature(c)
+
+	wild := entity.NewBerry(12, 10, types.ColorRed, false, false)
+	gameMap.AddItem(wild)
+	gameMap.SetTilled(types.Position{X: 15, Y: 10})
+	tilled := entity.NewBerry(15, 10, types.ColorRed, false, false)
+	gameMap.AddItem(tilled)
+	// Too far for the tilled bonus to outweigh the wild berry
+	gameMap.SetTilled(types.Position{X: 39, Y: 39})
+	gameMap.AddItem(entity.NewBerry(39, 39, types.ColorRed, false, false))
+
+	if got := FindCreatureFood(c, gameMap); got != tilled {
+		t.Errorf("Expected tilled berry, got %v", got)
+	}
+
+	gameMap.RemoveItem(tilled)
+	if got := FindCreatureFood(c, gameMap); got != wild {
+		t.Errorf("Expected wild berry once the near tilled one is gone, got %v", got)
+	}
+}
+
+func TestFindCreatureFood_SkipsPickedAndInedibleItems(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	c := entity.NewCreature(5, 5, "rabbit")
+	gameMap.AddCreature(c)
+
+	picked := entity.NewBerry(6, 5, types.ColorRed, false, false)
+	picked.Plant.IsGrowing = false
+	gameMap.AddItem(picked)
+	gameMap.AddItem(entity.NewStick(5, 6))
+	gameMap.AddItem(entity.NewMushroom(4, 5, types.ColorBrown, types.PatternNone, types.TextureNone, false, false))
+
+	if got := FindCreatureFood(c, gameMap); got != nil {
+		t.Errorf("Expected no rabbit food, got %s", got.Description())
+	}
+}
+
+func TestNearestEdge(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 10)
+	tests := []struct {
+		pos, want types.Position
+	}{
+		{types.Position{X: 2, Y: 5}, types.Position{X: 0, Y: 5}},
+		{types.Position{X: 17, Y: 5}, types.Position{X: 19, Y: 5}},
+		{types.Position{X: 10, Y: 1}, types.Position{X: 10, Y: 0}},
+		{types.Position{X: 10, Y: 8}, types.Position{X: 10, Y: 9}},
+	}
+	for _, tt := range tests {
+		if got := nearestEdge(tt.pos, gameMap); got != tt.want {
+			t.Errorf("nearestEdge(%v) = %v, want %v", tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestUpdateCreature_LeavingCreatureIsRemovedAtEdge(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	c := entity.NewCreature(0, 7, "rabbit")
+	c.Leaving = true
+	gameMap.AddCreature(c)
+
+	UpdateCreature(c, gameMap, 0.15)
+
+	if len(gameMap.Creatures()) != 0 {
+		t.Error("Expected leaving creature on the edge to be removed")
+	}
+}
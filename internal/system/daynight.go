@@ -0,0 +1,140 @@
+package system
+
+import (
+	"fmt"
+	"math"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+)
+
+const worldStartHour = 8.0 // Worlds begin mid-morning on day 1
+
+// TimeOfDay is the part of the world day the clock is in
+type TimeOfDay int
+
+const (
+	TimeDawn  TimeOfDay = iota // Sky lightening (DawnHour to DawnHour+TwilightHours)
+	TimeDay                    // Full daylight
+	TimeDusk                   // Sky darkening (DuskHour to DuskHour+TwilightHours)
+	TimeNight                  // Full darkness
+)
+
+// ID returns the stable identifier used for i18n keys and the API ("dawn", "day", "dusk", "night")
+func (t TimeOfDay) ID() string {
+	switch t {
+	case TimeDawn:
+		return "dawn"
+	case TimeDusk:
+		return "dusk"
+	case TimeNight:
+		return "night"
+	default:
+		return "day"
+	}
+}
+
+// WorldHour returns the hour on the 24-hour world clock (fractional) at the given game time
+func WorldHour(gameTime float64) float64 {
+	return math.Mod(worldStartHour+gameTime/config.WorldDaySeconds*24, 24)
+}
+
+// WorldDay returns the 1-based world day at the given game time. Days roll over at midnight.
+func WorldDay(gameTime float64) int {
+	return int(worldStartHour/24+gameTime/config.WorldDaySeconds) + 1
+}
+
+// FormatClock formats the world clock at the given game time as HH:MM
+func FormatClock(gameTime float64) string {
+	minutes := int(WorldHour(gameTime) * 60)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// TimeOfDayAt returns the part of the day at the given game time
+func TimeOfDayAt(gameTime float64) TimeOfDay {
+	hour := WorldHour(gameTime)
+	switch {
+	case hour >= config.DawnHour && hour < config.DawnHour+config.TwilightHours:
+		return TimeDawn
+	case hour >= config.DawnHour+config.TwilightHours && hour < config.DuskHour:
+		return TimeDay
+	case hour >= config.DuskHour && hour < config.DuskHour+config.TwilightHours:
+		return TimeDusk
+	default:
+		return TimeNight
+	}
+}
+
+// Daylight returns how light it is at the given game time: 1 in full day, 0 at night,
+// fading linearly in between over dawn and dusk
+func Daylight(gameTime float64) float64 {
+	hour := WorldHour(gameTime)
+	switch TimeOfDayAt(gameTime) {
+	case TimeDay:
+		return 1
+	case TimeDawn:
+		return (hour - config.DawnHour) / config.TwilightHours
+	case TimeDusk:
+		return 1 - (hour-config.DuskHour)/config.TwilightHours
+	default:
+		return 0
+	}
+}
+
+// darknessScale interpolates between nightFactor in full darkness and 1 in full daylight
+func darknessScale(gameMap *game.Map, nightFactor float64) float64 {
+	return nightFactor + (1-nightFactor)*Daylight(gameMap.GameTime())
+}
+
+// PerceptionRadius returns how far characters notice threats right now; darkness shrinks it
+func PerceptionRadius(gameMap *game.Map) int {
+	return int(config.ThreatPerceptionRadius * darknessScale(gameMap, config.NightPerceptionFactor))
+}
+
+// WorkRate returns the share of normal speed characters act at right now; darkness slows them
+func WorkRate(gameMap *game.Map) float64 {
+	return darknessScale(gameMap, config.NightWorkFactor)
+}
+
+// IsBedtime returns true from dusk until dawn
+func IsBedtime(gameMap *game.Map) bool {
+	tod := TimeOfDayAt(gameMap.GameTime())
+	return tod == TimeDusk || tod == TimeNight
+}
+
+// sleepinessTier returns the character's energy tier, raised to Moderate at bedtime once
+// energy drops below NightSleepEnergy so that characters turn in for the night
+func sleepinessTier(char *entity.Character, gameMap *game.Map) int {
+	tier := char.EnergyTier()
+	if tier < entity.TierModerate && char.Energy < config.NightSleepEnergy && IsBedtime(gameMap) {
+		return entity.TierModerate
+	}
+	return tier
+}
+
+// canSleepOnGround returns true if the character may lie down without a bed:
+// when exhausted, or at bedtime once energy drops below NightSleepEnergy
+func canSleepOnGround(char *entity.Character, gameMap *game.Map) bool {
+	if char.Energy <= 10 {
+		return true
+	}
+	return char.Energy < config.NightSleepEnergy && IsBedtime(gameMap)
+}
+
+// UpdateSleepSchedule wakes sleepers at dawn once they have at least DawnWakeEnergy
+func UpdateSleepSchedule(char *entity.Character, gameMap *game.Map, log *ActionLog) {
+	if char.IsDead || !char.IsSleeping {
+		return
+	}
+	if TimeOfDayAt(gameMap.GameTime()) != TimeDawn || char.Energy < config.DawnWakeEnergy {
+		return
+	}
+	char.IsSleeping = false
+	char.AtBed = false
+	char.CurrentActivity = i18n.T("doing.waking_up")
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.woke_dawn")
+	}
+}
@@ -0,0 +1,151 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// gameTimeAt returns the game time at the given world hour on the given (1-based) day
+func gameTimeAt(day int, hour float64) float64 {
+	return (float64(day-1)*24 + hour - worldStartHour) / 24 * config.WorldDaySeconds
+}
+
+func TestWorldClock_StartsMidMorningAndRollsOverAtMidnight(t *testing.T) {
+	t.Parallel()
+
+	if got := FormatClock(0); got != "08:00" {
+		t.Errorf("Expected a new world to start at 08:00, got %s", got)
+	}
+	if got := FormatClock(15); got != "11:00" {
+		t.Errorf("Expected 15 game seconds later to read 11:00, got %s", got)
+	}
+
+	midnight := gameTimeAt(2, 0)
+	if got := WorldDay(midnight - 0.01); got != 1 {
+		t.Errorf("Expected day 1 just before midnight, got %d", got)
+	}
+	if got := WorldDay(midnight); got != 2 {
+		t.Errorf("Expected day 2 at midnight, got %d", got)
+	}
+	if got := FormatClock(midnight); got != "00:00" {
+		t.Errorf("Expected the clock to wrap to 00:00, got %s", got)
+	}
+}
+
+func TestTimeOfDayAt_Phases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		hour float64
+		want TimeOfDay
+	}{
+		{3, TimeNight},
+		{config.DawnHour, TimeDawn},
+		{12, TimeDay},
+		{config.DuskHour, TimeDusk},
+		{config.DuskHour + config.TwilightHours, TimeNight},
+	}
+	for _, tt := range tests {
+		if got := TimeOfDayAt(gameTimeAt(2, tt.hour)); got != tt.want {
+			t.Errorf("Hour %.1f: expected %s, got %s", tt.hour, tt.want.ID(), got.ID())
+		}
+	}
+}
+
+func TestDaylight_FadesOverDusk(t *testing.T) {
+	t.Parallel()
+
+	if got := Daylight(gameTimeAt(1, 12)); got != 1 {
+		t.Errorf("Expected full daylight at noon, got %.2f", got)
+	}
+	mid := Daylight(gameTimeAt(1, config.DuskHour+config.TwilightHours/2))
+	if mid <= 0.4 || mid >= 0.6 {
+		t.Errorf("Expected half light midway through dusk, got %.2f", mid)
+	}
+	if got := Daylight(gameTimeAt(1, 23)); got != 0 {
+		t.Errorf("Expected darkness at 23:00, got %.2f", got)
+	}
+}
+
+func TestNightEffects_ShrinkPerceptionAndSlowWork(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	gameMap.SetGameTime(gameTimeAt(1, 12))
+	if got := PerceptionRadius(gameMap); got != int(config.ThreatPerceptionRadius) {
+		t.Errorf("Expected full perception radius by day, got %d", got)
+	}
+	if got := WorkRate(gameMap); got != 1 {
+		t.Errorf("Expected full work rate by day, got %.2f", got)
+	}
+
+	gameMap.SetGameTime(gameTimeAt(1, 23))
+	if got := PerceptionRadius(gameMap); got >= int(config.ThreatPerceptionRadius) {
+		t.Errorf("Expected a smaller perception radius at night, got %d", got)
+	}
+	if got := WorkRate(gameMap); got != config.NightWorkFactor {
+		t.Errorf("Expected work rate %.2f at night, got %.2f", config.NightWorkFactor, got)
+	}
+
+	// A rabbit at the edge of daytime perception goes unnoticed in the dark
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	gameMap.AddCreature(entity.NewCreature(5, 5+int(config.ThreatPerceptionRadius), "rabbit"))
+	if got := PerceiveThreat(char, gameMap); got != nil {
+		t.Errorf("Expected the distant rabbit to go unnoticed at night")
+	}
+}
+
+func TestCalculateIntent_TurnsInOnGroundAfterDusk(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	char.Hunger, char.Thirst = 0, 0
+	char.Energy = config.NightSleepEnergy - 5
+	gameMap.AddCharacter(char)
+
+	gameMap.SetGameTime(gameTimeAt(1, 12))
+	if intent := CalculateIntent(char, nil, gameMap, nil, nil); intent != nil && intent.Action == entity.ActionSleep {
+		t.Fatal("Expected no ground sleep at midday with energy to spare")
+	}
+
+	char.Intent = nil
+	gameMap.SetGameTime(gameTimeAt(1, 22))
+	intent := CalculateIntent(char, nil, gameMap, nil, nil)
+	if intent == nil || intent.Action != entity.ActionSleep {
+		t.Fatalf("Expected the character to sleep on the ground at night, got %+v", intent)
+	}
+}
+
+func TestUpdateSleepSchedule_WakesRestedSleepersAtDawn(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	rested := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	rested.IsSleeping = true
+	rested.Energy = config.DawnWakeEnergy + 10
+	tired := entity.NewCharacter(2, 6, 5, "Ari", "berry", types.ColorBlue)
+	tired.IsSleeping = true
+	tired.Energy = config.DawnWakeEnergy - 10
+
+	gameMap.SetGameTime(gameTimeAt(2, 3))
+	UpdateSleepSchedule(rested, gameMap, nil)
+	if !rested.IsSleeping {
+		t.Fatal("Expected sleepers to stay asleep in the middle of the night")
+	}
+
+	gameMap.SetGameTime(gameTimeAt(2, config.DawnHour))
+	UpdateSleepSchedule(rested, gameMap, nil)
+	UpdateSleepSchedule(tired, gameMap, nil)
+	if rested.IsSleeping {
+		t.Error("Expected a rested sleeper to get up at dawn")
+	}
+	if !tired.IsSleeping {
+		t.Error("Expected a sleeper below DawnWakeEnergy to keep sleeping")
+	}
+}
@@ -1,11 +1,9 @@
 package system
 
 import (
-	"fmt"
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/rng"
 )
 
 // GetDiscoveryChance returns the know-how discovery chance based on character mood.
@@ -60,11 +58,10 @@ func tryDiscoverActivity(char *entity.Character, action entity.ActionType, item
 			}
 
 			// Roll for discovery
-			if rand.Float64() < chance {
+			if rng.Float64() < chance {
 				char.LearnActivity(activity.ID)
 				if log != nil {
-					log.Add(char.ID, char.Name, "discovery",
-						fmt.Sprintf("Discovered how to %s!", activity.Name))
+					log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.activity", activity.DisplayName())
 				}
 				return true
 			}
@@ -89,7 +86,7 @@ func tryDiscoverRecipe(char *entity.Character, action entity.ActionType, item *e
 			}
 
 			// Roll for discovery
-			if rand.Float64() < chance {
+			if rng.Float64() < chance {
 				// Grant the activity (if not already known)
 				activityLearned := char.LearnActivity(recipe.ActivityID)
 
@@ -100,11 +97,9 @@ func tryDiscoverRecipe(char *entity.Character, action entity.ActionType, item *e
 				if log != nil {
 					activity := entity.ActivityRegistry[recipe.ActivityID]
 					if activityLearned {
-						log.Add(char.ID, char.Name, "discovery",
-							fmt.Sprintf("Discovered how to %s %s!", activityCategoryVerb(activity.Category), activity.Name))
+						log.AddMessage(char.ID, char.Name, "discovery", discoveryCategoryKey(activity.Category), activity.DisplayName())
 					}
-					log.Add(char.ID, char.Name, "discovery",
-						fmt.Sprintf("Learned %s recipe!", recipe.Name))
+					log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.recipe", recipe.DisplayName())
 				}
 
 				// Grant bundled activities
@@ -112,8 +107,7 @@ func tryDiscoverRecipe(char *entity.Character, action entity.ActionType, item *e
 					if char.LearnActivity(bundledID) {
 						if log != nil {
 							bundledActivity := entity.ActivityRegistry[bundledID]
-							log.Add(char.ID, char.Name, "discovery",
-								fmt.Sprintf("Discovered how to %s!", bundledActivity.Name))
+							log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.activity", bundledActivity.DisplayName())
 						}
 					}
 				}
@@ -126,13 +120,13 @@ func tryDiscoverRecipe(char *entity.Character, action entity.ActionType, item *e
 	return false
 }
 
-// activityCategoryVerb returns a verb suitable for "Discovered how to [verb] [name]!" messages.
-func activityCategoryVerb(category string) string {
+// discoveryCategoryKey returns the log message key for discovering an activity of a category
+func discoveryCategoryKey(category string) string {
 	switch category {
 	case "construction":
-		return "build"
+		return "log.discovery.build"
 	default:
-		return "craft"
+		return "log.discovery.craft"
 	}
 }
 
@@ -166,11 +160,10 @@ func tryDiscoverActivityFromConstruct(char *entity.Character, action entity.Acti
 				continue
 			}
 
-			if rand.Float64() < chance {
+			if rng.Float64() < chance {
 				char.LearnActivity(activity.ID)
 				if log != nil {
-					log.Add(char.ID, char.Name, "discovery",
-						fmt.Sprintf("Discovered how to %s!", activity.Name))
+					log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.activity", activity.DisplayName())
 				}
 				return true
 			}
@@ -192,26 +185,23 @@ func tryDiscoverRecipeFromConstruct(char *entity.Character, action entity.Action
 				continue
 			}
 
-			if rand.Float64() < chance {
+			if rng.Float64() < chance {
 				activityLearned := char.LearnActivity(recipe.ActivityID)
 				char.LearnRecipe(recipe.ID)
 
 				if log != nil {
 					activity := entity.ActivityRegistry[recipe.ActivityID]
 					if activityLearned {
-						log.Add(char.ID, char.Name, "discovery",
-							fmt.Sprintf("Discovered how to %s %s!", activityCategoryVerb(activity.Category), activity.Name))
+						log.AddMessage(char.ID, char.Name, "discovery", discoveryCategoryKey(activity.Category), activity.DisplayName())
 					}
-					log.Add(char.ID, char.Name, "discovery",
-						fmt.Sprintf("Learned %s recipe!", recipe.Name))
+					log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.recipe", recipe.DisplayName())
 				}
 
 				for _, bundledID := range recipe.BundledActivities {
 					if char.LearnActivity(bundledID) {
 						if log != nil {
 							bundledActivity := entity.ActivityRegistry[bundledID]
-							log.Add(char.ID, char.Name, "discovery",
-								fmt.Sprintf("Discovered how to %s!", bundledActivity.Name))
+							log.AddMessage(char.ID, char.Name, "discovery", "log.discovery.activity", bundledActivity.DisplayName())
 						}
 					}
 				}
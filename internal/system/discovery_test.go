@@ -449,6 +449,29 @@ func TestTryDiscoverKnowHow_ShellHoeRecipeGrantsTillSoil(t *testing.T) {
 	}
 }
 
+func TestTryDiscoverKnowHow_StoneAxeRecipeGrantsWoodwork(t *testing.T) {
+	char := &entity.Character{
+		Name:            "Test",
+		KnownActivities: []string{},
+		KnownRecipes:    []string{},
+	}
+	item := &entity.Item{
+		ItemType: "flake",
+	}
+
+	discovered := TryDiscoverKnowHow(char, entity.ActionLook, item, nil, 1.0)
+
+	if !discovered {
+		t.Error("Expected discovery with 100% chance")
+	}
+	if !char.KnowsRecipe("stone-axe") || !char.KnowsActivity("craftAxe") {
+		t.Error("Expected character to know the stone-axe recipe and craftAxe activity")
+	}
+	if !char.KnowsActivity("chopWood") || !char.KnowsActivity("carveWood") {
+		t.Error("Expected character to know chopWood and carveWood from bundled activities")
+	}
+}
+
 func TestTryDiscoverKnowHow_BundledActivityAlreadyKnown(t *testing.T) {
 	char := &entity.Character{
 		Name:            "Test",
@@ -1,11 +1,10 @@
 package system
 
 import (
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
@@ -24,7 +23,7 @@ func selectDiscretionaryActivity(char *entity.Character, pos types.Position, ite
 	char.IdleCooldown = config.IdleCooldown
 
 	// Roll 0-4 for activity selection (equal 1/5 probability each)
-	roll := rand.Intn(5)
+	roll := rng.Intn(5)
 
 	switch roll {
 	case 0:
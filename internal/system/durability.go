@@ -0,0 +1,145 @@
+package system
+
+import (
+	"math"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// UpdateDurability ages every construct and crafted item by delta seconds.
+// Constructs that wear out break into salvage; worn-out items are destroyed,
+// including ones characters are carrying.
+func UpdateDurability(gameMap *game.Map, delta float64, log *ActionLog) {
+	// Iterate copies: breaking removes entries from the underlying slices
+	for _, c := range append([]*entity.Construct(nil), gameMap.Constructs()...) {
+		DamageConstruct(gameMap, c, delta)
+	}
+
+	for _, item := range append([]*entity.Item(nil), gameMap.Items()...) {
+		if item.Durability != nil && item.Durability.Damage(delta) {
+			gameMap.RemoveItem(item)
+		}
+	}
+
+	for _, char := range gameMap.Characters() {
+		for _, item := range append([]*entity.Item(nil), char.Inventory...) {
+			if item == nil || item.Durability == nil || !item.Durability.Damage(delta) {
+				continue
+			}
+			char.RemoveFromInventory(item)
+			if log != nil {
+				log.AddMessage(char.ID, char.Name, "activity", "log.item_broke", item.Description())
+			}
+		}
+	}
+}
+
+// DamageConstruct reduces a construct's durability (age, weather, creatures), breaking it at zero.
+// Constructs whose material never wears are unaffected. Returns true if the construct broke.
+func DamageConstruct(gameMap *game.Map, c *entity.Construct, amount float64) bool {
+	if c.Durability == nil || !c.Durability.Damage(amount) {
+		return false
+	}
+	BreakConstruct(gameMap, c)
+	return true
+}
+
+// BreakConstruct removes a construct and leaves DurabilitySalvageFraction of its
+// input materials on the nearest free tiles, one stack per tile
+func BreakConstruct(gameMap *game.Map, c *entity.Construct) {
+	pos := c.Pos()
+	gameMap.RemoveConstruct(c)
+	dropSalvage(gameMap, pos, salvageItems(c, config.DurabilitySalvageFraction))
+}
+
+// DeconstructConstruct completes a deconstruct order on one construct: it is removed and
+// DeconstructRecoveryFraction of its input materials are dropped as bundles next to the site.
+// A hut wall or door comes down on its own; the rest of the hut stays standing.
+func DeconstructConstruct(gameMap *game.Map, c *entity.Construct, char *entity.Character, log *ActionLog) {
+	pos := c.Pos()
+	gameMap.RemoveConstruct(c)
+	dropSalvage(gameMap, pos, salvageItems(c, config.DeconstructRecoveryFraction))
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.deconstructed", c.DisplayName())
+	}
+}
+
+// dropSalvage places salvaged items on the nearest free tiles around pos, one stack per tile
+func dropSalvage(gameMap *game.Map, pos types.Position, salvage []*entity.Item) {
+	tiles := nearestFreeTiles(gameMap, pos, len(salvage))
+	for i, tile := range tiles {
+		item := salvage[i]
+		item.SetPos(tile)
+		gameMap.AddItem(item)
+	}
+}
+
+// salvageItems returns the given fraction of a construct's input materials as new items.
+// The input count comes from the construction recipe for the construct's kind and material.
+func salvageItems(c *entity.Construct, fraction float64) []*entity.Item {
+	count := 0
+	for _, recipe := range entity.RecipeRegistry {
+		if recipe.Output.ItemType == c.Kind && len(recipe.Inputs) == 1 && recipe.Inputs[0].ItemType == c.Material {
+			count = int(math.Round(float64(recipe.Inputs[0].Count) * fraction))
+			break
+		}
+	}
+
+	var items []*entity.Item
+	if maxBundle := config.MaxBundleSize[c.Material]; maxBundle > 0 {
+		// Bundleable materials come back as bundles
+		for count > 0 {
+			size := min(count, maxBundle)
+			count -= size
+			var item *entity.Item
+			switch c.Material {
+			case "stick":
+				item = entity.NewStick(0, 0)
+			case "grass":
+				item = entity.NewGrass(0, 0)
+				item.Plant.IsGrowing = false // Cut thatch doesn't take root
+			default:
+				return items
+			}
+			item.BundleCount = size
+			items = append(items, item)
+		}
+		return items
+	}
+
+	for i := 0; i < count; i++ {
+		switch c.Material {
+		case "brick":
+			items = append(items, entity.NewBrick(0, 0))
+		default:
+			return items
+		}
+	}
+	return items
+}
+
+// nearestFreeTiles returns up to n walkable, item-free tiles in rings of increasing distance from origin
+// (the origin itself first). Returns fewer if the search radius runs out.
+func nearestFreeTiles(gameMap *game.Map, origin types.Position, n int) []types.Position {
+	const maxRadius = 5
+	var tiles []types.Position
+	for r := 0; r <= maxRadius && len(tiles) < n; r++ {
+		for dy := -r; dy <= r && len(tiles) < n; dy++ {
+			for dx := -r; dx <= r && len(tiles) < n; dx++ {
+				// Only the ring at distance r
+				if max(types.Abs(dx), types.Abs(dy)) != r {
+					continue
+				}
+				pos := types.Position{X: origin.X + dx, Y: origin.Y + dy}
+				if !gameMap.IsValid(pos) || gameMap.IsBlocked(pos) || gameMap.ItemAt(pos) != nil {
+					continue
+				}
+				tiles = append(tiles, pos)
+			}
+		}
+	}
+	return tiles
+}
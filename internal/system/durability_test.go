@@ -0,0 +1,212 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestUpdateDurability_AgesConstructs(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	fence := entity.NewFence(5, 5, "brick", types.ColorTerracotta)
+	gameMap.AddConstruct(fence)
+
+	UpdateDurability(gameMap, 10, nil)
+
+	if fence.Durability.Current != fence.Durability.Max-10 {
+		t.Errorf("Expected 10 seconds of wear, got %.1f/%.1f", fence.Durability.Current, fence.Durability.Max)
+	}
+}
+
+func TestBreakConstruct_StickFenceLeavesHalfItsSticks(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	fence := entity.NewFence(5, 5, "stick", types.ColorBrown)
+	gameMap.AddConstruct(fence)
+	fence.Durability.Current = 1
+
+	UpdateDurability(gameMap, 2, nil)
+
+	if len(gameMap.Constructs()) != 0 {
+		t.Fatal("Expected worn-out fence to be removed")
+	}
+	items := gameMap.Items()
+	if len(items) != 1 {
+		t.Fatalf("Expected one bundle of salvage, got %d items", len(items))
+	}
+	if items[0].ItemType != "stick" || items[0].BundleCount != 3 {
+		t.Errorf("Expected a bundle of 3 sticks, got %s x%d", items[0].ItemType, items[0].BundleCount)
+	}
+	if items[0].Pos() != (types.Position{X: 5, Y: 5}) {
+		t.Errorf("Expected salvage on the fence tile, got %v", items[0].Pos())
+	}
+}
+
+func TestBreakConstruct_ThatchHutWallDoesNotTakeRoot(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	wall := entity.NewHutConstruct(5, 5, "grass", types.ColorPaleYellow, "wall")
+	gameMap.AddConstruct(wall)
+
+	BreakConstruct(gameMap, wall)
+
+	items := gameMap.Items()
+	if len(items) != 1 || items[0].ItemType != "grass" || items[0].BundleCount != 6 {
+		t.Fatalf("Expected a bundle of 6 grass from a 12-grass wall, got %v", items)
+	}
+	if items[0].Plant.IsGrowing {
+		t.Error("Expected salvaged thatch not to be a growing plant")
+	}
+}
+
+func TestBreakConstruct_BricksScatterToNearestFreeTiles(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	fence := entity.NewFence(5, 5, "brick", types.ColorTerracotta)
+	gameMap.AddConstruct(fence)
+	// Neighboring fence and water stay blocked
+	gameMap.AddConstruct(entity.NewFence(6, 5, "brick", types.ColorTerracotta))
+	gameMap.AddWater(types.Position{X: 4, Y: 5}, game.WaterPond)
+
+	BreakConstruct(gameMap, fence)
+
+	items := gameMap.Items()
+	if len(items) != 3 {
+		t.Fatalf("Expected 3 bricks from a 6-brick fence, got %d", len(items))
+	}
+	seen := make(map[types.Position]bool)
+	for _, item := range items {
+		pos := item.Pos()
+		if item.ItemType != "brick" {
+			t.Errorf("Expected brick, got %s", item.ItemType)
+		}
+		if seen[pos] {
+			t.Errorf("Two bricks on %v", pos)
+		}
+		seen[pos] = true
+		if gameMap.IsBlocked(pos) {
+			t.Errorf("Brick landed on blocked tile %v", pos)
+		}
+		if dx, dy := types.Abs(pos.X-5), types.Abs(pos.Y-5); dx > 1 || dy > 1 {
+			t.Errorf("Expected bricks next to the fence, got %v", pos)
+		}
+	}
+}
+
+func TestDeconstructConstruct_RecoversHutWallAsBundles(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 6, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	wall := entity.NewHutConstruct(5, 5, "stick", types.ColorBrown, "wall")
+	neighbor := entity.NewHutConstruct(6, 5, "stick", types.ColorBrown, "wall")
+	gameMap.AddConstruct(wall)
+	gameMap.AddConstruct(neighbor)
+	gameMap.MarkForDeconstruction(wall.Pos())
+	log := NewActionLog(10)
+
+	DeconstructConstruct(gameMap, wall, char, log)
+
+	if gameMap.ConstructAt(wall.Pos()) != nil {
+		t.Fatal("Expected the wall to be removed")
+	}
+	if gameMap.ConstructAt(neighbor.Pos()) != neighbor {
+		t.Error("Expected the neighboring wall to stay standing")
+	}
+	if gameMap.IsMarkedForDeconstruction(wall.Pos()) {
+		t.Error("Expected the deconstruction mark to be cleared")
+	}
+	total := 0
+	for _, item := range gameMap.Items() {
+		if item.ItemType != "stick" || item.BundleCount == 0 {
+			t.Errorf("Expected stick bundles, got %s x%d", item.ItemType, item.BundleCount)
+		}
+		if item.Pos().DistanceTo(wall.Pos()) > 2 {
+			t.Errorf("Expected recovered materials next to the site, got %v", item.Pos())
+		}
+		total += item.BundleCount
+	}
+	if total != 12 {
+		t.Errorf("Expected all 12 sticks recovered, got %d", total)
+	}
+	if log.EventCount(char.ID) == 0 {
+		t.Error("Expected a log entry for the deconstruction")
+	}
+}
+
+func TestUpdateDurability_CarriedToolBreaks(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	hoe := entity.NewHoe(0, 0, types.ColorSilver)
+	hoe.Durability.Current = 0.1
+	char.AddToInventory(hoe)
+	log := NewActionLog(10)
+
+	UpdateDurability(gameMap, 1, log)
+
+	if char.FindInInventory(func(i *entity.Item) bool { return i == hoe }) != nil {
+		t.Error("Expected worn-out hoe to leave the inventory")
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 1 || events[0].Key != "log.item_broke" {
+		t.Errorf("Expected an item_broke log entry, got %v", events)
+	}
+}
+
+func TestUpdateDurability_NaturalThingsNeverWear(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	berry := entity.NewBerry(3, 3, types.ColorRed, false, false)
+	gameMap.AddItem(berry)
+	gameMap.AddFeature(entity.NewLeafPile(4, 4))
+
+	UpdateDurability(gameMap, 1e9, nil)
+
+	if !gameMap.HasItemOnMap(berry) || len(gameMap.Features()) != 1 {
+		t.Error("Expected natural items and features to be unaffected by durability")
+	}
+}
+
+func TestCreatureGnawsBlockingFence(t *testing.T) {
+	t.Parallel()
+
+	// A rabbit penned in by fences gnaws its way out when it wants to leave
+	gameMap := game.NewMap(20, 20)
+	var pen []*entity.Construct
+	for _, pos := range []types.Position{{X: 4, Y: 5}, {X: 6, Y: 5}, {X: 5, Y: 4}, {X: 5, Y: 6}} {
+		fence := entity.NewFence(pos.X, pos.Y, "grass", types.ColorPaleYellow)
+		gameMap.AddConstruct(fence)
+		pen = append(pen, fence)
+	}
+	rabbit := entity.NewCreature(5, 5, "rabbit")
+	rabbit.Leaving = true
+	gameMap.AddCreature(rabbit)
+
+	UpdateCreature(rabbit, gameMap, 1)
+
+	damaged := 0
+	for _, fence := range pen {
+		if fence.Durability.Current < fence.Durability.Max {
+			damaged++
+			if want := fence.Durability.Max - config.CreatureGnawDamage; fence.Durability.Current != want {
+				t.Errorf("Expected gnaw damage to leave %.0f, got %.0f", want, fence.Durability.Current)
+			}
+		}
+	}
+	if damaged != 1 {
+		t.Errorf("Expected the rabbit to gnaw one fence, got %d", damaged)
+	}
+}
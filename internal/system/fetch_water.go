@@ -3,6 +3,7 @@ package system
 import (
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -43,11 +44,11 @@ func findFetchWaterIntent(char *entity.Character, pos types.Position, items []*e
 		if waterVessel := findGroundWaterVessel(pos, items); waterVessel != nil {
 			vpos := waterVessel.Pos()
 			nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-			newActivity := "Fetching water"
+			newActivity := i18n.T("doing.fetching_water")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "Picking up water vessel")
+					log.AddMessage(char.ID, char.Name, "activity", "log.picking_up_water_vessel")
 				}
 			}
 			return &entity.Intent{
@@ -68,11 +69,11 @@ func findFetchWaterIntent(char *entity.Character, pos types.Position, items []*e
 		// applyIntent will pick up the vessel, then transition to phase 2
 		vpos := groundVessel.Pos()
 		nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-		newActivity := "Fetching water"
+		newActivity := i18n.T("doing.fetching_water")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Picking up vessel for water")
+				log.AddMessage(char.ID, char.Name, "activity", "log.picking_up_vessel_for_water")
 			}
 		}
 
@@ -102,11 +103,11 @@ func findFetchWaterIntent(char *entity.Character, pos types.Position, items []*e
 
 	// Phase 2: ActionFillVessel with Dest at water-adjacent tile
 	nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
-	newActivity := "Fetching water"
+	newActivity := i18n.T("doing.fetching_water")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "activity", "Heading to water to fill vessel")
+			log.AddMessage(char.ID, char.Name, "activity", "log.heading_to_fill_vessel")
 		}
 	}
 
@@ -0,0 +1,489 @@
+package system
+
+import (
+	"math"
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// UpdateFires burns down campfire fuel and runs wildfires. Lit campfires go out when their fuel
+// runs out or a storm blows through, and rain puts out every wildfire. In dry weather (a drought
+// or a clear summer day) lit campfires and burning tiles spread to flammable cardinal neighbors
+// once every FireSpreadInterval seconds on average. A wildfire starting from nothing is logged.
+func UpdateFires(gameMap *game.Map, delta float64, log *ActionLog) {
+	weather := gameMap.Weather()
+	for _, c := range gameMap.Constructs() {
+		if !c.IsLit() {
+			continue
+		}
+		if weather == game.WeatherStorm {
+			c.PutOut()
+			continue
+		}
+		c.BurnFuel(delta)
+	}
+
+	if weather.IsWet() {
+		gameMap.DouseFires()
+		return
+	}
+
+	if isDryWeather(gameMap) {
+		var sources []types.Position
+		for _, c := range gameMap.Constructs() {
+			if c.IsLit() {
+				sources = append(sources, c.Pos())
+			}
+		}
+		sources = append(sources, gameMap.BurningPositions()...)
+		wasBurning := len(gameMap.BurningPositions()) > 0
+
+		for _, src := range sources {
+			for _, dir := range [4][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+				adj := types.Position{X: src.X + dir[0], Y: src.Y + dir[1]}
+				if gameMap.IsBurning(adj) || !gameMap.IsFlammable(adj) {
+					continue
+				}
+				if rollInterval(delta, config.FireSpreadInterval) {
+					gameMap.Ignite(adj)
+				}
+			}
+		}
+
+		if !wasBurning && len(gameMap.BurningPositions()) > 0 && log != nil {
+			log.AddMessage(weatherLogID, i18n.T("weather.log_name"), "weather", "log.wildfire")
+		}
+	}
+
+	gameMap.BurnDown(delta)
+}
+
+// isDryWeather returns true during a drought or on a clear summer day, when fire can spread
+func isDryWeather(gameMap *game.Map) bool {
+	weather := gameMap.Weather()
+	return weather == game.WeatherDrought || (!weather.IsWet() && gameMap.Season() == game.SeasonSummer)
+}
+
+// NearLitCampfire returns true if pos is within CampfireRadius tiles of a lit campfire
+func NearLitCampfire(gameMap *game.Map, pos types.Position) bool {
+	_, ok := findNearestLitCampfire(gameMap, pos, config.CampfireRadius)
+	return ok
+}
+
+// findNearestLitCampfire returns the nearest lit campfire within radius tiles of pos.
+// A negative radius means any distance.
+func findNearestLitCampfire(gameMap *game.Map, pos types.Position, radius float64) (*entity.Construct, bool) {
+	var best *entity.Construct
+	bestDist := math.MaxInt
+	for _, c := range gameMap.Constructs() {
+		if !c.IsLit() {
+			continue
+		}
+		dist := pos.DistanceTo(c.Pos())
+		if radius >= 0 && float64(dist) > radius {
+			continue
+		}
+		if dist < bestDist {
+			best, bestDist = c, dist
+		}
+	}
+	return best, best != nil
+}
+
+// FindCampfireWarmth returns a free tile beside the nearest lit campfire to warm up on
+func FindCampfireWarmth(gameMap *game.Map, from types.Position) (types.Position, bool) {
+	fire, ok := findNearestLitCampfire(gameMap, from, -1)
+	if !ok {
+		return types.Position{}, false
+	}
+	if from.IsCardinallyAdjacentTo(fire.Pos()) {
+		return from, true
+	}
+	adj := findAdjacentStandingTile(fire.Pos(), gameMap)
+	if adj == nil {
+		return types.Position{}, false
+	}
+	return *adj, true
+}
+
+// campfireNeedsFuel returns true if the construct is a campfire running low on fuel
+func campfireNeedsFuel(c *entity.Construct) bool {
+	return c.IsCampfire() && c.Fuel < config.CampfireRefuelFuel
+}
+
+// findBuildCampfireIntent creates an intent to build a campfire on a marked tile or feed a
+// campfire that is running low.
+// Flow: find nearest campfire work → procure a stick (and grass for a new fire) → walk beside it → build.
+// Returns nil when there is no campfire work (order complete) or no materials (triggers abandonment).
+func findBuildCampfireIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	var candidates []types.Position
+	for _, mpos := range gameMap.MarkedForConstructionPositions() {
+		mark, ok := gameMap.GetConstructionMark(mpos)
+		if !ok || mark.ConstructKind != "campfire" || gameMap.ConstructAt(mpos) != nil {
+			continue
+		}
+		if occ := gameMap.CharacterAt(mpos); occ != nil && occ != char {
+			continue
+		}
+		candidates = append(candidates, mpos)
+	}
+	for _, c := range gameMap.Constructs() {
+		if campfireNeedsFuel(c) {
+			candidates = append(candidates, c.Pos())
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return pos.DistanceTo(candidates[i]) < pos.DistanceTo(candidates[j])
+	})
+
+	// Drop anything that isn't kindling so both materials fit in inventory
+	var toDrop []*entity.Item
+	for _, inv := range char.Inventory {
+		if inv != nil && inv.ItemType != "stick" && inv.ItemType != "grass" {
+			toDrop = append(toDrop, inv)
+		}
+	}
+	for _, item := range toDrop {
+		DropItem(char, item, gameMap, log)
+	}
+
+	for _, candidate := range candidates {
+		needed := []string{"stick"}
+		if gameMap.ConstructAt(candidate) == nil {
+			needed = append(needed, "grass")
+		}
+		missing := false
+		for _, itemType := range needed {
+			if intent := EnsureHasItem(char, itemType, items, gameMap, log); intent != nil {
+				return intent
+			}
+			if char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == itemType }) == nil {
+				missing = true
+			}
+		}
+		if missing {
+			continue // Can't supply this fire — a refuel might still be possible
+		}
+
+		standPos := pos
+		if !pos.IsCardinallyAdjacentTo(candidate) {
+			adjPos := findAdjacentStandingTile(candidate, gameMap)
+			if adjPos == nil {
+				continue
+			}
+			standPos = *adjPos
+		}
+		targetPos := candidate
+		nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, standPos.X, standPos.Y, gameMap, char.UsingBFS)
+		if usedBFS {
+			char.UsingBFS = true
+		}
+		newActivity := i18n.T("doing.tending_campfire")
+		if pos != standPos {
+			newActivity = i18n.T("doing.moving_to_campfire")
+		}
+		if char.CurrentActivity != newActivity {
+			char.CurrentActivity = newActivity
+		}
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           standPos,
+			Action:         entity.ActionBuildCampfire,
+			TargetBuildPos: &targetPos,
+		}
+	}
+	return nil
+}
+
+// HasCampfireWork returns true if a campfire is marked but unbuilt, or a campfire is running low on fuel
+func HasCampfireWork(gameMap *game.Map) bool {
+	if gameMap.HasUnbuiltConstructionPositions("campfire") {
+		return true
+	}
+	for _, c := range gameMap.Constructs() {
+		if campfireNeedsFuel(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// campfireFeasible returns true if there is campfire work the world has the materials for
+func campfireFeasible(chars []*entity.Character, items []*entity.Item, gameMap *game.Map) bool {
+	if !itemExistsInWorld("stick", chars, items) {
+		return false
+	}
+	for _, c := range gameMap.Constructs() {
+		if campfireNeedsFuel(c) {
+			return true
+		}
+	}
+	return gameMap.HasUnbuiltConstructionPositions("campfire") && itemExistsInWorld("grass", chars, items)
+}
+
+// BuildCampfire lays a campfire on the marked tile at pos from a carried stick and grass, or feeds
+// the campfire already there with carried sticks. A new fire is lit with one stick's worth of fuel;
+// feeding adds CampfireFuelPerStick per stick, taking only as many sticks as fit under CampfireMaxFuel.
+// Returns false if the character is missing materials or there is nothing to build or feed.
+func BuildCampfire(gameMap *game.Map, pos types.Position, char *entity.Character, log *ActionLog) bool {
+	sticks := char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "stick" })
+	if sticks == nil {
+		return false
+	}
+
+	if fire := gameMap.ConstructAt(pos); fire != nil {
+		if !fire.IsCampfire() || fire.Fuel >= config.CampfireMaxFuel {
+			return false
+		}
+		want := int(math.Ceil((config.CampfireMaxFuel - fire.Fuel) / config.CampfireFuelPerStick))
+		fed := takeFromStack(char, sticks, want)
+		fire.AddFuel(float64(fed)*config.CampfireFuelPerStick, config.CampfireMaxFuel)
+		if log != nil {
+			log.AddMessage(char.ID, char.Name, "activity", "log.fed_campfire")
+		}
+		return true
+	}
+
+	mark, ok := gameMap.GetConstructionMark(pos)
+	grass := char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "grass" })
+	if !ok || mark.ConstructKind != "campfire" || grass == nil || gameMap.CharacterAt(pos) != nil {
+		return false
+	}
+	takeFromStack(char, sticks, 1)
+	takeFromStack(char, grass, 1)
+
+	fire := entity.NewCampfire(pos.X, pos.Y)
+	fire.AddFuel(config.CampfireFuelPerStick, config.CampfireMaxFuel)
+	gameMap.AddConstruct(fire)
+	gameMap.UnmarkForConstruction(pos)
+
+	// Move anything lying on the hearth to where the builder stands
+	for _, item := range gameMap.ItemsAt(pos) {
+		item.X, item.Y = char.X, char.Y
+	}
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.built", fire.DisplayName())
+	}
+	return true
+}
+
+// takeFromStack takes up to n units from a carried item or bundle, removing it from inventory
+// once used up. Returns how many units were taken.
+func takeFromStack(char *entity.Character, item *entity.Item, n int) int {
+	count := max(item.BundleCount, 1)
+	if n >= count {
+		char.RemoveFromInventory(item)
+		return count
+	}
+	item.BundleCount -= n
+	return n
+}
+
+// isRawFood returns true if an item can be cooked: edible, not yet cooked, and not a sprout
+func isRawFood(item *entity.Item) bool {
+	return item.IsEdible() && !item.IsCooked() && (item.Plant == nil || !item.Plant.IsSprout)
+}
+
+// findCookIntent creates an intent to cook a piece of food over the nearest lit campfire.
+// Flow: find lit campfire → procure raw food → walk beside the fire → cook.
+// Returns nil when there is no lit campfire or no raw food (triggers abandonment).
+func findCookIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	fire, ok := findNearestLitCampfire(gameMap, pos, -1)
+	if !ok {
+		return nil
+	}
+
+	if char.FindInInventory(isRawFood) == nil {
+		var target *entity.Item
+		bestDist := math.MaxInt
+		for _, item := range items {
+			if !isRawFood(item) {
+				continue
+			}
+			if dist := pos.DistanceTo(item.Pos()); dist < bestDist {
+				target, bestDist = item, dist
+			}
+		}
+		if target == nil {
+			return nil // No food to cook — triggers abandonment
+		}
+		if !char.HasInventorySpace() {
+			for _, inv := range char.Inventory {
+				if inv != nil {
+					DropItem(char, inv, gameMap, log)
+					break
+				}
+			}
+		}
+		return createItemPickupIntent(char, pos, target, gameMap, log)
+	}
+
+	firePos := fire.Pos()
+	standPos := pos
+	if !pos.IsCardinallyAdjacentTo(firePos) {
+		adjPos := findAdjacentStandingTile(firePos, gameMap)
+		if adjPos == nil {
+			return nil
+		}
+		standPos = *adjPos
+	}
+	nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, standPos.X, standPos.Y, gameMap, char.UsingBFS)
+	if usedBFS {
+		char.UsingBFS = true
+	}
+	newActivity := i18n.T("doing.cooking")
+	if pos != standPos {
+		newActivity = i18n.T("doing.moving_to_cook")
+	}
+	if char.CurrentActivity != newActivity {
+		char.CurrentActivity = newActivity
+	}
+	return &entity.Intent{
+		Target:         types.Position{X: nx, Y: ny},
+		Dest:           standPos,
+		Action:         entity.ActionCook,
+		TargetBuildPos: &firePos,
+	}
+}
+
+// cookFeasible returns true if a campfire is lit and there is raw food to cook
+func cookFeasible(chars []*entity.Character, items []*entity.Item, gameMap *game.Map) bool {
+	if _, ok := findNearestLitCampfire(gameMap, types.Position{}, -1); !ok {
+		return false
+	}
+	for _, c := range chars {
+		if c.FindInInventory(isRawFood) != nil {
+			return true
+		}
+	}
+	for _, item := range items {
+		if isRawFood(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// CookItem cooks a piece of carried raw food over the lit campfire at firePos.
+// Returns false if the fire is out or the character has nothing to cook.
+func CookItem(gameMap *game.Map, firePos types.Position, char *entity.Character, log *ActionLog) bool {
+	fire := gameMap.ConstructAt(firePos)
+	food := char.FindInInventory(isRawFood)
+	if fire == nil || !fire.IsLit() || food == nil {
+		return false
+	}
+	rawName := food.Description()
+	food.Cook()
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.cooked", rawName)
+	}
+	return true
+}
+
+// findExtinguishIntent creates an intent to put out the nearest wildfire with vessel water.
+// Phases mirror watering the garden: procure a vessel → fill it at water → walk beside the fire → douse.
+// Returns nil if nothing is burning or no vessel can be had.
+func findExtinguishIntent(char *entity.Character, pos types.Position, items []*entity.Item, gameMap *game.Map) *entity.Intent {
+	burning := gameMap.BurningPositions()
+	if len(burning) == 0 {
+		return nil
+	}
+	sort.SliceStable(burning, func(i, j int) bool {
+		return pos.DistanceTo(burning[i]) < pos.DistanceTo(burning[j])
+	})
+	firePos := burning[0]
+
+	// Phase 3: vessel with water in inventory → walk beside the fire
+	if vessel := findCarriedVesselWithWater(char); vessel != nil {
+		adjX, adjY := FindClosestCardinalTile(pos.X, pos.Y, firePos.X, firePos.Y, gameMap)
+		if adjX == -1 {
+			return nil
+		}
+		dest := types.Position{X: adjX, Y: adjY}
+		nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
+		setExtinguishActivity(char, "doing.moving_to_fire")
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           dest,
+			Action:         entity.ActionExtinguish,
+			TargetItem:     vessel,
+			TargetBuildPos: &firePos,
+		}
+	}
+
+	// Phase 2: empty vessel in inventory → fill at water source
+	if vessel := char.GetCarriedVessel(); vessel != nil {
+		if len(vessel.Container.Contents) > 0 {
+			return nil // Carrying food — don't dump it for the fire
+		}
+		waterPos, found := gameMap.FindNearestWater(pos)
+		if !found {
+			return nil
+		}
+		adjX, adjY := FindClosestCardinalTile(pos.X, pos.Y, waterPos.X, waterPos.Y, gameMap)
+		if adjX == -1 {
+			return nil
+		}
+		dest := types.Position{X: adjX, Y: adjY}
+		nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
+		setExtinguishActivity(char, "doing.fetching_water_for_fire")
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           dest,
+			Action:         entity.ActionExtinguish,
+			TargetItem:     vessel,
+			TargetBuildPos: &firePos,
+		}
+	}
+
+	// Phase 1: no vessel → procure a ground vessel, preferring one already holding water
+	if !char.HasInventorySpace() {
+		return nil
+	}
+	vessel := findGroundWaterVessel(pos, items)
+	if vessel == nil {
+		vessel = findEmptyGroundVessel(pos, items)
+	}
+	if vessel == nil {
+		return nil
+	}
+	vpos := vessel.Pos()
+	nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
+	setExtinguishActivity(char, "doing.getting_vessel_for_fire")
+	return &entity.Intent{
+		Target:         types.Position{X: nx, Y: ny},
+		Dest:           vpos,
+		Action:         entity.ActionExtinguish,
+		TargetItem:     vessel,
+		TargetBuildPos: &firePos,
+	}
+}
+
+// setExtinguishActivity updates the character's activity text for a firefighting phase
+func setExtinguishActivity(char *entity.Character, key string) {
+	if activity := i18n.T(key); char.CurrentActivity != activity {
+		char.CurrentActivity = activity
+	}
+}
+
+// ExtinguishFire pours one unit of water from the vessel onto the burning tile at firePos.
+// Returns false if nothing is burning there or the vessel is dry.
+func ExtinguishFire(gameMap *game.Map, firePos types.Position, char *entity.Character, vessel *entity.Item, log *ActionLog) bool {
+	if !gameMap.IsBurning(firePos) || vessel == nil || !vesselHasLiquid(vessel) {
+		return false
+	}
+	DrinkFromVessel(vessel)
+	gameMap.ExtinguishFire(firePos)
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.extinguished")
+	}
+	return true
+}
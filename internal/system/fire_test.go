@@ -0,0 +1,159 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestUpdateFires_CampfireBurnsOut(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.SetWeather(game.WeatherClear, game.WeatherClear, 1000)
+	fire := entity.NewCampfire(5, 5)
+	fire.AddFuel(10, config.CampfireMaxFuel)
+	gameMap.AddConstruct(fire)
+
+	UpdateFires(gameMap, 4, nil)
+	if !fire.IsLit() || fire.Fuel != 6 {
+		t.Fatalf("Expected the fire lit with 6s of fuel, got %.1f", fire.Fuel)
+	}
+	UpdateFires(gameMap, 10, nil)
+	if fire.IsLit() || fire.Fuel != 0 {
+		t.Errorf("Expected the fire to go out, got %.1f fuel", fire.Fuel)
+	}
+	if fire.MaterialColor != types.ColorGray {
+		t.Errorf("Expected a cold campfire to turn gray, got %s", fire.MaterialColor)
+	}
+}
+
+func TestUpdateFires_WetWeatherPutsFiresOut(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.SetWeather(game.WeatherStorm, game.WeatherClear, 1000)
+	fire := entity.NewCampfire(5, 5)
+	fire.AddFuel(config.CampfireMaxFuel, config.CampfireMaxFuel)
+	gameMap.AddConstruct(fire)
+	grass := types.Position{X: 1, Y: 1}
+	gameMap.AddItem(entity.NewGrass(grass.X, grass.Y))
+	gameMap.Ignite(grass)
+
+	UpdateFires(gameMap, 1, nil)
+	if fire.IsLit() {
+		t.Error("Expected the storm to put out the campfire")
+	}
+	if gameMap.IsBurning(grass) {
+		t.Error("Expected the storm to douse the wildfire")
+	}
+	if len(gameMap.ItemsAt(grass)) != 1 {
+		t.Error("Expected the doused grass to survive")
+	}
+}
+
+func TestBuildCampfire_BuildsFromMarkThenFeeds(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	gameMap.MarkForConstruction(pos, 0, "campfire", "")
+	char := entity.NewCharacter(1, 4, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	log := NewActionLog(10)
+
+	char.AddToInventory(entity.NewStick(0, 0))
+	if BuildCampfire(gameMap, pos, char, log) {
+		t.Fatal("Expected no campfire without grass for kindling")
+	}
+
+	char.AddToInventory(entity.NewGrass(0, 0))
+	if !BuildCampfire(gameMap, pos, char, log) {
+		t.Fatal("Expected the campfire to be built")
+	}
+	fire := gameMap.ConstructAt(pos)
+	if fire == nil || !fire.IsCampfire() || fire.Fuel != config.CampfireFuelPerStick {
+		t.Fatalf("Expected a campfire lit with one stick of fuel, got %+v", fire)
+	}
+	if _, marked := gameMap.GetConstructionMark(pos); marked {
+		t.Error("Expected the construction mark to be cleared")
+	}
+
+	char.AddToInventory(entity.NewStick(0, 0))
+	if !BuildCampfire(gameMap, pos, char, log) {
+		t.Fatal("Expected the campfire to be fed")
+	}
+	if fire.Fuel != 2*config.CampfireFuelPerStick {
+		t.Errorf("Expected %.0fs of fuel after feeding, got %.1f", 2*config.CampfireFuelPerStick, fire.Fuel)
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 2 || events[0].Key != "log.built" || events[1].Key != "log.fed_campfire" {
+		t.Errorf("Expected built then fed log entries, got %+v", events)
+	}
+}
+
+func TestCookItem_CookedFoodIsSaferAndMoreFilling(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	firePos := types.Position{X: 5, Y: 5}
+	fire := entity.NewCampfire(firePos.X, firePos.Y)
+	gameMap.AddConstruct(fire)
+	char := entity.NewCharacter(1, 4, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	berry := entity.NewBerry(0, 0, types.ColorRed, true, false)
+	char.AddToInventory(berry)
+	raw := itemSatiation(berry)
+
+	if CookItem(gameMap, firePos, char, nil) {
+		t.Fatal("Expected no cooking over a cold campfire")
+	}
+	fire.AddFuel(config.CampfireFuelPerStick, config.CampfireMaxFuel)
+	if !CookItem(gameMap, firePos, char, nil) {
+		t.Fatal("Expected the berry to be cooked")
+	}
+	if !berry.IsCooked() || berry.IsPoisonous() {
+		t.Error("Expected the berry cooked and no longer poisonous")
+	}
+	if got, want := itemSatiation(berry), raw*config.CookedSatiationMultiplier; got != want {
+		t.Errorf("Expected cooked satiation %.1f, got %.1f", want, got)
+	}
+	if CookItem(gameMap, firePos, char, nil) {
+		t.Error("Expected nothing left to cook")
+	}
+}
+
+func TestFindExtinguishIntent_TargetsNearestFire(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	near := types.Position{X: 6, Y: 5}
+	far := types.Position{X: 15, Y: 15}
+	for _, pos := range []types.Position{near, far} {
+		gameMap.AddItem(entity.NewGrass(pos.X, pos.Y))
+		gameMap.Ignite(pos)
+	}
+	char := entity.NewCharacter(1, 2, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	vessel := entity.NewVessel(0, 0, "hollow gourd", "gourd")
+	AddLiquidToVessel(vessel, createWaterVariety(), 1)
+	char.AddToInventory(vessel)
+
+	intent := findExtinguishIntent(char, char.Pos(), gameMap.Items(), gameMap)
+	if intent == nil || intent.Action != entity.ActionExtinguish {
+		t.Fatalf("Expected an extinguish intent, got %+v", intent)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != near || intent.TargetItem != vessel {
+		t.Errorf("Expected to douse %v with the carried vessel, got %+v", near, intent)
+	}
+
+	if !ExtinguishFire(gameMap, near, char, vessel, nil) {
+		t.Fatal("Expected the fire to be put out")
+	}
+	if gameMap.IsBurning(near) || vesselHasLiquid(vessel) {
+		t.Error("Expected the fire out and the vessel emptied")
+	}
+}
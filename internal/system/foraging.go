@@ -4,6 +4,7 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -173,22 +174,22 @@ func createForageIntent(char *entity.Character, pos types.Position, target *enti
 
 	// Vessels use "Picking up" text; food items use "Foraging"
 	isVessel := target.Container != nil
-	atVerb := "Foraging "
-	movingVerb := "Moving to forage "
-	logMsg := "Foraging for " + itemType
+	atKey := "doing.foraging"
+	movingKey := "doing.moving_to_forage"
+	logKey := "log.foraging_for"
 	if isVessel {
-		atVerb = "Picking up vessel for foraging "
-		movingVerb = "Moving to pick up vessel "
-		logMsg = "Picking up vessel for foraging"
+		atKey = "doing.picking_up_vessel_for_foraging"
+		movingKey = "doing.moving_to_pick_up_vessel_for_foraging"
+		logKey = "log.picking_up_vessel_for_foraging"
 	}
 
 	if pos.X == tx && pos.Y == ty {
 		// Already at target
-		newActivity := atVerb + target.Description()
+		newActivity := i18n.T(atKey, target.Description())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", logMsg)
+				log.AddMessage(char.ID, char.Name, "activity", logKey, entity.ItemDisplayName(itemType))
 			}
 		}
 		return &entity.Intent{
@@ -201,11 +202,11 @@ func createForageIntent(char *entity.Character, pos types.Position, target *enti
 
 	// Move toward target
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
-	newActivity := movingVerb + target.Description()
+	newActivity := i18n.T(movingKey, target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "activity", logMsg)
+			log.AddMessage(char.ID, char.Name, "activity", logKey, entity.ItemDisplayName(itemType))
 		}
 	}
 
@@ -289,7 +290,7 @@ func FindNextVesselTarget(char *entity.Character, cx, cy int, items []*entity.It
 		npos := nearest.Pos()
 		tx, ty := npos.X, npos.Y
 		if cx == tx && cy == ty {
-			char.CurrentActivity = "Foraging " + nearest.Description()
+			char.CurrentActivity = i18n.T("doing.foraging", nearest.Description())
 			return &entity.Intent{
 				Target:     types.Position{X: cx, Y: cy},
 				Dest:       types.Position{X: cx, Y: cy},
@@ -299,7 +300,7 @@ func FindNextVesselTarget(char *entity.Character, cx, cy int, items []*entity.It
 		}
 
 		nx, ny := NextStepBFS(cx, cy, tx, ty, gameMap)
-		char.CurrentActivity = "Moving to forage " + nearest.Description()
+		char.CurrentActivity = i18n.T("doing.moving_to_forage", nearest.Description())
 		return &entity.Intent{
 			Target:     types.Position{X: nx, Y: ny},
 			Dest:       types.Position{X: tx, Y: ty},
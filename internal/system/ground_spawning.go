@@ -1,42 +1,48 @@
 package system
 
 import (
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
 // GroundSpawnTimers holds independent timers for periodic ground item spawning.
-// Each item type (stick, nut, shell) spawns on its own random cycle.
+// Each item type (stick, nut, shell, stone) spawns on its own random cycle.
 type GroundSpawnTimers struct {
 	Stick float64
 	Nut   float64
 	Shell float64
+	Stone float64
 }
 
-// UpdateGroundSpawning decrements each ground spawn timer and spawns one item
-// when a timer fires. Each timer resets to a new random interval after firing.
+// UpdateGroundSpawning decrements each ground spawn timer (at its seasonal rate) and spawns
+// one item when a timer fires. Each timer resets to a new random interval after firing.
 func UpdateGroundSpawning(gameMap *game.Map, delta float64, timers *GroundSpawnTimers) {
-	timers.Stick -= delta
+	timers.Stick -= delta * groundSpawnRate("stick", gameMap)
 	if timers.Stick <= 0 {
 		timers.Stick = RandomGroundSpawnInterval()
 		spawnGroundItem(gameMap, "stick")
 	}
 
-	timers.Nut -= delta
+	timers.Nut -= delta * groundSpawnRate("nut", gameMap)
 	if timers.Nut <= 0 {
 		timers.Nut = RandomGroundSpawnInterval()
 		spawnGroundItem(gameMap, "nut")
 	}
 
-	timers.Shell -= delta
+	timers.Shell -= delta * groundSpawnRate("shell", gameMap)
 	if timers.Shell <= 0 {
 		timers.Shell = RandomGroundSpawnInterval()
 		spawnShell(gameMap)
 	}
+
+	timers.Stone -= delta * groundSpawnRate("stone", gameMap)
+	if timers.Stone <= 0 {
+		timers.Stone = RandomGroundSpawnInterval()
+		spawnStone(gameMap)
+	}
 }
 
 // RandomGroundSpawnInterval returns a randomized spawn interval for ground items.
@@ -44,7 +50,7 @@ func UpdateGroundSpawning(gameMap *game.Map, delta float64, timers *GroundSpawnT
 func RandomGroundSpawnInterval() float64 {
 	base := config.GroundSpawnInterval
 	variance := base * config.LifecycleIntervalVariance
-	return base + (rand.Float64()*2-1)*variance
+	return base + (rng.Float64()*2-1)*variance
 }
 
 // spawnGroundItem spawns one item of the given type on a random empty tile.
@@ -52,8 +58,8 @@ func RandomGroundSpawnInterval() float64 {
 func spawnGroundItem(gameMap *game.Map, itemType string) {
 	const maxAttempts = 10
 	for i := 0; i < maxAttempts; i++ {
-		x := rand.Intn(gameMap.Width)
-		y := rand.Intn(gameMap.Height)
+		x := rng.Intn(gameMap.Width)
+		y := rng.Intn(gameMap.Height)
 		pos := types.Position{X: x, Y: y}
 		if !gameMap.IsEmpty(pos) {
 			continue
@@ -77,7 +83,19 @@ func spawnShell(gameMap *game.Map) {
 		return
 	}
 
-	pos := tiles[rand.Intn(len(tiles))]
-	color := types.ShellColors[rand.Intn(len(types.ShellColors))]
+	pos := tiles[rng.Intn(len(tiles))]
+	color := types.ShellColors[rng.Intn(len(types.ShellColors))]
 	gameMap.AddItem(entity.NewShell(pos.X, pos.Y, color))
 }
+
+// spawnStone spawns one stone adjacent to a random rock outcrop tile.
+// Does nothing if no outcrops exist or no outcrop-adjacent tiles are available.
+func spawnStone(gameMap *game.Map) {
+	tiles := game.FindOutcropAdjacentEmptyTiles(gameMap)
+	if len(tiles) == 0 {
+		return
+	}
+
+	pos := tiles[rng.Intn(len(tiles))]
+	gameMap.AddItem(entity.NewStone(pos.X, pos.Y))
+}
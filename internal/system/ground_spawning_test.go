@@ -200,6 +200,48 @@ func TestUpdateGroundSpawning_NoShellsWithoutPonds(t *testing.T) {
 	}
 }
 
+func TestUpdateGroundSpawning_SpawnsStoneBesideOutcrop(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	gameMap.SetOutcrop(types.Position{X: 10, Y: 10})
+
+	timers := &GroundSpawnTimers{
+		Stick: config.GroundSpawnInterval * 2,
+		Nut:   config.GroundSpawnInterval * 2,
+		Shell: config.GroundSpawnInterval * 2,
+		Stone: 1.0,
+	}
+
+	UpdateGroundSpawning(gameMap, 2.0, timers)
+
+	items := gameMap.Items()
+	if len(items) != 1 || items[0].ItemType != "stone" {
+		t.Fatalf("Expected 1 stone, got %v", items)
+	}
+	if items[0].Pos().DistanceTo(types.Position{X: 10, Y: 10}) != 1 {
+		t.Errorf("Stone at %v is not beside the outcrop", items[0].Pos())
+	}
+}
+
+func TestUpdateGroundSpawning_NoStonesWithoutOutcrops(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	timers := &GroundSpawnTimers{
+		Stick: config.GroundSpawnInterval * 2,
+		Nut:   config.GroundSpawnInterval * 2,
+		Shell: config.GroundSpawnInterval * 2,
+		Stone: 1.0,
+	}
+
+	UpdateGroundSpawning(gameMap, 2.0, timers)
+
+	if len(gameMap.Items()) != 0 {
+		t.Error("Stones should not spawn without outcrops")
+	}
+}
+
 // =============================================================================
 // Independence: each type spawns on its own timer
 // =============================================================================
@@ -258,3 +300,26 @@ func TestRandomGroundSpawnInterval_InExpectedRange(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateGroundSpawning_NutsPeakInAutumn(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	timers := &GroundSpawnTimers{Stick: 1000, Nut: 1000, Shell: 1000}
+
+	gameMap.SetCalendar(game.SeasonAutumn, 0)
+	UpdateGroundSpawning(gameMap, 10, timers)
+	autumnDrop := 1000 - timers.Nut
+
+	timers.Nut = 1000
+	gameMap.SetCalendar(game.SeasonSummer, 0)
+	UpdateGroundSpawning(gameMap, 10, timers)
+	summerDrop := 1000 - timers.Nut
+
+	if autumnDrop <= summerDrop {
+		t.Errorf("Expected the nut timer to run faster in autumn (%.1f) than summer (%.1f)", autumnDrop, summerDrop)
+	}
+	if timers.Stick != 1000-20 {
+		t.Errorf("Expected sticks to spawn at the normal rate in every season, got timer %.1f", timers.Stick)
+	}
+}
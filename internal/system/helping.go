@@ -4,17 +4,18 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
 // selectHelpingActivity checks for nearby characters in crisis and creates an intent
 // to help them. Prioritizes thirst crisis over hunger crisis, with distance as the
-// primary selection criterion. Returns nil if no character is in crisis or helper
-// cannot assist.
+// primary selection criterion. With no one in crisis, a helper turns to putting out
+// wildfires. Returns nil if there is nothing the helper can assist with.
 func selectHelpingActivity(char *entity.Character, pos types.Position, items []*entity.Item, gameMap *game.Map, log *ActionLog) *entity.Intent {
 	needer := findNearestCrisisCharacter(char, gameMap.Characters())
 	if needer == nil {
-		return nil
+		return findExtinguishIntent(char, pos, items, gameMap)
 	}
 
 	if needer.ThirstTier() == entity.TierCrisis {
@@ -115,11 +116,11 @@ func findHelpWaterIntent(helper *entity.Character, needer *entity.Character, pos
 	// Carrying water vessel → deliver directly
 	if waterVessel != nil {
 		nx, ny := NextStepBFS(pos.X, pos.Y, npos.X, npos.Y, gameMap)
-		newActivity := "Bringing water to " + needer.Name
+		newActivity := i18n.T("doing.bringing_water_to", needer.Name)
 		if helper.CurrentActivity != newActivity {
 			helper.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(helper.ID, helper.Name, "activity", "Bringing water to "+needer.Name)
+				log.AddMessage(helper.ID, helper.Name, "activity", "log.help.bringing_water", needer.Name)
 			}
 		}
 		return &entity.Intent{
@@ -142,11 +143,11 @@ func findHelpWaterIntent(helper *entity.Character, needer *entity.Character, pos
 			return nil
 		}
 		nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
-		newActivity := "Fetching water for " + needer.Name
+		newActivity := i18n.T("doing.fetching_water_for", needer.Name)
 		if helper.CurrentActivity != newActivity {
 			helper.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(helper.ID, helper.Name, "activity", "Fetching water for "+needer.Name)
+				log.AddMessage(helper.ID, helper.Name, "activity", "log.help.fetching_water", needer.Name)
 			}
 		}
 		return &entity.Intent{
@@ -167,11 +168,11 @@ func findHelpWaterIntent(helper *entity.Character, needer *entity.Character, pos
 	if waterVessel := findGroundWaterVessel(pos, items); waterVessel != nil {
 		vpos := waterVessel.Pos()
 		nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-		newActivity := "Bringing water to " + needer.Name
+		newActivity := i18n.T("doing.bringing_water_to", needer.Name)
 		if helper.CurrentActivity != newActivity {
 			helper.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(helper.ID, helper.Name, "activity", "Picking up water for "+needer.Name)
+				log.AddMessage(helper.ID, helper.Name, "activity", "log.help.picking_up_water", needer.Name)
 			}
 		}
 		return &entity.Intent{
@@ -191,11 +192,11 @@ func findHelpWaterIntent(helper *entity.Character, needer *entity.Character, pos
 
 	vpos := groundVessel.Pos()
 	nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-	newActivity := "Fetching water for " + needer.Name
+	newActivity := i18n.T("doing.fetching_water_for", needer.Name)
 	if helper.CurrentActivity != newActivity {
 		helper.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(helper.ID, helper.Name, "activity", "Picking up vessel for "+needer.Name)
+			log.AddMessage(helper.ID, helper.Name, "activity", "log.help.picking_up_vessel", needer.Name)
 		}
 	}
 	return &entity.Intent{
@@ -329,11 +330,11 @@ func findHelpFeedIntent(helper *entity.Character, needer *entity.Character, pos
 	if bestCarried {
 		// Food already in inventory — go straight to delivery
 		nx, ny := NextStepBFS(pos.X, pos.Y, npos.X, npos.Y, gameMap)
-		newActivity := "Bringing food to " + needer.Name
+		newActivity := i18n.T("doing.bringing_food_to", needer.Name)
 		if helper.CurrentActivity != newActivity {
 			helper.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(helper.ID, helper.Name, "activity", "Bringing food to "+needer.Name)
+				log.AddMessage(helper.ID, helper.Name, "activity", "log.help.bringing_food", needer.Name)
 			}
 		}
 		return &entity.Intent{
@@ -348,11 +349,11 @@ func findHelpFeedIntent(helper *entity.Character, needer *entity.Character, pos
 	// Food is on the ground — procurement phase: walk to food
 	ipos := bestItem.Pos()
 	nx, ny := NextStepBFS(pos.X, pos.Y, ipos.X, ipos.Y, gameMap)
-	newActivity := "Getting food for " + needer.Name
+	newActivity := i18n.T("doing.getting_food_for", needer.Name)
 	if helper.CurrentActivity != newActivity {
 		helper.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(helper.ID, helper.Name, "activity", "Getting food for "+needer.Name)
+			log.AddMessage(helper.ID, helper.Name, "activity", "log.help.getting_food", needer.Name)
 		}
 	}
 	return &entity.Intent{
@@ -1,11 +1,10 @@
 package system
 
 import (
-	"fmt"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -18,10 +17,10 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 
 	// If frustrated, stay idle until timer expires
 	if char.IsFrustrated {
-		if char.CurrentActivity != "Frustrated" {
-			char.CurrentActivity = "Frustrated"
+		if char.CurrentActivity != i18n.T("doing.frustrated") {
+			char.CurrentActivity = i18n.T("doing.frustrated")
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Frustrated (can't meet needs)")
+				log.AddMessage(char.ID, char.Name, "activity", "log.frustrated")
 			}
 		}
 		return nil
@@ -30,11 +29,28 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 	cpos := char.Pos()
 	cx, cy := cpos.X, cpos.Y
 
+	// Threat perception runs ahead of needs: keep fleeing or shooing until it resolves,
+	// then check for new threats nearby
+	if char.Intent != nil && isThreatResponse(char.Intent.Action) {
+		if intent := continueThreatResponse(char, char.Intent, cpos, gameMap); intent != nil {
+			return intent
+		}
+	}
+	if intent := selectThreatResponse(char, cpos, gameMap, orders, log); intent != nil {
+		return intent
+	}
+
 	// Cache tier values (calculated once, reused throughout)
 	hungerTier := char.HungerTier()
 	thirstTier := char.ThirstTier()
-	energyTier := char.EnergyTier()
+	energyTier := sleepinessTier(char, gameMap)
 	healthTier := char.HealthTier()
+	warmthTier := char.WarmthTier()
+
+	// Storms send characters without urgent needs indoors
+	if intent, sheltering := selectStormShelter(char, cpos, gameMap, orders, log, max(hungerTier, thirstTier, energyTier, warmthTier)); sheltering {
+		return intent
+	}
 
 	// Check if we should continue a non-need activity (discretionary, orders, or helping)
 	// Non-need activities can be interrupted by urgent needs (tier >= Moderate)
@@ -50,6 +66,10 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 		if healthTier > maxTier && canFulfillHealth(char, items) {
 			maxTier = healthTier
 		}
+		// Warmth only counts if there is a hut to warm up in
+		if warmthTier > maxTier && canFulfillWarmth(gameMap, cpos) {
+			maxTier = warmthTier
+		}
 
 		// Continue looking/working if no urgent needs
 		if char.Intent.TargetItem != nil && maxTier < entity.TierModerate {
@@ -147,6 +167,8 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 					shouldReEval = true
 				} else if healthTier > currentDrivingTier && canFulfillHealth(char, items) {
 					shouldReEval = true
+				} else if warmthTier > currentDrivingTier && canFulfillWarmth(gameMap, cpos) {
+					shouldReEval = true
 				}
 			case types.StatThirst:
 				if hungerTier > currentDrivingTier && canFulfillHunger(char, items) {
@@ -155,6 +177,8 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 					shouldReEval = true
 				} else if healthTier > currentDrivingTier && canFulfillHealth(char, items) {
 					shouldReEval = true
+				} else if warmthTier > currentDrivingTier && canFulfillWarmth(gameMap, cpos) {
+					shouldReEval = true
 				}
 			case types.StatEnergy:
 				if hungerTier > currentDrivingTier && canFulfillHunger(char, items) {
@@ -163,6 +187,8 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 					shouldReEval = true
 				} else if healthTier > currentDrivingTier && canFulfillHealth(char, items) {
 					shouldReEval = true
+				} else if warmthTier > currentDrivingTier && canFulfillWarmth(gameMap, cpos) {
+					shouldReEval = true
 				}
 			case types.StatHealth:
 				if thirstTier > currentDrivingTier && canFulfillThirst(char, gameMap, cpos, items) {
@@ -171,8 +197,11 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 					shouldReEval = true
 				} else if energyTier > currentDrivingTier && canFulfillEnergy(char, gameMap, cpos) {
 					shouldReEval = true
+				} else if warmthTier > currentDrivingTier && canFulfillWarmth(gameMap, cpos) {
+					shouldReEval = true
 				}
 			default:
+				// Includes warmth: the walk into a hut is cheap to recalculate every tick
 				shouldReEval = true
 			}
 		}
@@ -206,6 +235,10 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 	if healthTier > maxTier && canFulfillHealth(char, items) {
 		maxTier = healthTier
 	}
+	// Warmth only counts if there is a hut to warm up in
+	if warmthTier > maxTier && canFulfillWarmth(gameMap, cpos) {
+		maxTier = warmthTier
+	}
 
 	// --- New intent from scratch ---
 
@@ -238,7 +271,7 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 	} else if maxTier > entity.TierNone {
 		// Has needs (Moderate+, or Mild without order): try priority loop
 
-		// Build priority list: stats with needs, sorted by tier (desc), then tie-breaker (Thirst > Hunger > Health > Energy)
+		// Build priority list: stats with needs, sorted by tier (desc), then tie-breaker (Thirst > Hunger > Health > Warmth > Energy)
 		type statPriority struct {
 			stat types.StatType
 			tier int
@@ -256,12 +289,16 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 		if healthTier > 0 && canFulfillHealth(char, items) {
 			priorities = append(priorities, statPriority{types.StatHealth, healthTier})
 		}
+		// Warmth only added if there is a hut to warm up in
+		if warmthTier > 0 && canFulfillWarmth(gameMap, cpos) {
+			priorities = append(priorities, statPriority{types.StatWarmth, warmthTier})
+		}
 		if energyTier > 0 {
 			priorities = append(priorities, statPriority{types.StatEnergy, energyTier})
 		}
 
 		// Sort by tier descending (higher tier = more urgent)
-		// Tie-breaker order is already correct since we added in Thirst > Hunger > Health > Energy order
+		// Tie-breaker order is already correct since we added in Thirst > Hunger > Health > Warmth > Energy order
 		for i := 0; i < len(priorities)-1; i++ {
 			for j := i + 1; j < len(priorities); j++ {
 				if priorities[j].tier > priorities[i].tier {
@@ -280,6 +317,8 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 				intent = findFoodIntent(char, cpos, items, p.tier, log, gameMap)
 			case types.StatHealth:
 				intent = findHealingIntent(char, cpos, items, p.tier, log, gameMap)
+			case types.StatWarmth:
+				intent = findWarmthIntent(char, cpos, gameMap, p.tier, log)
 			case types.StatEnergy:
 				intent = findSleepIntent(char, cpos, gameMap, p.tier, log)
 			}
@@ -305,9 +344,9 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 				char.IsFrustrated = true
 				char.FrustrationTimer = config.FrustrationDuration
 				char.FailedIntentCount = 0
-				char.CurrentActivity = "Frustrated"
+				char.CurrentActivity = i18n.T("doing.frustrated")
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "Frustrated (can't meet needs)")
+					log.AddMessage(char.ID, char.Name, "activity", "log.frustrated")
 				}
 				return nil
 			}
@@ -329,23 +368,23 @@ func CalculateIntent(char *entity.Character, items []*entity.Item, gameMap *game
 	// Terminal state — Mild needs aren't urgent enough to be "stuck"
 	// Need-failure activities (e.g. "No bed available") are set by find*Intent functions
 	// with their own guards. Don't overwrite them with generic "Stuck".
-	needFailureActivity := char.CurrentActivity == "No bed available" ||
-		char.CurrentActivity == "No water source available" ||
-		char.CurrentActivity == "No suitable food available"
+	needFailureActivity := char.CurrentActivity == i18n.T("doing.no_bed") ||
+		char.CurrentActivity == i18n.T("doing.no_water") ||
+		char.CurrentActivity == i18n.T("doing.no_food")
 	if maxTier >= entity.TierModerate {
 		if !needFailureActivity {
-			if char.CurrentActivity != "Stuck" {
-				char.CurrentActivity = "Stuck"
+			if char.CurrentActivity != i18n.T("doing.stuck") {
+				char.CurrentActivity = i18n.T("doing.stuck")
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "Stuck (can't meet needs)")
+					log.AddMessage(char.ID, char.Name, "activity", "log.stuck")
 				}
 			}
 		}
 	} else {
-		if char.CurrentActivity != "Idle" {
-			char.CurrentActivity = "Idle"
+		if char.CurrentActivity != i18n.T("doing.idle") {
+			char.CurrentActivity = i18n.T("doing.idle")
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Idle")
+				log.AddMessage(char.ID, char.Name, "activity", "log.idle")
 			}
 		}
 	}
@@ -422,11 +461,17 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 		return intent
 	}
 
+	// ActionExtinguish follows the ActionWaterGarden phases below, toward a fire rather than a
+	// dry tile, and stops as soon as the fire is out
+	if intent.Action == entity.ActionExtinguish && (intent.TargetBuildPos == nil || !gameMap.IsBurning(*intent.TargetBuildPos)) {
+		return nil
+	}
+
 	// ActionWaterGarden has three phases:
 	// Phase 1: TargetItem is on the map (ground vessel) — move toward it for pickup
 	// Phase 2: TargetItem is in inventory (empty vessel) — move toward water Dest for filling
 	// Phase 3: TargetItem is in inventory (vessel with water) — move toward dry tile Dest for watering
-	if intent.Action == entity.ActionWaterGarden {
+	if intent.Action == entity.ActionWaterGarden || intent.Action == entity.ActionExtinguish {
 		if intent.TargetItem != nil {
 			ipos := intent.TargetItem.Pos()
 			if gameMap.HasItemOnMap(intent.TargetItem) {
@@ -652,11 +697,11 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 
 	// Check if we've arrived at a ground vessel for drinking - switch to drink action
 	if intent.TargetItem != nil && intent.DrivingStat == types.StatThirst && cx == tx && cy == ty {
-		newActivity := "Drinking"
+		newActivity := i18n.T("doing.drinking")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "thirst", "Drinking from vessel")
+				log.AddMessage(char.ID, char.Name, "thirst", "log.drink.vessel")
 			}
 		}
 		return &entity.Intent{
@@ -673,11 +718,11 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 	if intent.TargetItem != nil && intent.DrivingStat == types.StatHunger && cx == tx && cy == ty {
 		item := intent.TargetItem
 		if item.Container != nil && len(item.Container.Contents) > 0 && item.Container.Contents[0].Variety.IsEdible() {
-			newActivity := "Eating " + item.Container.Contents[0].Variety.Description() + " from vessel"
+			newActivity := i18n.T("doing.eating_from_vessel", item.Container.Contents[0].Variety.Description())
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "hunger", fmt.Sprintf("Eating from ground vessel"))
+					log.AddMessage(char.ID, char.Name, "hunger", "log.eat.ground_vessel")
 				}
 			}
 			return &entity.Intent{
@@ -694,12 +739,11 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 	// Check if we've arrived at a water target - switch to drink action
 	if intent.TargetWaterPos != nil {
 		if isCardinallyAdjacent(cx, cy, tx, ty) {
-			newActivity := "Drinking"
+			newActivity := i18n.T("doing.drinking")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "thirst",
-						fmt.Sprintf("Drinking from %s", waterSourceName(gameMap, *intent.TargetWaterPos)))
+					log.AddMessage(char.ID, char.Name, "thirst", "log.drink.source", waterSourceName(gameMap, *intent.TargetWaterPos))
 				}
 			}
 			return &entity.Intent{
@@ -725,7 +769,7 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 
 		// Beds are passable - arrive when at the feature position
 		if feature.IsBed() && cx == tx && cy == ty {
-			newActivity := "Sleeping (in bed)"
+			newActivity := i18n.T("doing.sleeping_in_bed")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 			}
@@ -743,7 +787,7 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 	// Check if we've arrived adjacent to an item for looking
 	if intent.Action == entity.ActionLook && intent.TargetItem != nil {
 		if isAdjacent(cx, cy, tx, ty) {
-			newActivity := "Looking at " + intent.TargetItem.Description()
+			newActivity := i18n.T("doing.looking_at", intent.TargetItem.Description())
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 			}
@@ -776,7 +820,7 @@ func continueIntent(char *entity.Character, cx, cy int, gameMap *game.Map, log *
 	// Check if we've arrived adjacent to a construct for looking
 	if intent.Action == entity.ActionLook && intent.TargetConstruct != nil {
 		if isAdjacent(cx, cy, tx, ty) {
-			newActivity := "Looking at " + intent.TargetConstruct.DisplayName()
+			newActivity := i18n.T("doing.looking_at", intent.TargetConstruct.DisplayName())
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 			}
@@ -857,11 +901,15 @@ func vesselHasLiquid(item *entity.Item) bool {
 func waterSourceName(gameMap *game.Map, waterPos types.Position) string {
 	switch gameMap.WaterAt(waterPos) {
 	case game.WaterSpring:
-		return "spring"
+		return i18n.T("water.spring")
 	case game.WaterPond:
-		return "pond"
+		return i18n.T("water.pond")
+	case game.WaterRiver:
+		return i18n.T("water.river")
+	case game.WaterChannel:
+		return i18n.T("water.channel")
 	default:
-		return "water"
+		return i18n.T("water.other")
 	}
 }
 
@@ -917,11 +965,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 	// No water source found
 	if best == nil {
 		if tier >= entity.TierModerate {
-			newActivity := "No water source available"
+			newActivity := i18n.T("doing.no_water")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "No water source available")
+					log.AddMessage(char.ID, char.Name, "activity", "log.no_water")
 				}
 			}
 		}
@@ -932,11 +980,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 	switch best.kind {
 	case "carried":
 		// Distance 0 — drink immediately from carried vessel
-		newActivity := "Drinking"
+		newActivity := i18n.T("doing.drinking")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "thirst", "Drinking from vessel")
+				log.AddMessage(char.ID, char.Name, "thirst", "log.drink.vessel")
 			}
 		}
 		return &entity.Intent{
@@ -952,11 +1000,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 		vpos := best.vessel.Pos()
 		if pos.X == vpos.X && pos.Y == vpos.Y {
 			// Already at ground vessel — drink in place
-			newActivity := "Drinking"
+			newActivity := i18n.T("doing.drinking")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "thirst", "Drinking from vessel")
+					log.AddMessage(char.ID, char.Name, "thirst", "log.drink.vessel")
 				}
 			}
 			return &entity.Intent{
@@ -970,11 +1018,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 		}
 		// Move toward ground vessel
 		nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-		newActivity := "Moving to water"
+		newActivity := i18n.T("doing.moving_to_water")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "movement", "Heading to water")
+				log.AddMessage(char.ID, char.Name, "movement", "log.heading_to_water")
 			}
 		}
 		return &entity.Intent{
@@ -990,12 +1038,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 		wp := best.waterPos
 		if isCardinallyAdjacent(pos.X, pos.Y, wp.X, wp.Y) {
 			// Already cardinally adjacent — drink from terrain
-			newActivity := "Drinking"
+			newActivity := i18n.T("doing.drinking")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "thirst",
-						fmt.Sprintf("Drinking from %s", waterSourceName(gameMap, wp)))
+					log.AddMessage(char.ID, char.Name, "thirst", "log.drink.source", waterSourceName(gameMap, wp))
 				}
 			}
 			return &entity.Intent{
@@ -1009,11 +1056,11 @@ func findDrinkIntent(char *entity.Character, pos types.Position, gameMap *game.M
 		}
 		// Move toward cardinal-adjacent tile
 		nx, ny := NextStepBFS(pos.X, pos.Y, best.adjX, best.adjY, gameMap)
-		newActivity := "Moving to water"
+		newActivity := i18n.T("doing.moving_to_water")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "movement", "Heading to water")
+				log.AddMessage(char.ID, char.Name, "movement", "log.heading_to_water")
 			}
 		}
 		return &entity.Intent{
@@ -1035,11 +1082,11 @@ func findFoodIntent(char *entity.Character, pos types.Position, items []*entity.
 	result := FindFoodTarget(char, items)
 	if result.Item == nil {
 		if tier >= entity.TierModerate {
-			newActivity := "No suitable food available"
+			newActivity := i18n.T("doing.no_food")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "No suitable food available")
+					log.AddMessage(char.ID, char.Name, "activity", "log.no_food")
 				}
 			}
 		}
@@ -1053,9 +1100,8 @@ func findFoodIntent(char *entity.Character, pos types.Position, items []*entity.
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity",
-					fmt.Sprintf("Eating from inventory (pref:%d score:%.0f)",
-						result.NetPreference, result.GradientScore))
+				log.AddMessage(char.ID, char.Name, "activity", "log.eat.inventory_scored",
+					result.NetPreference, result.GradientScore)
 			}
 		}
 		return &entity.Intent{
@@ -1073,13 +1119,12 @@ func findFoodIntent(char *entity.Character, pos types.Position, items []*entity.
 	tx, ty := ipos.X, ipos.Y
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
 
-	newActivity := "Moving to " + result.Item.Description()
+	newActivity := i18n.T("doing.moving_to", result.Item.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement",
-				fmt.Sprintf("Started moving to %s (pref:%d score:%.0f)",
-					result.Item.Description(), result.NetPreference, result.GradientScore))
+			log.AddMessage(char.ID, char.Name, "movement", "log.moving_to_food",
+				result.Item.Description(), result.NetPreference, result.GradientScore)
 		}
 	}
 
@@ -1101,7 +1146,7 @@ func findHealingIntent(char *entity.Character, pos types.Position, items []*enti
 	knownHealing := char.KnownHealingItems(items)
 	if len(knownHealing) == 0 {
 		if log != nil {
-			log.Add(char.ID, char.Name, "activity", "No known healing items available")
+			log.AddMessage(char.ID, char.Name, "activity", "log.no_healing")
 		}
 		return nil
 	}
@@ -1127,12 +1172,11 @@ func findHealingIntent(char *entity.Character, pos types.Position, items []*enti
 	tx, ty := npos.X, npos.Y
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
 
-	newActivity := "Moving to " + nearest.Description() + " (healing)"
+	newActivity := i18n.T("doing.moving_to_heal", nearest.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement",
-				fmt.Sprintf("Seeking healing: %s", nearest.Description()))
+			log.AddMessage(char.ID, char.Name, "movement", "log.seeking_healing", nearest.Description())
 		}
 	}
 
@@ -1150,10 +1194,10 @@ func findHealingIntent(char *entity.Character, pos types.Position, items []*enti
 func findSleepIntent(char *entity.Character, pos types.Position, gameMap *game.Map, tier int, log *ActionLog) *entity.Intent {
 	bed := gameMap.FindNearestBed(pos)
 
-	// If no bed, can sleep on ground when exhausted (voluntary) or collapsed (involuntary)
+	// If no bed, can sleep on ground when exhausted or turning in for the night (voluntary) or collapsed (involuntary)
 	if bed == nil {
-		if char.Energy <= 10 { // Exhausted - ground sleep available
-			newActivity := "Sleeping (on ground)"
+		if canSleepOnGround(char, gameMap) {
+			newActivity := i18n.T("doing.sleeping_on_ground")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 			}
@@ -1168,11 +1212,11 @@ func findSleepIntent(char *entity.Character, pos types.Position, gameMap *game.M
 		// Not tired enough for ground sleep, need a bed
 		if tier >= entity.TierModerate {
 			// Moderate+ — too tired for idle activities, wait for a bed
-			newActivity := "No bed available"
+			newActivity := i18n.T("doing.no_bed")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "No bed available")
+					log.AddMessage(char.ID, char.Name, "activity", "log.no_bed")
 				}
 			}
 		}
@@ -1184,7 +1228,7 @@ func findSleepIntent(char *entity.Character, pos types.Position, gameMap *game.M
 
 	// Already at bed - sleep
 	if pos.X == tx && pos.Y == ty {
-		newActivity := "Sleeping (in bed)"
+		newActivity := i18n.T("doing.sleeping_in_bed")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -1200,11 +1244,11 @@ func findSleepIntent(char *entity.Character, pos types.Position, gameMap *game.M
 
 	// Move toward bed
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
-	newActivity := "Moving to leaf pile"
+	newActivity := i18n.T("doing.moving_to_leaf_pile")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement", "Heading to leaf pile")
+			log.AddMessage(char.ID, char.Name, "movement", "log.heading_to_leaf_pile")
 		}
 	}
 
@@ -1410,10 +1454,10 @@ func canFulfillHunger(char *entity.Character, items []*entity.Item) bool {
 	return FindFoodTarget(char, items).Item != nil
 }
 
-// canFulfillEnergy checks if energy can be addressed (bed exists or exhausted enough for ground sleep)
+// canFulfillEnergy checks if energy can be addressed (bed exists or tired enough for ground sleep)
 func canFulfillEnergy(char *entity.Character, gameMap *game.Map, pos types.Position) bool {
-	// Can sleep on ground if exhausted (voluntary at ≤10, involuntary collapse at 0)
-	if char.Energy <= 10 {
+	// Can sleep on ground if exhausted (voluntary at ≤10, involuntary collapse at 0) or at bedtime
+	if canSleepOnGround(char, gameMap) {
 		return true
 	}
 	// Otherwise need a bed
@@ -1459,11 +1503,11 @@ func buildLookIntentForItem(char *entity.Character, pos types.Position, target *
 	tx, ty := tpos.X, tpos.Y
 
 	if isAdjacent(pos.X, pos.Y, tx, ty) {
-		newActivity := "Looking at " + target.Description()
+		newActivity := i18n.T("doing.looking_at", target.Description())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Looking at "+target.Description())
+				log.AddMessage(char.ID, char.Name, "activity", "log.looking_at", target.Description())
 			}
 		}
 		return &entity.Intent{
@@ -1481,11 +1525,11 @@ func buildLookIntentForItem(char *entity.Character, pos types.Position, target *
 
 	nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
 
-	newActivity := "Moving to look at " + target.Description()
+	newActivity := i18n.T("doing.moving_to_look_at", target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement", "Moving to look at "+target.Description())
+			log.AddMessage(char.ID, char.Name, "movement", "log.moving_to_look_at", target.Description())
 		}
 	}
 
@@ -1503,11 +1547,11 @@ func buildLookIntentForConstruct(char *entity.Character, pos types.Position, tar
 	tx, ty := tpos.X, tpos.Y
 
 	if isAdjacent(pos.X, pos.Y, tx, ty) {
-		newActivity := "Looking at " + target.DisplayName()
+		newActivity := i18n.T("doing.looking_at", target.DisplayName())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Looking at "+target.DisplayName())
+				log.AddMessage(char.ID, char.Name, "activity", "log.looking_at", target.DisplayName())
 			}
 		}
 		return &entity.Intent{
@@ -1525,11 +1569,11 @@ func buildLookIntentForConstruct(char *entity.Character, pos types.Position, tar
 
 	nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
 
-	newActivity := "Moving to look at " + target.DisplayName()
+	newActivity := i18n.T("doing.moving_to_look_at", target.DisplayName())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement", "Moving to look at "+target.DisplayName())
+			log.AddMessage(char.ID, char.Name, "movement", "log.moving_to_look_at", target.DisplayName())
 		}
 	}
 
@@ -1602,11 +1646,11 @@ func findNearestItemExcluding(cx, cy int, items []*entity.Item, excludeX, exclud
 func findCarriedDrinkIntent(char *entity.Character, pos types.Position, tier int, log *ActionLog) *entity.Intent {
 	for _, item := range char.Inventory {
 		if vesselHasLiquid(item) {
-			newActivity := "Drinking"
+			newActivity := i18n.T("doing.drinking")
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "thirst", "Drinking from vessel")
+					log.AddMessage(char.ID, char.Name, "thirst", "log.drink.vessel")
 				}
 			}
 			return &entity.Intent{
@@ -1639,7 +1683,7 @@ func findCarriedFoodIntent(char *entity.Character, pos types.Position, tier int,
 				if char.CurrentActivity != newActivity {
 					char.CurrentActivity = newActivity
 					if log != nil {
-						log.Add(char.ID, char.Name, "activity", newActivity)
+						log.AddMessage(char.ID, char.Name, "activity", "doing.eating_from_vessel", variety.Description())
 					}
 				}
 				return &entity.Intent{
@@ -1654,11 +1698,11 @@ func findCarriedFoodIntent(char *entity.Character, pos types.Position, tier int,
 		}
 		// Check loose edible item
 		if item.IsEdible() {
-			newActivity := "Eating carried " + item.Description()
+			newActivity := i18n.T("doing.eating_carried", item.Description())
 			if char.CurrentActivity != newActivity {
 				char.CurrentActivity = newActivity
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity", "Eating from inventory")
+					log.AddMessage(char.ID, char.Name, "activity", "log.eat.inventory")
 				}
 			}
 			return &entity.Intent{
@@ -1679,7 +1723,7 @@ func findCarriedFoodIntent(char *entity.Character, pos types.Position, tier int,
 // For loose items, names the item ("Eating carried Red Berry").
 func eatingActivityName(item *entity.Item) string {
 	if item.Container != nil && len(item.Container.Contents) > 0 {
-		return "Eating " + item.Container.Contents[0].Variety.Description() + " from vessel"
+		return i18n.T("doing.eating_from_vessel", item.Container.Contents[0].Variety.Description())
 	}
-	return "Eating carried " + item.Description()
+	return i18n.T("doing.eating_carried", item.Description())
 }
@@ -1,9 +1,8 @@
 package system
 
 import (
-	"math/rand"
-
 	"petri/internal/entity"
+	"petri/internal/rng"
 )
 
 // LearnKnowledgeWithEffects teaches knowledge to a character and applies all side effects.
@@ -19,7 +18,7 @@ func LearnKnowledgeWithEffects(char *entity.Character, knowledge entity.Knowledg
 
 	// Log the learning
 	if log != nil {
-		log.Add(char.ID, char.Name, "learning", "Learned something!")
+		log.AddMessage(char.ID, char.Name, "learning", "log.learned_something")
 	}
 
 	// Side effect: poison knowledge creates dislike preference
@@ -69,11 +68,11 @@ func TransmitKnowledge(char1, char2 *entity.Character, log *ActionLog) {
 	var k2 *entity.Knowledge
 
 	if len(char1.Knowledge) > 0 {
-		idx := rand.Intn(len(char1.Knowledge))
+		idx := rng.Intn(len(char1.Knowledge))
 		k1 = &char1.Knowledge[idx]
 	}
 	if len(char2.Knowledge) > 0 {
-		idx := rand.Intn(len(char2.Knowledge))
+		idx := rng.Intn(len(char2.Knowledge))
 		k2 = &char2.Knowledge[idx]
 	}
 
@@ -91,8 +90,8 @@ func teachKnowledge(sharer, learner *entity.Character, knowledge entity.Knowledg
 	if LearnKnowledgeWithEffects(learner, knowledge, log) {
 		// Successfully learned - log sharing
 		if log != nil {
-			log.Add(sharer.ID, sharer.Name, "knowledge", "Shared knowledge with "+learner.Name)
-			log.Add(learner.ID, learner.Name, "knowledge", "Learned: "+knowledge.Description())
+			log.AddMessage(sharer.ID, sharer.Name, "knowledge", "log.knowledge.shared", learner.Name)
+			log.AddMessage(learner.ID, learner.Name, "knowledge", "log.knowledge.learned", knowledge.Description())
 		}
 	}
 }
@@ -1,11 +1,10 @@
 package system
 
 import (
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
@@ -28,11 +27,11 @@ func MatureSymbol(itemType string) rune {
 }
 
 // effectiveDelta calculates the growth-adjusted delta for an item position,
-// applying tilled and wet multipliers when applicable
+// applying tilled (scaled by soil fertility) and wet multipliers when applicable
 func effectiveDelta(delta float64, pos types.Position, gameMap *game.Map) float64 {
 	d := delta
 	if gameMap.IsTilled(pos) {
-		d *= config.TilledGrowthMultiplier
+		d *= config.TilledGrowthMultiplier * gameMap.Fertility(pos)
 	}
 	if gameMap.IsWet(pos) {
 		d *= config.WetGrowthMultiplier
@@ -40,6 +39,38 @@ func effectiveDelta(delta float64, pos types.Position, gameMap *game.Map) float6
 	return d
 }
 
+// seasonKeys returns the keys to look up the current season under, most specific first:
+// "late_" + season in the second half of a season, then the season itself
+func seasonKeys(gameMap *game.Map) []string {
+	season := gameMap.Season().ID()
+	if gameMap.IsLateSeason() {
+		return []string{"late_" + season, season}
+	}
+	return []string{season}
+}
+
+// seasonRates returns how fast an item type's lifecycle timers run in the current season
+func seasonRates(itemType string, gameMap *game.Map) config.SeasonRates {
+	seasons := config.ItemLifecycle[itemType].Seasons
+	for _, key := range seasonKeys(gameMap) {
+		if rates, ok := seasons[key]; ok {
+			return rates
+		}
+	}
+	return config.SeasonRates{Spawn: 1, Sprout: 1, Death: 1}
+}
+
+// groundSpawnRate returns how fast an item type's ground spawn timer runs in the current season
+func groundSpawnRate(itemType string, gameMap *game.Map) float64 {
+	seasons := config.GroundSpawnSeasons[itemType]
+	for _, key := range seasonKeys(gameMap) {
+		if rate, ok := seasons[key]; ok {
+			return rate
+		}
+	}
+	return 1
+}
+
 // UpdateSproutTimers decrements sprout timers and matures sprouts when timers expire
 func UpdateSproutTimers(gameMap *game.Map, initialItemCount int, delta float64) {
 	for _, item := range gameMap.Items() {
@@ -48,7 +79,7 @@ func UpdateSproutTimers(gameMap *game.Map, initialItemCount int, delta float64)
 		}
 
 		pos := item.Pos()
-		item.Plant.SproutTimer -= effectiveDelta(delta, pos, gameMap)
+		item.Plant.SproutTimer -= effectiveDelta(delta, pos, gameMap) * seasonRates(item.ItemType, gameMap).Sprout
 
 		if item.Plant.SproutTimer <= 0 {
 			// Mature the sprout
@@ -91,7 +122,7 @@ func UpdateSpawnTimers(gameMap *game.Map, initialItemCount int, delta float64) {
 				continue
 			}
 			pos := item.Pos()
-			item.Plant.SpawnTimer -= effectiveDelta(delta, pos, gameMap)
+			item.Plant.SpawnTimer -= effectiveDelta(delta, pos, gameMap) * seasonRates(item.ItemType, gameMap).Spawn
 			if item.Plant.SpawnTimer <= 0 {
 				item.Plant.SpawnTimer = CalculateSpawnInterval(item.ItemType, initialItemCount)
 			}
@@ -107,14 +138,14 @@ func UpdateSpawnTimers(gameMap *game.Map, initialItemCount int, delta float64) {
 		}
 
 		pos := item.Pos()
-		item.Plant.SpawnTimer -= effectiveDelta(delta, pos, gameMap)
+		item.Plant.SpawnTimer -= effectiveDelta(delta, pos, gameMap) * seasonRates(item.ItemType, gameMap).Spawn
 
 		if item.Plant.SpawnTimer <= 0 {
 			// Reset timer regardless of spawn success
 			item.Plant.SpawnTimer = CalculateSpawnInterval(item.ItemType, initialItemCount)
 
 			// Roll for spawn chance
-			if rand.Float64() >= config.ItemSpawnChance {
+			if rng.Float64() >= config.ItemSpawnChance {
 				continue
 			}
 
@@ -150,15 +181,18 @@ func UpdateDeathTimers(gameMap *game.Map, delta float64) {
 			continue
 		}
 
-		item.DeathTimer -= delta
+		item.DeathTimer -= delta * seasonRates(item.ItemType, gameMap).Death
 
 		if item.DeathTimer <= 0 {
 			toRemove = append(toRemove, item)
 		}
 	}
 
-	// Remove dead items
+	// Remove dead items; plants that die where they grew compost the tilled soil under them
 	for _, item := range toRemove {
+		if item.Plant != nil && item.Plant.IsGrowing {
+			gameMap.CompostSoil(item.Pos(), config.SpentPlantCompostFertility)
+		}
 		gameMap.RemoveItem(item)
 	}
 }
@@ -186,10 +220,10 @@ func CalculateSpawnInterval(itemType string, initialItemCount int) float64 {
 		cfg = config.LifecycleConfig{SpawnInterval: 3.0}
 	}
 
-	base := cfg.SpawnInterval * float64(initialItemCount)
+	base := cfg.SpawnSeconds() * float64(initialItemCount)
 	variance := base * config.LifecycleIntervalVariance
 	// Random value in range [base - variance, base + variance]
-	return base + (rand.Float64()*2-1)*variance
+	return base + (rng.Float64()*2-1)*variance
 }
 
 // CalculateDeathInterval returns a randomized death interval for an item type
@@ -203,7 +237,7 @@ func CalculateDeathInterval(itemType string, initialItemCount int) float64 {
 	base := cfg.DeathInterval * float64(initialItemCount)
 	variance := base * config.LifecycleIntervalVariance
 	// Random value in range [base - variance, base + variance]
-	return base + (rand.Float64()*2-1)*variance
+	return base + (rng.Float64()*2-1)*variance
 }
 
 // FindEmptyAdjacent finds a random empty adjacent tile (8-directional).
@@ -216,7 +250,7 @@ func FindEmptyAdjacent(x, y int, gameMap *game.Map) (int, int, bool) {
 	}
 
 	// Shuffle directions for randomness
-	rand.Shuffle(len(directions), func(i, j int) {
+	rng.Shuffle(len(directions), func(i, j int) {
 		directions[i], directions[j] = directions[j], directions[i]
 	})
 
@@ -22,7 +22,7 @@ func TestCalculateSpawnInterval_ReturnsValueInExpectedRange(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		interval := CalculateSpawnInterval("berry", initialItemCount)
 
-		base := config.ItemLifecycle["berry"].SpawnInterval * float64(initialItemCount)
+		base := config.ItemLifecycle["berry"].SpawnSeconds() * float64(initialItemCount)
 		variance := base * config.LifecycleIntervalVariance
 		minExpected := base - variance
 		maxExpected := base + variance
@@ -221,7 +221,7 @@ func TestUpdateSpawnTimers_ResetsTimerWhenExpired(t *testing.T) {
 	UpdateSpawnTimers(gameMap, initialItemCount, 1.0)
 
 	// Timer should have been reset to a new interval
-	base := config.ItemLifecycle["berry"].SpawnInterval * float64(initialItemCount)
+	base := config.ItemLifecycle["berry"].SpawnSeconds() * float64(initialItemCount)
 	variance := base * config.LifecycleIntervalVariance
 
 	if item.Plant.SpawnTimer < base-variance || item.Plant.SpawnTimer > base+variance {
@@ -546,7 +546,7 @@ func TestUpdateSproutTimers_MaturePlantHasSpawnTimer(t *testing.T) {
 	initialItemCount := 40
 	UpdateSproutTimers(gameMap, initialItemCount, 2.0)
 
-	base := config.ItemLifecycle["berry"].SpawnInterval * float64(initialItemCount)
+	base := config.ItemLifecycle["berry"].SpawnSeconds() * float64(initialItemCount)
 	variance := base * config.LifecycleIntervalVariance
 
 	if sprout.Plant.SpawnTimer < base-variance || sprout.Plant.SpawnTimer > base+variance {
@@ -1003,3 +1003,68 @@ func TestUpdateSeedTimers_SkipsSprouts(t *testing.T) {
 		t.Errorf("Sprout SeedTimer should be unchanged, got %.2f", sprout.Plant.SeedTimer)
 	}
 }
+
+// =============================================================================
+// Seasons
+// =============================================================================
+
+// setSeason moves the map's calendar to the start (or, if late, the second half) of a season
+func setSeason(gameMap *game.Map, season game.Season, late bool) {
+	elapsed := 0.0
+	if late {
+		elapsed = config.SeasonLengthDays * config.WorldDaySeconds * 0.75
+	}
+	gameMap.SetCalendar(season, elapsed)
+}
+
+func TestUpdateSpawnTimers_GourdsOnlyFruitInLateSummer(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gourd := entity.NewGourd(5, 5, types.ColorGreen, types.PatternNone, types.TextureNone, false, false)
+	gourd.Plant.SpawnTimer = 100.0
+	gameMap.AddItem(gourd)
+
+	setSeason(gameMap, game.SeasonSummer, false)
+	UpdateSpawnTimers(gameMap, 40, 10.0)
+	if gourd.Plant.SpawnTimer != 100.0 {
+		t.Errorf("Early summer: gourd SpawnTimer got %.2f, want it paused at 100", gourd.Plant.SpawnTimer)
+	}
+
+	setSeason(gameMap, game.SeasonSummer, true)
+	UpdateSpawnTimers(gameMap, 40, 10.0)
+	want := 100.0 - 10.0*config.ItemLifecycle["gourd"].Seasons["late_summer"].Spawn
+	if gourd.Plant.SpawnTimer != want {
+		t.Errorf("Late summer: gourd SpawnTimer got %.2f, want %.2f", gourd.Plant.SpawnTimer, want)
+	}
+}
+
+func TestUpdateDeathTimers_FlowersDieBackInWinter(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	flower := entity.NewFlower(5, 5, types.ColorRed)
+	flower.DeathTimer = 100.0
+	gameMap.AddItem(flower)
+
+	setSeason(gameMap, game.SeasonWinter, false)
+	UpdateDeathTimers(gameMap, 10.0)
+
+	want := 100.0 - 10.0*config.ItemLifecycle["flower"].Seasons["winter"].Death
+	if flower.DeathTimer != want || want >= 90.0 {
+		t.Errorf("Winter: flower DeathTimer got %.2f, want %.2f (faster than normal)", flower.DeathTimer, want)
+	}
+}
+
+func TestSeasonRates_UnlistedSeasonRunsAtNormalSpeed(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	setSeason(gameMap, game.SeasonAutumn, false)
+	if got := seasonRates("berry", gameMap); got != (config.SeasonRates{Spawn: 1, Sprout: 1, Death: 1}) {
+		t.Errorf("Berry in autumn: got %+v, want normal speed", got)
+	}
+	if got := seasonRates("stick", gameMap); got != (config.SeasonRates{Spawn: 1, Sprout: 1, Death: 1}) {
+		t.Errorf("Type without a lifecycle: got %+v, want normal speed", got)
+	}
+}
@@ -1,8 +1,6 @@
 package system
 
 import (
-	"fmt"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 )
@@ -18,7 +16,7 @@ func CompleteLook(char *entity.Character, item *entity.Item, log *ActionLog) {
 
 	// Log completion
 	if log != nil {
-		log.Add(char.ID, char.Name, "activity", "Looked at "+itemName)
+		log.AddMessage(char.ID, char.Name, "activity", "log.looked_at", itemName)
 	}
 
 	// Remember last looked item (to avoid looking at same item twice in a row)
@@ -41,16 +39,15 @@ func CompleteLook(char *entity.Character, item *entity.Item, log *ActionLog) {
 		}
 		// Log mood change from preference
 		if log != nil {
-			moodChange := "Improved Mood"
+			moodKey := "log.mood.look_improved"
 			if netPref < 0 {
-				moodChange = "Worsened Mood"
+				moodKey = "log.mood.look_worsened"
 			}
-			log.Add(char.ID, char.Name, "mood",
-				fmt.Sprintf("Looking at %s %s (mood %d→%d)", itemName, moodChange, int(oldMood), int(char.Mood)))
+			log.AddMessage(char.ID, char.Name, "mood", moodKey, itemName, int(oldMood), int(char.Mood))
 		}
 		// Log mood tier transition from preference
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
@@ -72,7 +69,7 @@ func CompleteLookAtConstruct(char *entity.Character, construct *entity.Construct
 	name := construct.DisplayName()
 
 	if log != nil {
-		log.Add(char.ID, char.Name, "activity", "Looked at "+name)
+		log.AddMessage(char.ID, char.Name, "activity", "log.looked_at", name)
 	}
 
 	// Remember last looked position (to avoid looking at same construct twice in a row)
@@ -94,15 +91,14 @@ func CompleteLookAtConstruct(char *entity.Character, construct *entity.Construct
 			char.Mood = 0
 		}
 		if log != nil {
-			moodChange := "Improved Mood"
+			moodKey := "log.mood.look_improved"
 			if netPref < 0 {
-				moodChange = "Worsened Mood"
+				moodKey = "log.mood.look_worsened"
 			}
-			log.Add(char.ID, char.Name, "mood",
-				fmt.Sprintf("Looking at %s %s (mood %d→%d)", name, moodChange, int(oldMood), int(char.Mood)))
+			log.AddMessage(char.ID, char.Name, "mood", moodKey, name, int(oldMood), int(char.Mood))
 		}
 		if log != nil && char.MoodTier() != prevTier {
-			log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+			log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 		}
 	}
 
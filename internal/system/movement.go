@@ -34,7 +34,7 @@ func nextStepBFSCore(fromX, fromY, toX, toY int, gameMap *game.Map, preferBFS bo
 	if !preferBFS {
 		gx, gy := NextStep(fromX, fromY, toX, toY)
 		greedyPos := types.Position{X: gx, Y: gy}
-		if gameMap.IsValid(greedyPos) && !gameMap.IsWater(greedyPos) {
+		if gameMap.IsValid(greedyPos) && !gameMap.IsWater(greedyPos) && !gameMap.IsSolidTree(greedyPos) && !gameMap.IsOutcrop(greedyPos) {
 			if f := gameMap.FeatureAt(greedyPos); f == nil || f.IsPassable() {
 				if c := gameMap.ConstructAt(greedyPos); c == nil || c.IsPassable() {
 					return gx, gy, false
@@ -64,7 +64,7 @@ func nextStepBFSCore(fromX, fromY, toX, toY int, gameMap *game.Map, preferBFS bo
 		if !gameMap.IsValid(neighbor) || visited[neighbor] {
 			continue
 		}
-		if gameMap.IsWater(neighbor) {
+		if gameMap.IsWater(neighbor) || gameMap.IsSolidTree(neighbor) || gameMap.IsOutcrop(neighbor) {
 			continue
 		}
 		if f := gameMap.FeatureAt(neighbor); f != nil && !f.IsPassable() {
@@ -89,7 +89,7 @@ func nextStepBFSCore(fromX, fromY, toX, toY int, gameMap *game.Map, preferBFS bo
 			if !gameMap.IsValid(neighbor) || visited[neighbor] {
 				continue
 			}
-			if gameMap.IsWater(neighbor) {
+			if gameMap.IsWater(neighbor) || gameMap.IsSolidTree(neighbor) || gameMap.IsOutcrop(neighbor) {
 				continue
 			}
 			if f := gameMap.FeatureAt(neighbor); f != nil && !f.IsPassable() {
@@ -1,7 +1,6 @@
 package system
 
 import (
-	"fmt"
 	"math"
 	"sort"
 	"strings"
@@ -9,6 +8,7 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -28,7 +28,7 @@ func selectOrderActivity(char *entity.Character, pos types.Position, items []*en
 			if order.Status == entity.OrderPaused {
 				order.Status = entity.OrderAssigned
 				if log != nil {
-					log.Add(char.ID, char.Name, "order", fmt.Sprintf("Resuming order: %s", order.DisplayName()))
+					log.AddMessage(char.ID, char.Name, "order", "log.order.resuming", order.DisplayName())
 				}
 			}
 			if intent := findOrderIntent(char, pos, items, order, log, gameMap); intent != nil {
@@ -67,7 +67,7 @@ func selectOrderActivity(char *entity.Character, pos types.Position, items []*en
 	order.AssignedTo = char.ID
 	char.AssignedOrderID = order.ID
 	if log != nil {
-		log.Add(char.ID, char.Name, "order", fmt.Sprintf("Taking order: %s", order.DisplayName()))
+		log.AddMessage(char.ID, char.Name, "order", "log.order.taking", order.DisplayName())
 	}
 
 	if intent := findOrderIntent(char, pos, items, order, log, gameMap); intent != nil {
@@ -152,6 +152,20 @@ func findOrderIntent(char *entity.Character, pos types.Position, items []*entity
 		return findBuildFenceIntent(char, pos, items, order, log, gameMap)
 	case "buildHut":
 		return findBuildHutIntent(char, pos, items, order, log, gameMap)
+	case "deconstruct":
+		return findDeconstructIntent(char, pos, items, order, log, gameMap)
+	case "carveWood":
+		return findCarveIntent(char, pos, items, order, log, gameMap)
+	case "chopWood":
+		return findChopIntent(char, pos, items, order, log, gameMap)
+	case "digChannel":
+		return findDigChannelIntent(char, pos, items, order, log, gameMap)
+	case "compost":
+		return findCompostIntent(char, pos, items, order, log, gameMap)
+	case "buildCampfire":
+		return findBuildCampfireIntent(char, pos, items, order, log, gameMap)
+	case "cook":
+		return findCookIntent(char, pos, items, order, log, gameMap)
 	default:
 		// Recipe-based activities (craftVessel, craftHoe, craftBrick, etc.) use generic craft handler
 		if len(entity.GetRecipesForActivity(order.ActivityID)) > 0 {
@@ -192,7 +206,7 @@ func findHarvestIntent(char *entity.Character, pos types.Position, items []*enti
 	// Check if already at target
 	if pos.X == tx && pos.Y == ty {
 		// Start harvesting immediately (uses ActionPickup - same physical action as foraging)
-		newActivity := "Harvesting " + target.Description()
+		newActivity := i18n.T("doing.harvesting", target.Description())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -207,7 +221,7 @@ func findHarvestIntent(char *entity.Character, pos types.Position, items []*enti
 	// Move toward target
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
 
-	newActivity := "Moving to harvest " + target.Description()
+	newActivity := i18n.T("doing.moving_to_harvest", target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -258,7 +272,7 @@ func findTillSoilIntent(char *entity.Character, pos types.Position, items []*ent
 	// Step 3: Move to or till the target tile
 	if pos.X == nearest.X && pos.Y == nearest.Y {
 		// At target — start tilling
-		char.CurrentActivity = "Tilling soil"
+		char.CurrentActivity = i18n.T("doing.tilling")
 		return &entity.Intent{
 			Target: *nearest,
 			Dest:   *nearest,
@@ -272,7 +286,7 @@ func findTillSoilIntent(char *entity.Character, pos types.Position, items []*ent
 	if usedBFS {
 		char.UsingBFS = true
 	}
-	newActivity := "Moving to till soil"
+	newActivity := i18n.T("doing.moving_to_till")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -313,7 +327,7 @@ func findPlantIntent(char *entity.Character, pos types.Position, items []*entity
 		// Has item — move to tilled tile or plant
 		if pos.X == nearestTile.X && pos.Y == nearestTile.Y {
 			// At tilled tile — plant
-			char.CurrentActivity = "Planting"
+			char.CurrentActivity = i18n.T("doing.planting")
 			return &entity.Intent{
 				Target: *nearestTile,
 				Dest:   *nearestTile,
@@ -326,7 +340,7 @@ func findPlantIntent(char *entity.Character, pos types.Position, items []*entity
 		if usedBFS {
 			char.UsingBFS = true
 		}
-		newActivity := "Moving to plant"
+		newActivity := i18n.T("doing.moving_to_plant")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -392,6 +406,18 @@ func isMultiStepOrderComplete(char *entity.Character, order *entity.Order, gameM
 		return !gameMap.HasUnbuiltConstructionPositions("fence")
 	case "buildHut":
 		return !gameMap.HasUnbuiltConstructionPositions("hut")
+	case "deconstruct":
+		return !HasMarkedConstructs(gameMap)
+	case "carveWood":
+		return !HasCarvableMarks(gameMap)
+	case "chopWood":
+		return !HasChoppableMarks(gameMap)
+	case "digChannel":
+		return !HasChannelWork(gameMap)
+	case "compost":
+		return !HasCompostWork(gameMap)
+	case "buildCampfire":
+		return !HasCampfireWork(gameMap)
 	default:
 		return false
 	}
@@ -509,7 +535,7 @@ func findCraftIntent(char *entity.Character, pos types.Position, items []*entity
 	}
 
 	// Ready to craft
-	newActivity := "Crafting " + feasible.Name
+	newActivity := i18n.T("doing.crafting", feasible.Name)
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -540,7 +566,7 @@ func isRecipeFeasible(char *entity.Character, recipe *entity.Recipe, items []*en
 // abandonOrder marks an order as abandoned with a cooldown and clears the character's assignment.
 func abandonOrder(char *entity.Character, order *entity.Order, orders []*entity.Order, log *ActionLog) {
 	if log != nil {
-		log.Add(char.ID, char.Name, "order", fmt.Sprintf("Abandoning order: %s (no items available)", order.DisplayName()))
+		log.AddMessage(char.ID, char.Name, "order", "log.order.abandoning", order.DisplayName())
 	}
 
 	// Clear character's assignment
@@ -566,7 +592,7 @@ func findOrderByID(orders []*entity.Order, id int) *entity.Order {
 // the order as OrderCompleted. The game loop sweep removes completed orders.
 func CompleteOrder(char *entity.Character, order *entity.Order, log *ActionLog) {
 	if log != nil {
-		log.Add(char.ID, char.Name, "order", fmt.Sprintf("Completed order: %s", order.DisplayName()))
+		log.AddMessage(char.ID, char.Name, "order", "log.order.completed", order.DisplayName())
 	}
 
 	char.AssignedOrderID = 0
@@ -653,6 +679,9 @@ func IsOrderFeasible(order *entity.Order, items []*entity.Item, gameMap *game.Ma
 	if order.ActivityID == "buildHut" {
 		return gameMap.HasUnbuiltConstructionPositions("hut") && constructionMaterialFeasible("hut", gameMap), false
 	}
+	if order.ActivityID == "buildCampfire" {
+		return campfireFeasible(chars, items, gameMap), false
+	}
 
 	// Recipe-based activities (craft): check if any recipe's inputs all exist in world
 	if len(entity.GetRecipesForActivity(order.ActivityID)) > 0 {
@@ -674,6 +703,18 @@ func IsOrderFeasible(order *entity.Order, items []*entity.Item, gameMap *game.Ma
 		return extractableItemExists(items, order.TargetType, ""), false
 	case "dig":
 		return gameMap.HasClay(), false
+	case "deconstruct":
+		return HasMarkedConstructs(gameMap), false
+	case "carveWood":
+		return anyItemExistsInWorld(carvingTools, chars, items) && HasCarvableMarks(gameMap), false
+	case "chopWood":
+		return itemExistsInWorld("axe", chars, items) && HasChoppableMarks(gameMap), false
+	case "digChannel":
+		return itemExistsInWorld("hoe", chars, items) && HasChannelWork(gameMap), false
+	case "compost":
+		return itemExistsInWorld("shell", chars, items) && HasCompostWork(gameMap), false
+	case "cook":
+		return cookFeasible(chars, items, gameMap), false
 	default:
 		return true, false // Unknown activity type, assume feasible
 	}
@@ -859,7 +900,7 @@ func findWaterGardenIntent(char *entity.Character, pos types.Position, items []*
 	vessel := findCarriedVesselWithWater(char)
 	if vessel != nil {
 		if pos.X == target.X && pos.Y == target.Y {
-			char.CurrentActivity = "Watering garden"
+			char.CurrentActivity = i18n.T("doing.watering")
 			return &entity.Intent{
 				Target:     *target,
 				Dest:       *target,
@@ -868,7 +909,7 @@ func findWaterGardenIntent(char *entity.Character, pos types.Position, items []*
 			}
 		}
 		nx, ny := NextStepBFS(pos.X, pos.Y, target.X, target.Y, gameMap)
-		newActivity := "Moving to water garden"
+		newActivity := i18n.T("doing.moving_to_water_garden")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -893,7 +934,7 @@ func findWaterGardenIntent(char *entity.Character, pos types.Position, items []*
 		}
 		dest := types.Position{X: adjX, Y: adjY}
 		nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
-		newActivity := "Fetching water for garden"
+		newActivity := i18n.T("doing.fetching_water_for_garden")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -914,7 +955,7 @@ func findWaterGardenIntent(char *entity.Character, pos types.Position, items []*
 	if waterVessel := findGroundWaterVessel(pos, items); waterVessel != nil {
 		vpos := waterVessel.Pos()
 		nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-		newActivity := "Getting water for garden"
+		newActivity := i18n.T("doing.getting_water_for_garden")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -933,7 +974,7 @@ func findWaterGardenIntent(char *entity.Character, pos types.Position, items []*
 	}
 	vpos := groundVessel.Pos()
 	nx, ny := NextStepBFS(pos.X, pos.Y, vpos.X, vpos.Y, gameMap)
-	newActivity := "Getting vessel for garden"
+	newActivity := i18n.T("doing.getting_vessel_for_garden")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -1049,7 +1090,7 @@ func findGatherIntent(char *entity.Character, pos types.Position, items []*entit
 	tx, ty := tpos.X, tpos.Y
 
 	if pos.X == tx && pos.Y == ty {
-		newActivity := "Gathering " + target.Description()
+		newActivity := i18n.T("doing.gathering", target.Description())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -1062,7 +1103,7 @@ func findGatherIntent(char *entity.Character, pos types.Position, items []*entit
 	}
 
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
-	newActivity := "Moving to gather " + target.Description()
+	newActivity := i18n.T("doing.moving_to_gather", target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -1190,7 +1231,7 @@ func PauseOrder(order *entity.Order, log *ActionLog, charID int, charName string
 	if order.Status == entity.OrderAssigned {
 		order.Status = entity.OrderPaused
 		if log != nil {
-			log.Add(charID, charName, "order", fmt.Sprintf("Pausing order: %s (needs attention)", order.DisplayName()))
+			log.AddMessage(charID, charName, "order", "log.order.pausing", order.DisplayName())
 		}
 	}
 }
@@ -1218,7 +1259,7 @@ func findExtractIntent(char *entity.Character, pos types.Position, items []*enti
 
 	// Check if already at target
 	if pos == tpos {
-		newActivity := fmt.Sprintf("Extracting %s seeds", target.ItemType)
+		newActivity := i18n.T("doing.extracting", entity.ItemDisplayName(target.ItemType))
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 		}
@@ -1233,7 +1274,7 @@ func findExtractIntent(char *entity.Character, pos types.Position, items []*enti
 	// Move toward target — calculate BFS step
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
 
-	newActivity := fmt.Sprintf("Moving to extract from %s", target.Description())
+	newActivity := i18n.T("doing.moving_to_extract", target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -1319,7 +1360,7 @@ func findDigIntent(char *entity.Character, pos types.Position, items []*entity.I
 
 	// Step 4: Walk-then-act
 	if pos == clayPos {
-		char.CurrentActivity = "Digging clay"
+		char.CurrentActivity = i18n.T("doing.digging_clay")
 		return &entity.Intent{
 			Target: pos,
 			Dest:   clayPos,
@@ -1332,7 +1373,7 @@ func findDigIntent(char *entity.Character, pos types.Position, items []*entity.I
 	if usedBFS {
 		char.UsingBFS = true
 	}
-	newActivity := "Moving to dig clay"
+	newActivity := i18n.T("doing.moving_to_dig_clay")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -1494,9 +1535,9 @@ func findBundleBuildIntent(char *entity.Character, pos types.Position, candidate
 		if usedBFS {
 			char.UsingBFS = true
 		}
-		newActivity := "Building fence"
+		newActivity := i18n.T("doing.building_fence")
 		if pos != *adjPos {
-			newActivity = "Moving to build fence"
+			newActivity = i18n.T("doing.moving_to_build_fence")
 		}
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
@@ -1534,9 +1575,9 @@ func findBrickFenceIntent(char *entity.Character, pos types.Position, items []*e
 		if usedBFS {
 			char.UsingBFS = true
 		}
-		newActivity := "Delivering materials"
+		newActivity := i18n.T("doing.delivering_materials")
 		if pos == buildPos {
-			newActivity = "Dropping materials"
+			newActivity = i18n.T("doing.dropping_materials")
 		}
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
@@ -1913,9 +1954,9 @@ func createHutDeliveryIntent(char *entity.Character, pos types.Position, buildPo
 	if usedBFS {
 		char.UsingBFS = true
 	}
-	newActivity := "Delivering materials"
+	newActivity := i18n.T("doing.delivering_materials")
 	if pos == buildPos {
-		newActivity = "Dropping materials"
+		newActivity = i18n.T("doing.dropping_materials")
 	}
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
@@ -1982,9 +2023,9 @@ func findHutBuildIntent(char *entity.Character, pos types.Position, candidates [
 		if usedBFS {
 			char.UsingBFS = true
 		}
-		newActivity := "Building hut"
+		newActivity := i18n.T("doing.building_hut")
 		if pos != *adjPos {
-			newActivity = "Moving to build hut"
+			newActivity = i18n.T("doing.moving_to_build_hut")
 		}
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
@@ -1998,3 +2039,109 @@ func findHutBuildIntent(char *entity.Character, pos types.Position, candidates [
 	}
 	return nil // No viable candidate
 }
+
+// findDeconstructIntent finds the nearest construct marked for deconstruction that has a free
+// cardinal tile to stand on, and walks there to dismantle it. Returns nil when no marked
+// construct remains (triggers completion) or every one is currently unreachable.
+func findDeconstructIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	var candidates []types.Position
+	for _, mpos := range gameMap.MarkedForDeconstructionPositions() {
+		if gameMap.ConstructAt(mpos) != nil {
+			candidates = append(candidates, mpos)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		di, dj := pos.DistanceTo(candidates[i]), pos.DistanceTo(candidates[j])
+		if di != dj {
+			return di < dj
+		}
+		return candidates[i].Y < candidates[j].Y || (candidates[i].Y == candidates[j].Y && candidates[i].X < candidates[j].X)
+	})
+
+	for _, candidate := range candidates {
+		standPos := pos
+		if !pos.IsCardinallyAdjacentTo(candidate) {
+			adjPos := findAdjacentStandingTile(candidate, gameMap)
+			if adjPos == nil {
+				continue // All adjacent tiles blocked — try next candidate
+			}
+			standPos = *adjPos
+		}
+		targetPos := candidate
+		nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, standPos.X, standPos.Y, gameMap, char.UsingBFS)
+		if usedBFS {
+			char.UsingBFS = true
+		}
+		newActivity := i18n.T("doing.deconstructing")
+		if pos != standPos {
+			newActivity = i18n.T("doing.moving_to_deconstruct")
+		}
+		if char.CurrentActivity != newActivity {
+			char.CurrentActivity = newActivity
+		}
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           standPos,
+			Action:         entity.ActionDeconstruct,
+			TargetBuildPos: &targetPos,
+		}
+	}
+	return nil
+}
+
+// HasMarkedConstructs returns true if any construct on the map is marked for deconstruction
+func HasMarkedConstructs(gameMap *game.Map) bool {
+	for _, pos := range gameMap.MarkedForDeconstructionPositions() {
+		if gameMap.ConstructAt(pos) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// findCarveIntent creates an intent to carve the nearest tree tile marked for carving.
+// Flow: procure a carving tool (chisel, flake or axe) → find nearest carvable marked tile with a free
+// tile beside it → walk there → carve.
+// Returns nil when no marks are carvable (order complete) or when no carving tool exists (triggers abandonment).
+func findCarveIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	if intent := EnsureHasTool(char, carvingTools, items, gameMap, log); intent != nil {
+		return intent
+	}
+	if findCarriedTool(char, carvingTools) == nil {
+		return nil // No carving tool available — triggers abandonment
+	}
+
+	var candidates []types.Position
+	for _, mpos := range gameMap.MarkedForCarvingPositions() {
+		if gameMap.CanCarve(mpos) {
+			candidates = append(candidates, mpos)
+		}
+	}
+	return findTreeWorkIntent(char, pos, candidates, entity.ActionCarve, "doing.carving", "doing.moving_to_carve", gameMap)
+}
+
+// HasCarvableMarks returns true if any tile marked for carving can still be carved
+func HasCarvableMarks(gameMap *game.Map) bool {
+	for _, pos := range gameMap.MarkedForCarvingPositions() {
+		if gameMap.CanCarve(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// CarveTreeTile completes carving one tree tile: heartwood is hollowed out (or livewood opened up)
+// and the carved-out wood is dropped as an item beside the site. Returns false if nothing was carved.
+func CarveTreeTile(gameMap *game.Map, pos types.Position, char *entity.Character, log *ActionLog) bool {
+	if !gameMap.CarveTree(pos) {
+		return false
+	}
+	dropSalvage(gameMap, pos, []*entity.Item{entity.NewWood(pos.X, pos.Y)})
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.carved_wood")
+	}
+	return true
+}
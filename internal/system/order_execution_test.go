@@ -6602,3 +6602,342 @@ func TestIsOrderFeasible_BuildHut_Feasible_UnlockedLine(t *testing.T) {
 		t.Error("Expected feasible when unlocked line and free construction materials exist")
 	}
 }
+
+// =============================================================================
+// Deconstruct
+// =============================================================================
+
+func TestFindDeconstructIntent_WalksBesideNearestMarkedConstruct(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+
+	near := entity.NewFence(6, 5, "stick", types.ColorBrown)
+	far := entity.NewFence(15, 5, "stick", types.ColorBrown)
+	unmarked := entity.NewFence(4, 5, "stick", types.ColorBrown)
+	gameMap.AddConstruct(near)
+	gameMap.AddConstruct(far)
+	gameMap.AddConstruct(unmarked)
+	gameMap.MarkForDeconstruction(near.Pos())
+	gameMap.MarkForDeconstruction(far.Pos())
+
+	order := entity.NewOrder(1, "deconstruct", "")
+	intent := findDeconstructIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil {
+		t.Fatal("Expected deconstruct intent, got nil")
+	}
+	if intent.Action != entity.ActionDeconstruct {
+		t.Errorf("Intent.Action: got %v, want ActionDeconstruct", intent.Action)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != near.Pos() {
+		t.Fatalf("Intent.TargetBuildPos: got %v, want %v", intent.TargetBuildPos, near.Pos())
+	}
+	if !intent.Dest.IsCardinallyAdjacentTo(near.Pos()) {
+		t.Errorf("Intent.Dest %v should be beside the construct at %v", intent.Dest, near.Pos())
+	}
+	if char.CurrentActivity != "Moving to deconstruct" {
+		t.Errorf("CurrentActivity: got %q, want %q", char.CurrentActivity, "Moving to deconstruct")
+	}
+}
+
+func TestFindDeconstructIntent_StaysPutWhenAlreadyBeside(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	fence := entity.NewFence(6, 5, "stick", types.ColorBrown)
+	gameMap.AddConstruct(fence)
+	gameMap.MarkForDeconstruction(fence.Pos())
+
+	order := entity.NewOrder(1, "deconstruct", "")
+	intent := findDeconstructIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil {
+		t.Fatal("Expected deconstruct intent, got nil")
+	}
+	if intent.Dest != char.Pos() {
+		t.Errorf("Intent.Dest: got %v, want current position %v", intent.Dest, char.Pos())
+	}
+}
+
+func TestDeconstructOrder_CompleteAndFeasibleFollowMarks(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	fence := entity.NewFence(8, 5, "stick", types.ColorBrown)
+	gameMap.AddConstruct(fence)
+	order := entity.NewOrder(1, "deconstruct", "")
+
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); feasible {
+		t.Error("Deconstruct order should be infeasible with nothing marked")
+	}
+
+	gameMap.MarkForDeconstruction(fence.Pos())
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); !feasible {
+		t.Error("Deconstruct order should be feasible while a construct is marked")
+	}
+	if isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Deconstruct order should not be complete while a construct is marked")
+	}
+
+	gameMap.RemoveConstruct(fence)
+	if !isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Deconstruct order should be complete once no marked constructs remain")
+	}
+}
+
+// =============================================================================
+// Carve Wood
+// =============================================================================
+
+func TestFindCarveIntent_FetchesChiselFirst(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	chisel := entity.NewChisel(3, 2, types.ColorSilver)
+	gameMap.AddItem(chisel)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	gameMap.MarkForCarving(types.Position{X: 10, Y: 8})
+
+	order := entity.NewOrder(1, "carveWood", "")
+	intent := findCarveIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil || intent.Action != entity.ActionPickup || intent.TargetItem != chisel {
+		t.Fatalf("Expected to pick up the chisel first, got %+v", intent)
+	}
+}
+
+func TestFindCarveIntent_SkipsWoodWithNoWayIn(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 10, "Test", "berry", types.ColorRed)
+	char.AddToInventory(entity.NewChisel(0, 0, types.ColorSilver))
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	entrance := types.Position{X: 8, Y: 10}
+	gameMap.MarkForCarving(types.Position{X: 10, Y: 10}) // Heartwood in the middle: nowhere to stand yet
+	gameMap.MarkForCarving(entrance)
+
+	order := entity.NewOrder(1, "carveWood", "")
+	intent := findCarveIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil {
+		t.Fatal("Expected carve intent, got nil")
+	}
+	if intent.Action != entity.ActionCarve {
+		t.Errorf("Intent.Action: got %v, want ActionCarve", intent.Action)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != entrance {
+		t.Fatalf("Intent.TargetBuildPos: got %v, want the entrance %v", intent.TargetBuildPos, entrance)
+	}
+	if intent.Dest != (types.Position{X: 7, Y: 10}) {
+		t.Errorf("Intent.Dest: got %v, want the tile outside the entrance", intent.Dest)
+	}
+	if char.CurrentActivity != "Moving to carve wood" {
+		t.Errorf("CurrentActivity: got %q, want %q", char.CurrentActivity, "Moving to carve wood")
+	}
+}
+
+func TestCarveTreeTile_DropsWood(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 7, 10, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	entrance := types.Position{X: 8, Y: 10}
+	log := NewActionLog(10)
+
+	if !CarveTreeTile(gameMap, entrance, char, log) {
+		t.Fatal("Expected the entrance to be carved")
+	}
+	if gameMap.TreeAt(entrance) != game.TreeOpening {
+		t.Errorf("Expected an opening, got %v", gameMap.TreeAt(entrance))
+	}
+	items := gameMap.Items()
+	if len(items) != 1 || items[0].ItemType != "wood" {
+		t.Fatalf("Expected a piece of wood, got %v", items)
+	}
+	if items[0].Pos() != entrance {
+		t.Errorf("Expected the wood on the opened tile, got %v", items[0].Pos())
+	}
+	if events := log.Events(char.ID, 10); len(events) != 1 || events[0].Key != "log.carved_wood" {
+		t.Errorf("Expected a log.carved_wood entry, got %+v", events)
+	}
+}
+
+func TestCarveWoodOrder_CompleteAndFeasibleFollowMarks(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 2, "Test", "berry", types.ColorRed)
+	char.KnownActivities = []string{"carveWood"}
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	entrance := types.Position{X: 8, Y: 10}
+	gameMap.MarkForCarving(entrance)
+	order := entity.NewOrder(1, "carveWood", "")
+
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); feasible {
+		t.Error("Carve order should be infeasible without a chisel")
+	}
+
+	gameMap.AddItem(entity.NewChisel(3, 3, types.ColorSilver))
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); !feasible {
+		t.Error("Carve order should be feasible with a chisel and marked wood")
+	}
+	if isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Carve order should not be complete while wood is marked")
+	}
+
+	gameMap.CarveTree(entrance)
+	if !isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Carve order should be complete once nothing marked is left to carve")
+	}
+}
+
+func TestFindCarveIntent_FlakeServesAsChisel(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 10, "Test", "berry", types.ColorRed)
+	char.AddToInventory(entity.NewFlake(0, 0))
+	gameMap.AddCharacter(char)
+	gameMap.AddItem(entity.NewChisel(3, 10, types.ColorSilver))
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	gameMap.MarkForCarving(types.Position{X: 8, Y: 10})
+
+	order := entity.NewOrder(1, "carveWood", "")
+	intent := findCarveIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil || intent.Action != entity.ActionCarve {
+		t.Fatalf("Expected to carve with the flake already in hand, got %+v", intent)
+	}
+}
+
+// =============================================================================
+// Chop Wood
+// =============================================================================
+
+func TestFindChopIntent_FetchesAxeFirst(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	axe := entity.NewAxe(3, 2)
+	gameMap.AddItem(axe)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	gameMap.MarkForChopping(types.Position{X: 8, Y: 8})
+
+	order := entity.NewOrder(1, "chopWood", "")
+	intent := findChopIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil || intent.Action != entity.ActionPickup || intent.TargetItem != axe {
+		t.Fatalf("Expected to pick up the axe first, got %+v", intent)
+	}
+}
+
+func TestFindChopIntent_WalksBesideMarkedWood(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 8, "Test", "berry", types.ColorRed)
+	char.AddToInventory(entity.NewAxe(0, 0))
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	corner := types.Position{X: 8, Y: 8}
+	gameMap.MarkForChopping(corner)
+
+	order := entity.NewOrder(1, "chopWood", "")
+	intent := findChopIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil {
+		t.Fatal("Expected chop intent, got nil")
+	}
+	if intent.Action != entity.ActionChop {
+		t.Errorf("Intent.Action: got %v, want ActionChop", intent.Action)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != corner {
+		t.Fatalf("Intent.TargetBuildPos: got %v, want %v", intent.TargetBuildPos, corner)
+	}
+	if !intent.Dest.IsCardinallyAdjacentTo(corner) {
+		t.Errorf("Intent.Dest: got %v, want a tile beside %v", intent.Dest, corner)
+	}
+	if char.CurrentActivity != "Moving to chop wood" {
+		t.Errorf("CurrentActivity: got %q, want %q", char.CurrentActivity, "Moving to chop wood")
+	}
+}
+
+func TestChopTreeTile_DropsWood(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 7, 8, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	corner := types.Position{X: 8, Y: 8}
+	log := NewActionLog(10)
+
+	if !ChopTreeTile(gameMap, corner, char, log) {
+		t.Fatal("Expected the corner to be chopped")
+	}
+	if gameMap.TreeAt(corner) != game.TreeNone {
+		t.Errorf("Expected open ground, got %v", gameMap.TreeAt(corner))
+	}
+	wood := 0
+	for _, item := range gameMap.Items() {
+		if item.ItemType == "wood" {
+			wood++
+		}
+	}
+	if wood != config.ChopWoodYield {
+		t.Errorf("Expected %d pieces of wood, got %d", config.ChopWoodYield, wood)
+	}
+	if events := log.Events(char.ID, 10); len(events) != 1 || events[0].Key != "log.chopped_wood" {
+		t.Errorf("Expected a log.chopped_wood entry, got %+v", events)
+	}
+	if ChopTreeTile(gameMap, corner, char, log) {
+		t.Error("Expected open ground not to be chopped twice")
+	}
+}
+
+func TestChopWoodOrder_CompleteAndFeasibleFollowMarks(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 2, "Test", "berry", types.ColorRed)
+	char.KnownActivities = []string{"chopWood"}
+	gameMap.AddCharacter(char)
+	game.AddTestSquareTree(gameMap, 8, 8, 5)
+	corner := types.Position{X: 8, Y: 8}
+	gameMap.MarkForChopping(corner)
+	order := entity.NewOrder(1, "chopWood", "")
+
+	gameMap.AddItem(entity.NewFlake(3, 3))
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); feasible {
+		t.Error("Chop order should be infeasible without an axe")
+	}
+
+	gameMap.AddItem(entity.NewAxe(3, 4))
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); !feasible {
+		t.Error("Chop order should be feasible with an axe and marked wood")
+	}
+	if isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Chop order should not be complete while wood is marked")
+	}
+
+	gameMap.ChopTree(corner)
+	if !isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Chop order should be complete once nothing marked is left to chop")
+	}
+}
@@ -1,12 +1,12 @@
 package system
 
 import (
-	"fmt"
 	"math"
 
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -73,11 +73,11 @@ func createVesselPickupIntent(char *entity.Character, pos types.Position, vessel
 
 	if pos.X == vx && pos.Y == vy {
 		// Already at vessel
-		newActivity := "Picking up vessel"
+		newActivity := i18n.T("doing.picking_up_vessel")
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, category, "Picking up vessel")
+				log.AddMessage(char.ID, char.Name, category, "log.picking_up_vessel")
 			}
 		}
 		return &entity.Intent{
@@ -90,7 +90,7 @@ func createVesselPickupIntent(char *entity.Character, pos types.Position, vessel
 
 	// Move toward vessel
 	nx, ny := NextStepBFS(pos.X, pos.Y, vx, vy, gameMap)
-	newActivity := "Moving to pick up vessel"
+	newActivity := i18n.T("doing.moving_to_pick_up_vessel")
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -298,11 +298,11 @@ func createItemPickupIntent(char *entity.Character, pos types.Position, target *
 	tx, ty := tpos.X, tpos.Y
 
 	if pos.X == tx && pos.Y == ty {
-		newActivity := "Picking up " + target.Description()
+		newActivity := i18n.T("doing.picking_up", target.Description())
 		if char.CurrentActivity != newActivity {
 			char.CurrentActivity = newActivity
 			if log != nil {
-				log.Add(char.ID, char.Name, "order", "Picking up "+target.Description())
+				log.AddMessage(char.ID, char.Name, "order", "log.picking_up", target.Description())
 			}
 		}
 		return &entity.Intent{
@@ -315,7 +315,7 @@ func createItemPickupIntent(char *entity.Character, pos types.Position, target *
 
 	// Move toward target
 	nx, ny := NextStepBFS(pos.X, pos.Y, tx, ty, gameMap)
-	newActivity := "Moving to pick up " + target.Description()
+	newActivity := i18n.T("doing.moving_to_pick_up", target.Description())
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 	}
@@ -665,8 +665,8 @@ func AddToVessel(vessel, item *entity.Item, registry *game.VarietyRegistry) bool
 		return false
 	}
 
-	// Vessel-excluded items cannot go in vessels
-	if config.VesselExcludedTypes[item.ItemType] {
+	// Vessel-excluded items cannot go in vessels, and cooked food has no variety to stack under
+	if config.VesselExcludedTypes[item.ItemType] || item.IsCooked() {
 		return false
 	}
 
@@ -773,8 +773,8 @@ func CanVesselAccept(vessel, item *entity.Item, registry *game.VarietyRegistry)
 		return false
 	}
 
-	// Vessel-excluded items cannot go in vessels
-	if config.VesselExcludedTypes[item.ItemType] {
+	// Vessel-excluded items cannot go in vessels, and cooked food has no variety to stack under
+	if config.VesselExcludedTypes[item.ItemType] || item.IsCooked() {
 		return false
 	}
 
@@ -911,8 +911,7 @@ func Drop(char *entity.Character, gameMap *game.Map, log *ActionLog) {
 
 	// Log drop
 	if log != nil {
-		log.Add(char.ID, char.Name, "activity",
-			fmt.Sprintf("Dropped %s", itemName))
+		log.AddMessage(char.ID, char.Name, "activity", "log.dropped", itemName)
 	}
 }
 
@@ -931,8 +930,7 @@ func DropItem(char *entity.Character, item *entity.Item, gameMap *game.Map, log
 
 	// Log drop
 	if log != nil {
-		log.Add(char.ID, char.Name, "activity",
-			fmt.Sprintf("Dropped %s", itemName))
+		log.AddMessage(char.ID, char.Name, "activity", "log.dropped", itemName)
 	}
 }
 
@@ -967,7 +965,7 @@ const (
 func RunVesselProcurement(char *entity.Character, vessel *entity.Item, gameMap *game.Map, log *ActionLog, registry *game.VarietyRegistry, delta float64) ProcurementStatus {
 	if vessel == nil {
 		char.Intent = nil
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		return ProcureFailed
 	}
 
@@ -982,7 +980,7 @@ func RunVesselProcurement(char *entity.Character, vessel *entity.Item, gameMap *
 		}
 		// Vessel is gone (taken by another character, etc.)
 		char.Intent = nil
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		return ProcureFailed
 	}
 
@@ -1039,7 +1037,7 @@ const (
 func RunWaterFill(char *entity.Character, vessel *entity.Item, actionType entity.ActionType, gameMap *game.Map, log *ActionLog, registry *game.VarietyRegistry, delta float64) WaterFillStatus {
 	if vessel == nil || vessel.Container == nil {
 		char.Intent = nil
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		return FillFailed
 	}
 
@@ -1049,12 +1047,12 @@ func RunWaterFill(char *entity.Character, vessel *entity.Item, actionType entity
 	if char.Intent == nil {
 		waterPos, found := gameMap.FindNearestWater(cpos)
 		if !found {
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			return FillFailed
 		}
 		adjX, adjY := FindClosestCardinalTile(cpos.X, cpos.Y, waterPos.X, waterPos.Y, gameMap)
 		if adjX == -1 {
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			return FillFailed
 		}
 		waterDest := types.Position{X: adjX, Y: adjY}
@@ -1066,7 +1064,7 @@ func RunWaterFill(char *entity.Character, vessel *entity.Item, actionType entity
 			TargetItem: vessel,
 		}
 		if log != nil {
-			log.Add(char.ID, char.Name, "activity", "Heading to water to fill vessel")
+			log.AddMessage(char.ID, char.Name, "activity", "log.heading_to_fill_vessel")
 		}
 		return FillApproaching
 	}
@@ -1077,8 +1075,8 @@ func RunWaterFill(char *entity.Character, vessel *entity.Item, actionType entity
 	}
 
 	// At water destination — accumulate filling progress
-	if char.CurrentActivity != "Filling vessel with water" {
-		char.CurrentActivity = "Filling vessel with water"
+	if char.CurrentActivity != i18n.T("doing.filling_vessel") {
+		char.CurrentActivity = i18n.T("doing.filling_vessel")
 	}
 	char.ActionProgress += delta
 	if char.ActionProgress < config.ActionDurationShort {
@@ -1091,7 +1089,7 @@ func RunWaterFill(char *entity.Character, vessel *entity.Item, actionType entity
 	if len(waterVarieties) > 0 {
 		AddLiquidToVessel(vessel, waterVarieties[0], config.GetStackSize("liquid"))
 		if log != nil {
-			log.Add(char.ID, char.Name, "activity", fmt.Sprintf("Filled %s with water", vessel.Description()))
+			log.AddMessage(char.ID, char.Name, "activity", "log.filled_vessel", vessel.Description())
 		}
 	}
 
@@ -1122,6 +1120,14 @@ func harvestItem(item *entity.Item) {
 	}
 }
 
+// wearSoil records the harvest of a plant still growing where it stood, so tilled soil wears out
+// when it yields the same crop twice running. Call before harvestItem stops the plant growing.
+func wearSoil(item *entity.Item, gameMap *game.Map) {
+	if item.Plant != nil && item.Plant.IsGrowing && !item.Plant.IsSprout {
+		gameMap.HarvestSoil(item.Pos(), item.ItemType)
+	}
+}
+
 // =============================================================================
 // Pickup Actions
 // =============================================================================
@@ -1175,6 +1181,7 @@ func Pickup(char *entity.Character, item *entity.Item, gameMap *game.Map, log *A
 				// Successfully added to vessel
 				gameMap.RemoveItem(item)
 
+				wearSoil(item, gameMap)
 				harvestItem(item)
 
 				// Berries and mushrooms become plantable when picked
@@ -1185,8 +1192,7 @@ func Pickup(char *entity.Character, item *entity.Item, gameMap *game.Map, log *A
 				// Log the addition
 				if log != nil {
 					count := vessel.Container.Contents[0].Count
-					log.Add(char.ID, char.Name, "activity",
-						fmt.Sprintf("Added %s to vessel (%d)", itemName, count))
+					log.AddMessage(char.ID, char.Name, "activity", "log.added_to_vessel", itemName, count)
 				}
 
 				// Try to discover know-how
@@ -1209,11 +1215,11 @@ func Pickup(char *entity.Character, item *entity.Item, gameMap *game.Map, log *A
 				carried.BundleCount += item.BundleCount
 				gameMap.RemoveItem(item)
 
+				wearSoil(item, gameMap)
 				harvestItem(item)
 
 				if log != nil {
-					log.Add(char.ID, char.Name, "activity",
-						fmt.Sprintf("Added to %s", carried.Description()))
+					log.AddMessage(char.ID, char.Name, "activity", "log.added_to", carried.Description())
 				}
 
 				TryDiscoverKnowHow(char, entity.ActionPickup, item, log, GetDiscoveryChance(char))
@@ -1231,6 +1237,7 @@ func Pickup(char *entity.Character, item *entity.Item, gameMap *game.Map, log *A
 
 	gameMap.RemoveItem(item)
 
+	wearSoil(item, gameMap)
 	harvestItem(item)
 
 	// Berries and mushrooms become plantable when picked
@@ -1242,12 +1249,11 @@ func Pickup(char *entity.Character, item *entity.Item, gameMap *game.Map, log *A
 	char.AddToInventory(item)
 
 	// Update activity
-	char.CurrentActivity = "Idle"
+	char.CurrentActivity = i18n.T("doing.idle")
 
 	// Log pickup
 	if log != nil {
-		log.Add(char.ID, char.Name, "activity",
-			fmt.Sprintf("Picked up %s", itemName))
+		log.AddMessage(char.ID, char.Name, "activity", "log.picked_up", itemName)
 	}
 
 	// Try to discover know-how from foraging
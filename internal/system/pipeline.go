@@ -0,0 +1,357 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+)
+
+// Phase identifies when a system runs within a tick. Phases run in ascending
+// order; systems within a phase run in registration order.
+type Phase int
+
+const (
+	PhaseSurvival  Phase = iota // Character needs decay and damage
+	PhaseLifecycle              // Item, tile, and order timers
+	PhaseIntents                // Characters decide what to do
+	PhaseApply                  // Characters act on their intents
+)
+
+// phaseNames maps phases to their profiling/metrics labels
+var phaseNames = map[Phase]string{
+	PhaseSurvival:  "survival",
+	PhaseLifecycle: "lifecycle",
+	PhaseIntents:   "intents",
+	PhaseApply:     "apply",
+}
+
+// AllPhases lists phases in the order they run
+var AllPhases = []Phase{PhaseSurvival, PhaseLifecycle, PhaseIntents, PhaseApply}
+
+// String returns the phase label (e.g. "survival")
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("phase%d", int(p))
+}
+
+// TickContext carries the world state every system sees during one tick.
+// Hosts (the TUI model, headless tests) fill it in before running the pipeline.
+type TickContext struct {
+	GameMap            *game.Map
+	ActionLog          *ActionLog
+	Orders             []*entity.Order
+	Delta              float64
+	GameTime           float64            // Elapsed game seconds including this tick (drives the time of day)
+	NoFood             bool               // Skip food spawning and sprouting (test mode)
+	GroundSpawnTimers  *GroundSpawnTimers // Per-world ground spawn timers
+	CreatureSpawnTimer *float64           // Per-world countdown to the next creature arrival
+
+	// ApplyIntent executes one character's intent. Supplied by the host because
+	// applying intents touches host state (orders, cursor, logs). Nil skips the apply phase.
+	ApplyIntent func(char *entity.Character, delta float64)
+
+	// OnPhase, if set, is called after each phase with its wall-clock duration
+	OnPhase func(phase Phase, d time.Duration)
+}
+
+// System is one unit of per-tick simulation work
+type System interface {
+	Name() string // Unique identifier, used to disable or profile the system
+	Phase() Phase
+	Update(ctx *TickContext)
+}
+
+// SystemFunc adapts a function to the System interface
+type SystemFunc struct {
+	name   string
+	phase  Phase
+	update func(ctx *TickContext)
+}
+
+// NewSystemFunc creates a system from a name, phase, and update function
+func NewSystemFunc(name string, phase Phase, update func(ctx *TickContext)) SystemFunc {
+	return SystemFunc{name: name, phase: phase, update: update}
+}
+
+func (s SystemFunc) Name() string            { return s.name }
+func (s SystemFunc) Phase() Phase            { return s.phase }
+func (s SystemFunc) Update(ctx *TickContext) { s.update(ctx) }
+
+// SystemProfile is the accumulated run time of one system
+type SystemProfile struct {
+	Name  string
+	Phase Phase
+	Calls int
+	Total time.Duration
+}
+
+// Pipeline runs registered systems in phase order each tick.
+// Each world owns its own pipeline, so systems can be disabled or profiled per world.
+type Pipeline struct {
+	systems   []System // Sorted by phase, then registration order
+	disabled  map[string]bool
+	profiling bool
+	profiles  map[string]*SystemProfile
+}
+
+// NewPipeline creates an empty pipeline
+func NewPipeline() *Pipeline {
+	return &Pipeline{
+		disabled: make(map[string]bool),
+		profiles: make(map[string]*SystemProfile),
+	}
+}
+
+// Register adds a system. Returns an error if a system with the same name exists.
+func (p *Pipeline) Register(s System) error {
+	for _, existing := range p.systems {
+		if existing.Name() == s.Name() {
+			return fmt.Errorf("system %q already registered", s.Name())
+		}
+	}
+	p.systems = append(p.systems, s)
+	// Stable sort keeps registration order within a phase
+	sort.SliceStable(p.systems, func(i, j int) bool {
+		return p.systems[i].Phase() < p.systems[j].Phase()
+	})
+	return nil
+}
+
+// Systems returns registered systems in run order
+func (p *Pipeline) Systems() []System {
+	return append([]System(nil), p.systems...)
+}
+
+// Has reports whether a system with the given name is registered
+func (p *Pipeline) Has(name string) bool {
+	for _, s := range p.systems {
+		if s.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetEnabled enables or disables a system by name.
+// Returns false if no such system is registered.
+func (p *Pipeline) SetEnabled(name string, enabled bool) bool {
+	if !p.Has(name) {
+		return false
+	}
+	if enabled {
+		delete(p.disabled, name)
+	} else {
+		p.disabled[name] = true
+	}
+	return true
+}
+
+// Enabled reports whether a system runs (unregistered names report false)
+func (p *Pipeline) Enabled(name string) bool {
+	return p.Has(name) && !p.disabled[name]
+}
+
+// Disabled returns the names of disabled systems, sorted
+func (p *Pipeline) Disabled() []string {
+	var names []string
+	for name := range p.disabled {
+		names = append(names, name)
+	}
+	// Sort for consistent ordering (maps iterate randomly)
+	sort.Strings(names)
+	return names
+}
+
+// SetProfiling turns per-system timing on or off. Turning it on clears previous totals.
+func (p *Pipeline) SetProfiling(on bool) {
+	if on && !p.profiling {
+		p.profiles = make(map[string]*SystemProfile)
+	}
+	p.profiling = on
+}
+
+// Profiling reports whether per-system timing is on
+func (p *Pipeline) Profiling() bool {
+	return p.profiling
+}
+
+// Profile returns accumulated timings for systems that have run while profiling, in run order
+func (p *Pipeline) Profile() []SystemProfile {
+	var result []SystemProfile
+	for _, s := range p.systems {
+		if prof, ok := p.profiles[s.Name()]; ok {
+			result = append(result, *prof)
+		}
+	}
+	return result
+}
+
+// Run executes one tick: every enabled system, phase by phase
+func (p *Pipeline) Run(ctx *TickContext) {
+	i := 0
+	for i < len(p.systems) {
+		phase := p.systems[i].Phase()
+		phaseStart := time.Now()
+		for ; i < len(p.systems) && p.systems[i].Phase() == phase; i++ {
+			s := p.systems[i]
+			if p.disabled[s.Name()] {
+				continue
+			}
+			if !p.profiling {
+				s.Update(ctx)
+				continue
+			}
+			start := time.Now()
+			s.Update(ctx)
+			prof, ok := p.profiles[s.Name()]
+			if !ok {
+				prof = &SystemProfile{Name: s.Name(), Phase: phase}
+				p.profiles[s.Name()] = prof
+			}
+			prof.Calls++
+			prof.Total += time.Since(start)
+		}
+		if ctx.OnPhase != nil {
+			ctx.OnPhase(phase, time.Since(phaseStart))
+		}
+	}
+}
+
+// DefaultPipeline returns a pipeline with the standard systems registered in their canonical order.
+// New subsystems (weather, creatures, ...) are added here.
+func DefaultPipeline() *Pipeline {
+	p := NewPipeline()
+	for _, s := range defaultSystems() {
+		if err := p.Register(s); err != nil {
+			panic(err) // Duplicate names in defaultSystems are a programming error
+		}
+	}
+	return p
+}
+
+// defaultSystems lists the standard systems in registration order
+func defaultSystems() []System {
+	return []System{
+		NewSystemFunc("clock", PhaseSurvival, func(ctx *TickContext) {
+			ctx.GameMap.SetGameTime(ctx.GameTime)
+		}),
+		NewSystemFunc("survival", PhaseSurvival, func(ctx *TickContext) {
+			for _, char := range ctx.GameMap.Characters() {
+				UpdateSurvival(char, ctx.GameMap, ctx.Delta, ctx.ActionLog)
+				UpdateSleepSchedule(char, ctx.GameMap, ctx.ActionLog)
+				UpdateDroughtThirst(char, ctx.GameMap, ctx.Delta)
+			}
+		}),
+		NewSystemFunc("creatureSurvival", PhaseSurvival, func(ctx *TickContext) {
+			for _, c := range ctx.GameMap.Creatures() {
+				UpdateCreatureSurvival(c, ctx.Delta)
+			}
+		}),
+		NewSystemFunc("calendar", PhaseLifecycle, func(ctx *TickContext) {
+			ctx.GameMap.AdvanceCalendar(ctx.Delta)
+		}),
+		NewSystemFunc("weather", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateWeather(ctx.GameMap, ctx.Delta, ctx.ActionLog)
+		}),
+		NewSystemFunc("spawning", PhaseLifecycle, func(ctx *TickContext) {
+			if ctx.NoFood {
+				return
+			}
+			UpdateSpawnTimers(ctx.GameMap, initialItemCount(), ctx.Delta)
+		}),
+		NewSystemFunc("sprouting", PhaseLifecycle, func(ctx *TickContext) {
+			if ctx.NoFood {
+				return
+			}
+			UpdateSproutTimers(ctx.GameMap, initialItemCount(), ctx.Delta)
+		}),
+		// Flowers die regardless of no-food mode
+		NewSystemFunc("death", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateDeathTimers(ctx.GameMap, ctx.Delta)
+		}),
+		NewSystemFunc("seeds", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateSeedTimers(ctx.GameMap, ctx.Delta)
+		}),
+		NewSystemFunc("watering", PhaseLifecycle, func(ctx *TickContext) {
+			ctx.GameMap.UpdateWateredTimers(ctx.Delta)
+		}),
+		NewSystemFunc("channels", PhaseLifecycle, func(ctx *TickContext) {
+			ctx.GameMap.SiltChannels(ctx.Delta)
+		}),
+		NewSystemFunc("soil", PhaseLifecycle, func(ctx *TickContext) {
+			ctx.GameMap.RestFallowSoil(ctx.Delta)
+		}),
+		NewSystemFunc("fire", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateFires(ctx.GameMap, ctx.Delta, ctx.ActionLog)
+		}),
+		NewSystemFunc("groundSpawning", PhaseLifecycle, func(ctx *TickContext) {
+			if ctx.GroundSpawnTimers != nil {
+				UpdateGroundSpawning(ctx.GameMap, ctx.Delta, ctx.GroundSpawnTimers)
+			}
+		}),
+		NewSystemFunc("creatureSpawning", PhaseLifecycle, func(ctx *TickContext) {
+			if ctx.CreatureSpawnTimer != nil {
+				UpdateCreatureSpawning(ctx.GameMap, ctx.Delta, ctx.CreatureSpawnTimer)
+			}
+		}),
+		NewSystemFunc("durability", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateDurability(ctx.GameMap, ctx.Delta, ctx.ActionLog)
+		}),
+		NewSystemFunc("orderCooldowns", PhaseLifecycle, func(ctx *TickContext) {
+			UpdateOrderCooldowns(ctx.Orders, ctx.Delta)
+		}),
+		NewSystemFunc("intents", PhaseIntents, func(ctx *TickContext) {
+			// Phase II ready: can parallelize this
+			items := ctx.GameMap.Items()
+			for _, char := range ctx.GameMap.Characters() {
+				oldIntent := char.Intent
+				char.Intent = CalculateIntent(char, items, ctx.GameMap, ctx.ActionLog, ctx.Orders)
+
+				// Reset action progress if intent action changed
+				if oldIntent == nil || char.Intent == nil || oldIntent.Action != char.Intent.Action {
+					char.ActionProgress = 0
+				}
+			}
+		}),
+		NewSystemFunc("apply", PhaseApply, func(ctx *TickContext) {
+			if ctx.ApplyIntent == nil {
+				return
+			}
+			// Apply intents atomically; characters act more slowly in the dark
+			delta := ctx.Delta * WorkRate(ctx.GameMap)
+			for _, char := range ctx.GameMap.Characters() {
+				ctx.ApplyIntent(char, delta)
+			}
+		}),
+		// Creatures act after characters; iterate a copy since leaving creatures are removed
+		NewSystemFunc("creatures", PhaseApply, func(ctx *TickContext) {
+			for _, c := range append([]*entity.Creature(nil), ctx.GameMap.Creatures()...) {
+				UpdateCreature(c, ctx.GameMap, ctx.Delta)
+			}
+		}),
+	}
+}
+
+// initialItemCount is the spawn target used by item spawning and sprouting
+func initialItemCount() int {
+	return config.ItemSpawnCount*2 + config.FlowerSpawnCount // berries + mushrooms + flowers
+}
+
+// UpdateOrderCooldowns ticks down abandoned order cooldowns, reopening orders whose cooldown expires
+func UpdateOrderCooldowns(orders []*entity.Order, delta float64) {
+	for _, order := range orders {
+		if order.Status == entity.OrderAbandoned && order.AbandonCooldown > 0 {
+			order.AbandonCooldown -= delta
+			if order.AbandonCooldown <= 0 {
+				order.AbandonCooldown = 0
+				order.Status = entity.OrderOpen
+			}
+		}
+	}
+}
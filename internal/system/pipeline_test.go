@@ -0,0 +1,177 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// recordingSystem returns a system that appends its name to *order when run
+func recordingSystem(name string, phase Phase, order *[]string) System {
+	return NewSystemFunc(name, phase, func(ctx *TickContext) {
+		*order = append(*order, name)
+	})
+}
+
+func TestPipeline_RunsPhasesInOrderThenRegistrationOrder(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	p := NewPipeline()
+	p.Register(recordingSystem("applyA", PhaseApply, &order))
+	p.Register(recordingSystem("survival", PhaseSurvival, &order))
+	p.Register(recordingSystem("lifeA", PhaseLifecycle, &order))
+	p.Register(recordingSystem("applyB", PhaseApply, &order))
+	p.Register(recordingSystem("lifeB", PhaseLifecycle, &order))
+
+	p.Run(&TickContext{})
+
+	want := []string{"survival", "lifeA", "lifeB", "applyA", "applyB"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("Expected run order %v, got %v", want, order)
+	}
+}
+
+func TestPipeline_RegisterRejectsDuplicateNames(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	p := NewPipeline()
+	if err := p.Register(recordingSystem("weather", PhaseLifecycle, &order)); err != nil {
+		t.Fatalf("First register failed: %v", err)
+	}
+	if err := p.Register(recordingSystem("weather", PhaseSurvival, &order)); err == nil {
+		t.Error("Expected error registering duplicate system name")
+	}
+	if len(p.Systems()) != 1 {
+		t.Errorf("Expected 1 system, got %d", len(p.Systems()))
+	}
+}
+
+func TestPipeline_DisabledSystemsDoNotRun(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	p := NewPipeline()
+	p.Register(recordingSystem("a", PhaseSurvival, &order))
+	p.Register(recordingSystem("b", PhaseSurvival, &order))
+
+	if !p.SetEnabled("a", false) {
+		t.Fatal("Expected SetEnabled to find system a")
+	}
+	if p.SetEnabled("missing", false) {
+		t.Error("Expected SetEnabled to report unknown system")
+	}
+	p.Run(&TickContext{})
+	if !reflect.DeepEqual(order, []string{"b"}) {
+		t.Errorf("Expected only b to run, got %v", order)
+	}
+	if !reflect.DeepEqual(p.Disabled(), []string{"a"}) {
+		t.Errorf("Expected disabled [a], got %v", p.Disabled())
+	}
+
+	p.SetEnabled("a", true)
+	if !p.Enabled("a") || len(p.Disabled()) != 0 {
+		t.Error("Expected a to be re-enabled")
+	}
+}
+
+func TestPipeline_ProfilingAccumulatesPerSystem(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	p := NewPipeline()
+	p.Register(recordingSystem("a", PhaseSurvival, &order))
+	p.Register(recordingSystem("b", PhaseApply, &order))
+
+	p.Run(&TickContext{})
+	if len(p.Profile()) != 0 {
+		t.Error("Expected no profile while profiling is off")
+	}
+
+	p.SetProfiling(true)
+	p.Run(&TickContext{})
+	p.Run(&TickContext{})
+	profiles := p.Profile()
+	if len(profiles) != 2 || profiles[0].Name != "a" || profiles[1].Name != "b" {
+		t.Fatalf("Expected profiles for a and b in run order, got %+v", profiles)
+	}
+	if profiles[0].Calls != 2 || profiles[1].Phase != PhaseApply {
+		t.Errorf("Unexpected profile: %+v", profiles)
+	}
+}
+
+func TestPipeline_OnPhaseCalledOncePerPhase(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	p := NewPipeline()
+	p.Register(recordingSystem("a", PhaseSurvival, &order))
+	p.Register(recordingSystem("b", PhaseLifecycle, &order))
+	p.Register(recordingSystem("c", PhaseLifecycle, &order))
+
+	var phases []Phase
+	p.Run(&TickContext{OnPhase: func(phase Phase, d time.Duration) {
+		phases = append(phases, phase)
+	}})
+	if !reflect.DeepEqual(phases, []Phase{PhaseSurvival, PhaseLifecycle}) {
+		t.Errorf("Expected survival then lifecycle, got %v", phases)
+	}
+}
+
+func TestDefaultPipeline_CanonicalOrder(t *testing.T) {
+	t.Parallel()
+
+	var names []string
+	for _, s := range DefaultPipeline().Systems() {
+		names = append(names, s.Name())
+	}
+	want := []string{"clock", "survival", "creatureSurvival", "calendar", "weather", "spawning", "sprouting", "death", "seeds", "watering", "channels", "soil", "fire", "groundSpawning", "creatureSpawning", "durability", "orderCooldowns", "intents", "apply", "creatures"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected default systems %v, got %v", want, names)
+	}
+}
+
+func TestDefaultPipeline_AppliesIntentsThroughHost(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(config.MapWidth, config.MapHeight)
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+
+	applied := 0
+	DefaultPipeline().Run(&TickContext{
+		GameMap:   gameMap,
+		ActionLog: NewActionLog(100),
+		Delta:     0.15,
+		NoFood:    true,
+		ApplyIntent: func(c *entity.Character, delta float64) {
+			applied++
+		},
+	})
+	if applied != 1 {
+		t.Errorf("Expected ApplyIntent once per character, got %d", applied)
+	}
+}
+
+func TestUpdateOrderCooldowns_ReopensExpiredOrders(t *testing.T) {
+	t.Parallel()
+
+	order := entity.NewOrder(1, "harvest", "berry")
+	order.Status = entity.OrderAbandoned
+	order.AbandonCooldown = 1.0
+
+	UpdateOrderCooldowns([]*entity.Order{order}, 0.5)
+	if order.Status != entity.OrderAbandoned {
+		t.Error("Expected order to stay abandoned before cooldown expires")
+	}
+	UpdateOrderCooldowns([]*entity.Order{order}, 0.6)
+	if order.Status != entity.OrderOpen || order.AbandonCooldown != 0 {
+		t.Errorf("Expected order reopened, got status %v cooldown %.2f", order.Status, order.AbandonCooldown)
+	}
+}
@@ -1,10 +1,9 @@
 package system
 
 import (
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/rng"
 )
 
 // PreferenceFormationResult represents the outcome of a preference formation attempt
@@ -27,7 +26,7 @@ func TryFormPreference(char *entity.Character, item *entity.Item, log *ActionLog
 	}
 
 	// Roll for formation
-	if rand.Float64() >= chance {
+	if rng.Float64() >= chance {
 		return FormationNone, nil
 	}
 
@@ -75,14 +74,14 @@ func getFormationParams(moodTier int) (chance float64, valence int) {
 // based on configured weights: single attribute or combo (2+ attributes).
 // Solo: any single attribute. Combo: ItemType + 1-2 other attributes (max 3 total).
 func rollPreferenceType(item *entity.Item, valence int) entity.Preference {
-	roll := rand.Float64()
+	roll := rng.Float64()
 
 	// Build list of available attributes for this item
 	attrs := collectItemAttributes(item)
 
 	if roll < config.PrefFormationWeightSingle {
 		// Single attribute - pick one randomly from all available
-		attr := attrs[rand.Intn(len(attrs))]
+		attr := attrs[rng.Intn(len(attrs))]
 		return buildPreference(valence, []string{attr}, item)
 	}
 
@@ -102,12 +101,12 @@ func rollPreferenceType(item *entity.Item, valence int) entity.Preference {
 
 	// Determine how many extra attributes to include (1 or 2)
 	numExtras := 1
-	if len(extras) >= 2 && rand.Float64() < 0.5 {
+	if len(extras) >= 2 && rng.Float64() < 0.5 {
 		numExtras = 2
 	}
 
 	// Shuffle extras and pick first numExtras
-	rand.Shuffle(len(extras), func(i, j int) {
+	rng.Shuffle(len(extras), func(i, j int) {
 		extras[i], extras[j] = extras[j], extras[i]
 	})
 
@@ -185,11 +184,11 @@ func logPreferenceFormed(char *entity.Character, pref entity.Preference, log *Ac
 	if log == nil {
 		return
 	}
-	verb := "Likes"
+	key := "log.preference.likes"
 	if pref.Valence < 0 {
-		verb = "Dislikes"
+		key = "log.preference.dislikes"
 	}
-	log.Add(char.ID, char.Name, "preference", "New Opinion: "+verb+" "+pref.Description())
+	log.AddMessage(char.ID, char.Name, "preference", key, pref.Description())
 }
 
 // logPreferenceRemoved logs when an existing preference is removed
@@ -197,11 +196,11 @@ func logPreferenceRemoved(char *entity.Character, pref entity.Preference, log *A
 	if log == nil {
 		return
 	}
-	verb := "No longer likes"
+	key := "log.preference.no_longer_likes"
 	if pref.Valence < 0 {
-		verb = "No longer dislikes"
+		key = "log.preference.no_longer_dislikes"
 	}
-	log.Add(char.ID, char.Name, "preference", verb+" "+pref.Description())
+	log.AddMessage(char.ID, char.Name, "preference", key, pref.Description())
 }
 
 // TryFormConstructPreference attempts to form a preference based on the character's mood
@@ -212,7 +211,7 @@ func TryFormConstructPreference(char *entity.Character, construct *entity.Constr
 		return FormationNone, nil
 	}
 
-	if rand.Float64() >= chance {
+	if rng.Float64() >= chance {
 		return FormationNone, nil
 	}
 
@@ -240,11 +239,11 @@ func TryFormConstructPreference(char *entity.Character, construct *entity.Constr
 // rollConstructPreferenceType randomly selects which type of preference to form
 // from a construct's attributes: Kind (recipe identity) and Color (material color).
 func rollConstructPreferenceType(construct *entity.Construct, valence int) entity.Preference {
-	roll := rand.Float64()
+	roll := rng.Float64()
 
 	if roll < config.PrefFormationWeightSingle {
 		// Solo attribute - pick one of Kind, ItemType (material), or Color
-		switch rand.Intn(3) {
+		switch rng.Intn(3) {
 		case 0:
 			return entity.Preference{Valence: valence, Kind: construct.PreferenceKind()}
 		case 1:
@@ -255,7 +254,7 @@ func rollConstructPreferenceType(construct *entity.Construct, valence int) entit
 	}
 
 	// Combo - type slot (Kind or ItemType) + Color
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		return entity.Preference{
 			Valence: valence,
 			Kind:    construct.PreferenceKind(),
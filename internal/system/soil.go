@@ -0,0 +1,88 @@
+package system
+
+import (
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// findCompostIntent creates an intent to work a shell into the most worn-out tilled tile.
+// Flow: procure shell → find the least fertile tile that can take compost (nearest first among
+// equals) → walk onto it → compost.
+// Returns nil when no tile can take compost (order complete) or when no shell exists (triggers abandonment).
+func findCompostIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	if intent := EnsureHasItem(char, "shell", items, gameMap, log); intent != nil {
+		return intent
+	}
+	if char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "shell" }) == nil {
+		return nil // No shell available — triggers abandonment
+	}
+
+	var candidates []types.Position
+	for _, tpos := range gameMap.TilledPositions() {
+		if gameMap.CanCompost(tpos) {
+			candidates = append(candidates, tpos)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		fi, fj := gameMap.Fertility(candidates[i]), gameMap.Fertility(candidates[j])
+		if fi != fj {
+			return fi < fj
+		}
+		return pos.DistanceTo(candidates[i]) < pos.DistanceTo(candidates[j])
+	})
+
+	for _, candidate := range candidates {
+		if other := gameMap.CharacterAt(candidate); other != nil && other != char {
+			continue
+		}
+		targetPos := candidate
+		nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, targetPos.X, targetPos.Y, gameMap, char.UsingBFS)
+		if usedBFS {
+			char.UsingBFS = true
+		}
+		newActivity := i18n.T("doing.composting")
+		if pos != targetPos {
+			newActivity = i18n.T("doing.moving_to_compost")
+		}
+		if char.CurrentActivity != newActivity {
+			char.CurrentActivity = newActivity
+		}
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           targetPos,
+			Action:         entity.ActionCompost,
+			TargetBuildPos: &targetPos,
+		}
+	}
+	return nil
+}
+
+// HasCompostWork returns true if any tilled tile can take more compost
+func HasCompostWork(gameMap *game.Map) bool {
+	for _, pos := range gameMap.TilledPositions() {
+		if gameMap.CanCompost(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompostTile works a carried shell into the tilled tile at pos, raising its fertility by
+// ShellCompostFertility. Returns false if the character has no shell or the tile can't take compost.
+func CompostTile(gameMap *game.Map, pos types.Position, char *entity.Character, log *ActionLog) bool {
+	shell := char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "shell" })
+	if shell == nil || !gameMap.CanCompost(pos) {
+		return false
+	}
+	char.RemoveFromInventory(shell)
+	gameMap.CompostSoil(pos, config.ShellCompostFertility)
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.composted")
+	}
+	return true
+}
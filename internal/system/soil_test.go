@@ -0,0 +1,138 @@
+package system
+
+import (
+	"math"
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestUpdateSproutTimers_FertilityScalesTilledGrowth(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	gameMap.SetTilled(pos)
+	gameMap.SetSoil(pos, 0.5, "")
+
+	sprout := entity.NewBerry(5, 5, types.ColorRed, false, false)
+	sprout.Sym = config.CharSprout
+	sprout.Plant.IsSprout = true
+	sprout.Plant.SproutTimer = 20.0
+	gameMap.AddItem(sprout)
+
+	delta := 10.0
+	UpdateSproutTimers(gameMap, 40, delta)
+
+	expectedTimer := 20.0 - (delta * config.TilledGrowthMultiplier * 0.5)
+	if math.Abs(sprout.Plant.SproutTimer-expectedTimer) > 1e-9 {
+		t.Errorf("SproutTimer on worn-out soil: got %.2f, want %.2f", sprout.Plant.SproutTimer, expectedTimer)
+	}
+}
+
+func TestWearSoil_OnlyGrowingPlants(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	gameMap.SetTilled(pos)
+
+	sprout := entity.NewBerry(5, 5, types.ColorRed, false, false)
+	sprout.Plant.IsSprout = true
+	wearSoil(sprout, gameMap)
+	if gameMap.LastCrop(pos) != "" {
+		t.Error("Expected pulling a sprout not to count as a harvest")
+	}
+
+	wearSoil(entity.NewBerry(5, 5, types.ColorRed, false, false), gameMap)
+	wearSoil(entity.NewBerry(5, 5, types.ColorRed, false, false), gameMap)
+	if got, want := gameMap.Fertility(pos), 1-config.SoilHarvestDepletion; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected fertility %.2f after two berry harvests, got %.2f", want, got)
+	}
+}
+
+func TestFindCompostIntent_TargetsLeastFertileTile(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	near := types.Position{X: 3, Y: 2}
+	worn := types.Position{X: 10, Y: 10}
+	gameMap.SetTilled(near)
+	gameMap.SetTilled(worn)
+	gameMap.SetSoil(worn, config.SoilFertilityMin, "berry")
+	char := entity.NewCharacter(1, 2, 2, "Test", "berry", types.ColorRed)
+	char.AddToInventory(&entity.Item{ItemType: "shell", Color: types.ColorSilver})
+	gameMap.AddCharacter(char)
+
+	order := entity.NewOrder(1, "compost", "")
+	intent := findCompostIntent(char, char.Pos(), gameMap.Items(), order, nil, gameMap)
+
+	if intent == nil || intent.Action != entity.ActionCompost {
+		t.Fatalf("Expected a compost intent, got %+v", intent)
+	}
+	if intent.TargetBuildPos == nil || *intent.TargetBuildPos != worn || intent.Dest != worn {
+		t.Errorf("Expected to walk onto the worn-out tile %v, got %+v", worn, intent)
+	}
+}
+
+func TestCompostTile_ConsumesShell(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	pos := types.Position{X: 5, Y: 5}
+	gameMap.SetTilled(pos)
+	char := entity.NewCharacter(1, 5, 5, "Test", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	log := NewActionLog(10)
+
+	if CompostTile(gameMap, pos, char, log) {
+		t.Fatal("Expected no compost without a shell")
+	}
+
+	char.AddToInventory(&entity.Item{ItemType: "shell", Color: types.ColorSilver})
+	if !CompostTile(gameMap, pos, char, log) {
+		t.Fatal("Expected the shell to be composted")
+	}
+	if got, want := gameMap.Fertility(pos), 1+config.ShellCompostFertility; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Expected fertility %.2f, got %.2f", want, got)
+	}
+	if char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "shell" }) != nil {
+		t.Error("Expected the shell to be used up")
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 1 || events[0].Key != "log.composted" {
+		t.Errorf("Expected a composted log entry, got %+v", events)
+	}
+}
+
+func TestCompostOrder_CompleteAndFeasibleFollowWork(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 2, 2, "Test", "berry", types.ColorRed)
+	char.KnownActivities = []string{"compost"}
+	gameMap.AddCharacter(char)
+	pos := types.Position{X: 6, Y: 5}
+	gameMap.SetTilled(pos)
+	order := entity.NewOrder(1, "compost", "")
+
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); feasible {
+		t.Error("Compost order should be infeasible without a shell")
+	}
+
+	gameMap.AddItem(&entity.Item{BaseEntity: entity.BaseEntity{X: 3, Y: 3, EType: entity.TypeItem}, ItemType: "shell"})
+	if feasible, _ := IsOrderFeasible(order, gameMap.Items(), gameMap); !feasible {
+		t.Error("Compost order should be feasible with a shell and tilled soil")
+	}
+	if isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Compost order should not be complete while soil can take compost")
+	}
+
+	gameMap.SetSoil(pos, config.SoilFertilityMax, "")
+	if !isMultiStepOrderComplete(char, order, gameMap) {
+		t.Error("Compost order should be complete once all soil is fully composted")
+	}
+}
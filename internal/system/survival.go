@@ -1,14 +1,14 @@
 package system
 
 import (
-	"fmt"
-
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
 )
 
-// UpdateSurvival updates hunger, thirst, energy, poison, and health for a character
-func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
+// UpdateSurvival updates hunger, thirst, energy, warmth, poison, and health for a character
+func UpdateSurvival(char *entity.Character, gameMap *game.Map, deltaTime float64, log *ActionLog) {
 	if char.IsDead {
 		return
 	}
@@ -19,9 +19,9 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		if char.FrustrationTimer <= 0 {
 			char.IsFrustrated = false
 			char.FrustrationTimer = 0
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			if log != nil {
-				log.Add(char.ID, char.Name, "activity", "Calmed down")
+				log.AddMessage(char.ID, char.Name, "activity", "log.calmed_down")
 			}
 		}
 	}
@@ -54,6 +54,14 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		}
 	}
 
+	// Decrement threat response cooldown
+	if char.FearCooldown > 0 {
+		char.FearCooldown -= deltaTime
+		if char.FearCooldown < 0 {
+			char.FearCooldown = 0
+		}
+	}
+
 	prevHunger := char.Hunger
 	prevThirst := char.Thirst
 	prevEnergy := char.Energy
@@ -74,12 +82,18 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		}
 	}
 
+	// Warmth follows the temperature; being cold outdoors is tiring and unpleasant
+	updateWarmth(char, gameMap, deltaTime, log)
+
 	// Handle energy: restore while sleeping, decrease while awake
 	if char.IsSleeping {
 		restoreRate := config.GroundEnergyRestoreRate
 		if char.AtBed {
 			restoreRate = config.BedEnergyRestoreRate
 		}
+		if isSheltered(gameMap, char.Pos()) {
+			restoreRate *= config.HutSleepRestoreBonus
+		}
 		char.Energy += restoreRate * deltaTime
 		if char.Energy > 100 {
 			char.Energy = 100
@@ -96,12 +110,12 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		if hungerWakes || thirstWakes {
 			char.IsSleeping = false
 			char.AtBed = false
-			char.CurrentActivity = "Waking up"
+			char.CurrentActivity = i18n.T("doing.waking_up")
 			if log != nil {
 				if hungerWakes && (!thirstWakes || char.HungerUrgency() > char.ThirstUrgency()) {
-					log.Add(char.ID, char.Name, "sleep", "Woke up due to hunger")
+					log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.woke_hungry")
 				} else {
-					log.Add(char.ID, char.Name, "sleep", "Woke up due to thirst")
+					log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.woke_thirsty")
 				}
 			}
 		}
@@ -114,7 +128,7 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		if char.IsSleeping && char.Energy >= wakeThreshold {
 			char.IsSleeping = false
 			char.AtBed = false
-			char.CurrentActivity = "Waking up"
+			char.CurrentActivity = i18n.T("doing.waking_up")
 			// Set energy cooldown and boost mood when waking fully rested
 			if wakeThreshold >= 100 {
 				char.EnergyCooldown = config.SatisfactionCooldown
@@ -125,14 +139,14 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 				}
 				// Log mood tier transition from boost
 				if log != nil && char.MoodTier() != prevMoodTier {
-					log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+					log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 				}
 			}
 			if log != nil {
 				if wakeThreshold >= 100 {
-					log.Add(char.ID, char.Name, "sleep", "Woke up fully rested")
+					log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.woke_rested")
 				} else {
-					log.Add(char.ID, char.Name, "sleep", "Woke up partially rested")
+					log.AddMessage(char.ID, char.Name, "sleep", "log.sleep.woke_partially_rested")
 				}
 			}
 		}
@@ -140,35 +154,38 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		// Decrease energy over time (base rate) when awake - unless on cooldown
 		if char.EnergyCooldown <= 0 {
 			char.Energy -= config.EnergyDecreaseRate * deltaTime
-			if char.Energy < 0 {
-				char.Energy = 0
-			}
+		}
+		if char.IsCold {
+			char.Energy -= config.ColdEnergyDrain * deltaTime
+		}
+		if char.Energy < 0 {
+			char.Energy = 0
 		}
 	}
 
 	// Log hunger milestones
 	if log != nil {
 		if prevHunger < 50 && char.Hunger >= 50 {
-			log.Add(char.ID, char.Name, "hunger", "Getting hungry")
+			log.AddMessage(char.ID, char.Name, "hunger", "log.hunger.mild")
 		} else if prevHunger < 75 && char.Hunger >= 75 {
-			log.Add(char.ID, char.Name, "hunger", "Very hungry!")
+			log.AddMessage(char.ID, char.Name, "hunger", "log.hunger.moderate")
 		} else if prevHunger < 90 && char.Hunger >= 90 {
-			log.Add(char.ID, char.Name, "hunger", "Ravenous!")
+			log.AddMessage(char.ID, char.Name, "hunger", "log.hunger.severe")
 		} else if prevHunger < 100 && char.Hunger >= 100 {
-			log.Add(char.ID, char.Name, "hunger", "Starving!")
+			log.AddMessage(char.ID, char.Name, "hunger", "log.hunger.crisis")
 		}
 	}
 
 	// Log thirst milestones
 	if log != nil {
 		if prevThirst < 50 && char.Thirst >= 50 {
-			log.Add(char.ID, char.Name, "thirst", "Getting thirsty")
+			log.AddMessage(char.ID, char.Name, "thirst", "log.thirst.mild")
 		} else if prevThirst < 75 && char.Thirst >= 75 {
-			log.Add(char.ID, char.Name, "thirst", "Very thirsty!")
+			log.AddMessage(char.ID, char.Name, "thirst", "log.thirst.moderate")
 		} else if prevThirst < 90 && char.Thirst >= 90 {
-			log.Add(char.ID, char.Name, "thirst", "Parched!")
+			log.AddMessage(char.ID, char.Name, "thirst", "log.thirst.severe")
 		} else if prevThirst < 100 && char.Thirst >= 100 {
-			log.Add(char.ID, char.Name, "thirst", "Dehydrated!")
+			log.AddMessage(char.ID, char.Name, "thirst", "log.thirst.crisis")
 		}
 	}
 
@@ -176,16 +193,16 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 	// Use independent if statements so multiple threshold crossings in one tick all get logged
 	if !char.IsSleeping && log != nil {
 		if prevEnergy > 50 && char.Energy <= 50 {
-			log.Add(char.ID, char.Name, "energy", "Getting tired")
+			log.AddMessage(char.ID, char.Name, "energy", "log.energy.mild")
 		}
 		if prevEnergy > 25 && char.Energy <= 25 {
-			log.Add(char.ID, char.Name, "energy", "Very tired!")
+			log.AddMessage(char.ID, char.Name, "energy", "log.energy.moderate")
 		}
 		if prevEnergy > 10 && char.Energy <= 10 {
-			log.Add(char.ID, char.Name, "energy", "Exhausted!")
+			log.AddMessage(char.ID, char.Name, "energy", "log.energy.severe")
 		}
 		if prevEnergy > 0 && char.Energy <= 0 {
-			log.Add(char.ID, char.Name, "energy", "Collapsed from exhaustion!")
+			log.AddMessage(char.ID, char.Name, "energy", "log.energy.crisis")
 		}
 	}
 
@@ -202,7 +219,7 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 			char.PoisonTimer = 0
 
 			if log != nil {
-				log.Add(char.ID, char.Name, "poison", "Poison wore off")
+				log.AddMessage(char.ID, char.Name, "poison", "log.poison.wore_off")
 			}
 		}
 	}
@@ -214,8 +231,7 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 
 		// Log starvation damage periodically
 		if log != nil && int(oldHealth/5) > int(char.Health/5) {
-			log.Add(char.ID, char.Name, "health",
-				fmt.Sprintf("Starving! Health: %d/100", int(char.Health)))
+			log.AddMessage(char.ID, char.Name, "health", "log.health.starving", int(char.Health))
 		}
 	}
 
@@ -226,8 +242,7 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 
 		// Log dehydration damage periodically
 		if log != nil && int(oldHealth/5) > int(char.Health/5) {
-			log.Add(char.ID, char.Name, "health",
-				fmt.Sprintf("Dehydrated! Health: %d/100", int(char.Health)))
+			log.AddMessage(char.ID, char.Name, "health", "log.health.dehydrated", int(char.Health))
 		}
 	}
 
@@ -236,10 +251,10 @@ func UpdateSurvival(char *entity.Character, deltaTime float64, log *ActionLog) {
 		char.Health = 0
 		char.IsDead = true
 		char.IsSleeping = false // Can't be sleeping if dead
-		char.CurrentActivity = "Dead"
+		char.CurrentActivity = i18n.T("doing.dead")
 
 		if log != nil {
-			log.Add(char.ID, char.Name, "death", "Died")
+			log.AddMessage(char.ID, char.Name, "death", "log.died")
 		}
 	}
 
@@ -255,7 +270,7 @@ func UpdateMood(char *entity.Character, deltaTime float64, log *ActionLog) {
 
 	prevTier := char.MoodTier()
 
-	// Find highest need tier (hunger, thirst, energy, health - excluding mood itself)
+	// Find highest need tier (hunger, thirst, energy, health, warmth - excluding mood itself)
 	highestTier := char.HungerTier()
 	if char.ThirstTier() > highestTier {
 		highestTier = char.ThirstTier()
@@ -266,6 +281,9 @@ func UpdateMood(char *entity.Character, deltaTime float64, log *ActionLog) {
 	if char.HealthTier() > highestTier {
 		highestTier = char.HealthTier()
 	}
+	if char.WarmthTier() > highestTier {
+		highestTier = char.WarmthTier()
+	}
 
 	// Adjust mood based on highest need tier
 	switch highestTier {
@@ -292,6 +310,9 @@ func UpdateMood(char *entity.Character, deltaTime float64, log *ActionLog) {
 	if char.IsFrustrated {
 		char.Mood -= config.MoodPenaltyFrustrated * deltaTime
 	}
+	if char.IsCold {
+		char.Mood -= config.ColdMoodPenalty * deltaTime
+	}
 
 	// Clamp mood to 0-100
 	if char.Mood > 100 {
@@ -304,6 +325,6 @@ func UpdateMood(char *entity.Character, deltaTime float64, log *ActionLog) {
 	// Log tier transitions
 	newTier := char.MoodTier()
 	if newTier != prevTier && log != nil {
-		log.Add(char.ID, char.Name, "mood", fmt.Sprintf("Feeling %s", char.MoodLevel()))
+		log.AddMessage(char.ID, char.Name, "mood", "log.mood."+entity.TierID(char.MoodTier()))
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"petri/internal/config"
 	"petri/internal/entity"
+	"petri/internal/game"
 	"petri/internal/types"
 )
 
@@ -13,6 +14,11 @@ func newTestCharacter() *entity.Character {
 	return entity.NewCharacter(1, 0, 0, "Test", "berry", types.ColorRed)
 }
 
+// mildMap returns a new map: a clear spring morning, warm enough that temperature has no effect
+func mildMap() *game.Map {
+	return game.NewMap(10, 10)
+}
+
 // =============================================================================
 // Stat Changes Over Time
 // =============================================================================
@@ -24,7 +30,7 @@ func TestUpdateSurvival_HungerIncreasesOverTime(t *testing.T) {
 	char.Hunger = 50
 	char.HungerCooldown = 0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 50 + config.HungerIncreaseRate
 	if char.Hunger != expected {
@@ -39,7 +45,7 @@ func TestUpdateSurvival_HungerDoesNotIncreaseOnCooldown(t *testing.T) {
 	char.Hunger = 0
 	char.HungerCooldown = 5.0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.Hunger != 0 {
 		t.Errorf("Hunger should remain 0 on cooldown, got %.2f", char.Hunger)
@@ -56,7 +62,7 @@ func TestUpdateSurvival_HungerCapsAt100(t *testing.T) {
 	char.Hunger = 99.5
 	char.HungerCooldown = 0
 
-	UpdateSurvival(char, 10.0, nil) // Enough to exceed 100
+	UpdateSurvival(char, mildMap(), 10.0, nil) // Enough to exceed 100
 
 	if char.Hunger != 100 {
 		t.Errorf("Hunger should cap at 100, got %.2f", char.Hunger)
@@ -70,7 +76,7 @@ func TestUpdateSurvival_ThirstIncreasesOverTime(t *testing.T) {
 	char.Thirst = 50
 	char.ThirstCooldown = 0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 50 + config.ThirstIncreaseRate
 	if char.Thirst != expected {
@@ -85,7 +91,7 @@ func TestUpdateSurvival_ThirstDoesNotIncreaseOnCooldown(t *testing.T) {
 	char.Thirst = 0
 	char.ThirstCooldown = 5.0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.Thirst != 0 {
 		t.Errorf("Thirst should remain 0 on cooldown, got %.2f", char.Thirst)
@@ -100,7 +106,7 @@ func TestUpdateSurvival_EnergyDecreasesWhenAwake(t *testing.T) {
 	char.EnergyCooldown = 0
 	char.IsSleeping = false
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 100 - config.EnergyDecreaseRate
 	if char.Energy != expected {
@@ -116,7 +122,7 @@ func TestUpdateSurvival_EnergyDoesNotDecreaseOnCooldown(t *testing.T) {
 	char.EnergyCooldown = 5.0
 	char.IsSleeping = false
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.Energy != 100 {
 		t.Errorf("Energy should remain 100 on cooldown, got %.2f", char.Energy)
@@ -131,7 +137,7 @@ func TestUpdateSurvival_EnergyFloorsAt0(t *testing.T) {
 	char.EnergyCooldown = 0
 	char.IsSleeping = false
 
-	UpdateSurvival(char, 10.0, nil) // Enough to go below 0
+	UpdateSurvival(char, mildMap(), 10.0, nil) // Enough to go below 0
 
 	if char.Energy != 0 {
 		t.Errorf("Energy should floor at 0, got %.2f", char.Energy)
@@ -150,7 +156,7 @@ func TestUpdateSurvival_EnergyRestoresFasterInBed(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 50 + config.BedEnergyRestoreRate
 	if char.Energy != expected {
@@ -166,7 +172,7 @@ func TestUpdateSurvival_EnergyRestoresSlowerOnGround(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = false
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 50 + config.GroundEnergyRestoreRate
 	if char.Energy != expected {
@@ -182,7 +188,7 @@ func TestUpdateSurvival_WakesAt100InBed(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 1.0, nil) // BedEnergyRestoreRate should push past 100
+	UpdateSurvival(char, mildMap(), 1.0, nil) // BedEnergyRestoreRate should push past 100
 
 	if char.IsSleeping {
 		t.Error("Character should wake up at 100 energy in bed")
@@ -200,7 +206,7 @@ func TestUpdateSurvival_WakesAt75OnGround(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = false
 
-	UpdateSurvival(char, 1.0, nil) // GroundEnergyRestoreRate should push past 75
+	UpdateSurvival(char, mildMap(), 1.0, nil) // GroundEnergyRestoreRate should push past 75
 
 	if char.IsSleeping {
 		t.Error("Character should wake up at 75 energy on ground")
@@ -219,7 +225,7 @@ func TestUpdateSurvival_MoodBoostWhenFullyRested(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 1.0, nil) // BedEnergyRestoreRate should push past 100
+	UpdateSurvival(char, mildMap(), 1.0, nil) // BedEnergyRestoreRate should push past 100
 
 	expected := 50 + config.MoodBoostOnConsumption
 	if char.Mood != expected {
@@ -236,7 +242,7 @@ func TestUpdateSurvival_NoMoodBoostWhenPartiallyRested(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = false
 
-	UpdateSurvival(char, 1.0, nil) // GroundEnergyRestoreRate should push past 75
+	UpdateSurvival(char, mildMap(), 1.0, nil) // GroundEnergyRestoreRate should push past 75
 
 	if char.Mood != 50 {
 		t.Errorf("Mood should remain 50 when partially rested, got %.2f", char.Mood)
@@ -252,7 +258,7 @@ func TestUpdateSurvival_WakesEarlyDueToHunger(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 0.01, nil) // Small delta to not change energy much
+	UpdateSurvival(char, mildMap(), 0.01, nil) // Small delta to not change energy much
 
 	if char.IsSleeping {
 		t.Error("Character should wake early due to hunger at Moderate+ tier")
@@ -268,7 +274,7 @@ func TestUpdateSurvival_WakesEarlyDueToThirst(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 0.01, nil)
+	UpdateSurvival(char, mildMap(), 0.01, nil)
 
 	if char.IsSleeping {
 		t.Error("Character should wake early due to thirst at Moderate+ tier")
@@ -284,7 +290,7 @@ func TestUpdateSurvival_DoesNotWakeEarlyForMildHunger(t *testing.T) {
 	char.IsSleeping = true
 	char.AtBed = true
 
-	UpdateSurvival(char, 0.01, nil)
+	UpdateSurvival(char, mildMap(), 0.01, nil)
 
 	if !char.IsSleeping {
 		t.Error("Character should not wake early for Mild hunger")
@@ -303,7 +309,7 @@ func TestUpdateSurvival_PoisonDealsDamage(t *testing.T) {
 	char.Poisoned = true
 	char.PoisonTimer = 10.0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 100 - config.PoisonDamageRate
 	if char.Health != expected {
@@ -318,7 +324,7 @@ func TestUpdateSurvival_PoisonWearsOff(t *testing.T) {
 	char.Poisoned = true
 	char.PoisonTimer = 1.0
 
-	UpdateSurvival(char, 1.5, nil)
+	UpdateSurvival(char, mildMap(), 1.5, nil)
 
 	if char.Poisoned {
 		t.Error("Poison should wear off when timer expires")
@@ -335,7 +341,7 @@ func TestUpdateSurvival_StarvationDealsDamage(t *testing.T) {
 	char.Hunger = 100
 	char.Health = 100
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 100 - config.StarvationDamageRate
 	if char.Health != expected {
@@ -350,7 +356,7 @@ func TestUpdateSurvival_NoStarvationDamageBelow100Hunger(t *testing.T) {
 	char.Hunger = 99
 	char.Health = 100
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.Health != 100 {
 		t.Errorf("Health should remain 100 when hunger < 100, got %.2f", char.Health)
@@ -364,7 +370,7 @@ func TestUpdateSurvival_DehydrationDealsDamage(t *testing.T) {
 	char.Thirst = 100
 	char.Health = 100
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	expected := 100 - config.DehydrationDamageRate
 	if char.Health != expected {
@@ -380,7 +386,7 @@ func TestUpdateSurvival_DeathAtZeroHealth(t *testing.T) {
 	char.Hunger = 100 // Taking starvation damage
 	char.IsSleeping = true
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if !char.IsDead {
 		t.Error("Character should be dead when health reaches 0")
@@ -401,7 +407,7 @@ func TestUpdateSurvival_DeadCharacterNotUpdated(t *testing.T) {
 	char.Hunger = 50
 	char.Thirst = 50
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.Hunger != 50 {
 		t.Errorf("Dead character hunger should not change, got %.2f", char.Hunger)
@@ -422,7 +428,7 @@ func TestUpdateSurvival_FrustrationTimerDecrements(t *testing.T) {
 	char.IsFrustrated = true
 	char.FrustrationTimer = 5.0
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.FrustrationTimer != 4.0 {
 		t.Errorf("FrustrationTimer: got %.2f, want 4.0", char.FrustrationTimer)
@@ -436,7 +442,7 @@ func TestUpdateSurvival_FrustrationClearsWhenTimerExpires(t *testing.T) {
 	char.IsFrustrated = true
 	char.FrustrationTimer = 0.5
 
-	UpdateSurvival(char, 1.0, nil)
+	UpdateSurvival(char, mildMap(), 1.0, nil)
 
 	if char.IsFrustrated {
 		t.Error("Frustration should clear when timer expires")
@@ -471,7 +477,7 @@ func TestRegression_EnergyMilestonesAllLogged(t *testing.T) {
 
 	// Energy decreases at 0.5/sec, so delta of 110 would drop 55 points
 	// From 60 to 5, crossing 50, 25, and 10 thresholds
-	UpdateSurvival(char, 110.0, log)
+	UpdateSurvival(char, mildMap(), 110.0, log)
 
 	// Check that energy dropped significantly
 	if char.Energy > 10 {
@@ -4,6 +4,7 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/types"
 )
 
@@ -16,8 +17,8 @@ func StartTalking(initiator, target *entity.Character, log *ActionLog) {
 	initiator.TalkTimer = config.TalkDuration
 	target.TalkTimer = config.TalkDuration
 
-	initiator.CurrentActivity = "Talking with " + target.Name
-	target.CurrentActivity = "Talking with " + initiator.Name
+	initiator.CurrentActivity = i18n.T("doing.talking_with", target.Name)
+	target.CurrentActivity = i18n.T("doing.talking_with", initiator.Name)
 
 	// Set intent for target so they also continue talking
 	tpos := target.Pos()
@@ -30,8 +31,8 @@ func StartTalking(initiator, target *entity.Character, log *ActionLog) {
 	}
 
 	if log != nil {
-		log.Add(initiator.ID, initiator.Name, "activity", "Started talking with "+target.Name)
-		log.Add(target.ID, target.Name, "activity", "Started talking with "+initiator.Name)
+		log.AddMessage(initiator.ID, initiator.Name, "activity", "log.talk.started", target.Name)
+		log.AddMessage(target.ID, target.Name, "activity", "log.talk.started", initiator.Name)
 	}
 }
 
@@ -113,11 +114,11 @@ func findTalkIntent(char *entity.Character, pos types.Position, gameMap *game.Ma
 	}
 	nx, ny := NextStepBFS(pos.X, pos.Y, adjX, adjY, gameMap)
 
-	newActivity := "Moving to talk with " + closest.Name
+	newActivity := i18n.T("doing.moving_to_talk_with", closest.Name)
 	if char.CurrentActivity != newActivity {
 		char.CurrentActivity = newActivity
 		if log != nil {
-			log.Add(char.ID, char.Name, "movement", "Moving to talk with "+closest.Name)
+			log.AddMessage(char.ID, char.Name, "movement", "log.talk.moving", closest.Name)
 		}
 	}
 
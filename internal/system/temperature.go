@@ -0,0 +1,117 @@
+package system
+
+import (
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// AmbientTemperature returns the outdoor temperature in °C: the season's average,
+// swung up by day and down by night, then shifted by the weather
+func AmbientTemperature(gameMap *game.Map) float64 {
+	temp := config.SeasonTemperatures[gameMap.Season().ID()]
+	temp += (Daylight(gameMap.GameTime()) - 0.5) * config.DayNightTemperatureSwing
+	temp += config.WeatherTemperatureOffsets[gameMap.Weather().ID()]
+	return temp
+}
+
+// FeltTemperature returns the temperature at pos: ambient, raised by HutWarmthBonus inside a shelter
+// (hut or tree hollow) and by CampfireWarmthBonus near a lit campfire
+func FeltTemperature(gameMap *game.Map, pos types.Position) float64 {
+	temp := AmbientTemperature(gameMap)
+	if isSheltered(gameMap, pos) {
+		temp += config.HutWarmthBonus
+	}
+	if NearLitCampfire(gameMap, pos) {
+		temp += config.CampfireWarmthBonus
+	}
+	return temp
+}
+
+// isWarmSpot returns true if pos is inside a shelter or beside a lit campfire
+func isWarmSpot(gameMap *game.Map, pos types.Position) bool {
+	return isSheltered(gameMap, pos) || NearLitCampfire(gameMap, pos)
+}
+
+// findNearestWarmSpot returns the nearest place to warm up: a shelter tile or a free tile beside a lit campfire
+func findNearestWarmSpot(gameMap *game.Map, pos types.Position) (types.Position, bool) {
+	shelter, sheltered := FindNearestShelter(gameMap, pos)
+	fire, fireLit := FindCampfireWarmth(gameMap, pos)
+	if fireLit && (!sheltered || pos.DistanceTo(fire) < pos.DistanceTo(shelter)) {
+		return fire, true
+	}
+	return shelter, sheltered
+}
+
+// updateWarmth moves a character's warmth toward the felt temperature: it drains by
+// WarmthLossRate per degree below ColdTemperature and recovers at WarmthRecoveryRate otherwise.
+// Logs when the character gets colder by a tier, and sets IsCold while the character is cold outdoors.
+func updateWarmth(char *entity.Character, gameMap *game.Map, deltaTime float64, log *ActionLog) {
+	pos := char.Pos()
+	felt := FeltTemperature(gameMap, pos)
+	prevTier := char.WarmthTier()
+
+	if felt < config.ColdTemperature {
+		char.Warmth -= config.WarmthLossRate * (config.ColdTemperature - felt) * deltaTime
+		if char.Warmth < 0 {
+			char.Warmth = 0
+		}
+	} else {
+		char.Warmth += config.WarmthRecoveryRate * deltaTime
+		if char.Warmth > 100 {
+			char.Warmth = 100
+		}
+	}
+
+	if tier := char.WarmthTier(); tier > prevTier && log != nil {
+		log.AddMessage(char.ID, char.Name, "warmth", "log.warmth."+entity.TierID(tier))
+	}
+	char.IsCold = felt < config.ColdTemperature && !isWarmSpot(gameMap, pos)
+}
+
+// canFulfillWarmth returns true if there is a shelter or lit campfire to warm up at
+func canFulfillWarmth(gameMap *game.Map, pos types.Position) bool {
+	_, ok := findNearestWarmSpot(gameMap, pos)
+	return ok
+}
+
+// findWarmthIntent sends a cold character into the nearest shelter, or beside the nearest lit
+// campfire, to warm up, and keeps them there until warmth recovers. Returns nil if there is neither.
+func findWarmthIntent(char *entity.Character, pos types.Position, gameMap *game.Map, tier int, log *ActionLog) *entity.Intent {
+	if isWarmSpot(gameMap, pos) {
+		char.CurrentActivity = i18n.T("doing.warming_up")
+		return &entity.Intent{
+			Target:      pos,
+			Dest:        pos, // Already inside
+			Action:      entity.ActionWarmUp,
+			DrivingStat: types.StatWarmth,
+			DrivingTier: tier,
+		}
+	}
+
+	dest, ok := findNearestWarmSpot(gameMap, pos)
+	if !ok {
+		return nil
+	}
+
+	newActivity := i18n.T("doing.heading_to_shelter")
+	if char.CurrentActivity != newActivity {
+		char.CurrentActivity = newActivity
+		if log != nil {
+			log.AddMessage(char.ID, char.Name, "movement", "log.heading_to_shelter")
+		}
+	}
+	nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, dest.X, dest.Y, gameMap, char.UsingBFS)
+	if usedBFS {
+		char.UsingBFS = true
+	}
+	return &entity.Intent{
+		Target:      types.Position{X: nx, Y: ny},
+		Dest:        dest,
+		Action:      entity.ActionWarmUp,
+		DrivingStat: types.StatWarmth,
+		DrivingTier: tier,
+	}
+}
@@ -0,0 +1,143 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// winterNightMap returns a map on a clear winter night, with a hut whose interior is (11..13, 11..13)
+func winterNightMap() *game.Map {
+	gameMap := game.NewMap(30, 30)
+	gameMap.SetCalendar(game.SeasonWinter, 0)
+	gameMap.SetGameTime(gameTimeAt(2, 2))
+	game.AddTestHut(gameMap, 10, 10)
+	return gameMap
+}
+
+func TestAmbientTemperature_SeasonTimeOfDayAndWeather(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.SetCalendar(game.SeasonSummer, 0)
+	gameMap.SetGameTime(gameTimeAt(1, 12))
+	noon := AmbientTemperature(gameMap)
+	if want := config.SeasonTemperatures["summer"] + config.DayNightTemperatureSwing/2; noon != want {
+		t.Errorf("Expected %.1f°C at a clear summer noon, got %.1f", want, noon)
+	}
+
+	gameMap.SetGameTime(gameTimeAt(2, 2))
+	if night := AmbientTemperature(gameMap); night != noon-config.DayNightTemperatureSwing {
+		t.Errorf("Expected the night to be %.0f degrees colder than noon, got %.1f", config.DayNightTemperatureSwing, night)
+	}
+
+	gameMap.SetCalendar(game.SeasonWinter, 0)
+	gameMap.SetWeather(game.WeatherStorm, game.WeatherClear, 100)
+	want := config.SeasonTemperatures["winter"] - config.DayNightTemperatureSwing/2 + config.WeatherTemperatureOffsets["storm"]
+	if got := AmbientTemperature(gameMap); got != want {
+		t.Errorf("Expected %.1f°C on a stormy winter night, got %.1f", want, got)
+	}
+}
+
+func TestUpdateWarmth_DrainsOutdoorsAndRecoversInHut(t *testing.T) {
+	t.Parallel()
+
+	gameMap := winterNightMap()
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	log := NewActionLog(10)
+
+	updateWarmth(char, gameMap, 20, log)
+	felt := FeltTemperature(gameMap, char.Pos())
+	want := 100 - config.WarmthLossRate*(config.ColdTemperature-felt)*20
+	if char.Warmth != want {
+		t.Fatalf("Expected warmth %.1f after 20s outdoors, got %.1f", want, char.Warmth)
+	}
+	if !char.IsCold {
+		t.Error("Expected the character to be cold outdoors")
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 1 || events[0].Key != "log.warmth.mild" {
+		t.Errorf("Expected a log.warmth.mild entry, got %+v", events)
+	}
+
+	// Inside the hut it's warm enough to recover
+	char.SetPos(types.Position{X: 12, Y: 12})
+	updateWarmth(char, gameMap, 1, log)
+	if char.Warmth != want+config.WarmthRecoveryRate {
+		t.Errorf("Expected warmth to recover to %.1f in the hut, got %.1f", want+config.WarmthRecoveryRate, char.Warmth)
+	}
+	if char.IsCold {
+		t.Error("Expected a sheltered character not to be cold")
+	}
+}
+
+func TestUpdateSurvival_ColdDrainsEnergyAndMood(t *testing.T) {
+	t.Parallel()
+
+	warm := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	cold := entity.NewCharacter(2, 5, 5, "Mo", "berry", types.ColorRed)
+	warm.Mood, cold.Mood = 50, 50
+
+	UpdateSurvival(warm, mildMap(), 1, nil)
+	UpdateSurvival(cold, winterNightMap(), 1, nil)
+
+	if got := warm.Energy - cold.Energy; got != config.ColdEnergyDrain {
+		t.Errorf("Expected the cold character to lose %.2f more energy, lost %.2f more", config.ColdEnergyDrain, got)
+	}
+	if cold.Mood >= warm.Mood {
+		t.Errorf("Expected the cold to lower mood, got %.2f (warm %.2f)", cold.Mood, warm.Mood)
+	}
+}
+
+func TestUpdateSurvival_SleepingInHutRestoresMore(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	game.AddTestHut(gameMap, 10, 10)
+	outside := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	inside := entity.NewCharacter(2, 12, 12, "Mo", "berry", types.ColorRed)
+	for _, char := range []*entity.Character{outside, inside} {
+		char.Energy = 20
+		char.IsSleeping = true
+	}
+
+	UpdateSurvival(outside, gameMap, 1, nil)
+	UpdateSurvival(inside, gameMap, 1, nil)
+
+	want := 20 + config.GroundEnergyRestoreRate*config.HutSleepRestoreBonus
+	if inside.Energy != want {
+		t.Errorf("Expected energy %.2f after sleeping in the hut, got %.2f", want, inside.Energy)
+	}
+	if outside.Energy >= inside.Energy {
+		t.Errorf("Expected sleeping outdoors to restore less, got %.2f (hut %.2f)", outside.Energy, inside.Energy)
+	}
+}
+
+func TestCalculateIntent_ColdCharacterWarmsUpInHut(t *testing.T) {
+	t.Parallel()
+
+	gameMap := winterNightMap() // Hut door at (12, 14)
+	char := entity.NewCharacter(1, 12, 17, "Len", "berry", types.ColorRed)
+	char.Warmth = 20
+	gameMap.AddCharacter(char)
+	log := NewActionLog(10)
+
+	intent := CalculateIntent(char, nil, gameMap, log, nil)
+	if intent == nil || intent.Action != entity.ActionWarmUp || intent.Dest != (types.Position{X: 12, Y: 13}) {
+		t.Fatalf("Expected to head for the hut interior, got %+v", intent)
+	}
+	if intent.DrivingStat != types.StatWarmth {
+		t.Errorf("Expected warmth to drive the intent, got %s", intent.DrivingStat)
+	}
+
+	// Once inside, the character stays put to warm up
+	gameMap.MoveCharacter(char, types.Position{X: 12, Y: 12})
+	char.Intent = nil
+	intent = CalculateIntent(char, nil, gameMap, log, nil)
+	if intent == nil || intent.Action != entity.ActionWarmUp || intent.Dest != (types.Position{X: 12, Y: 12}) {
+		t.Errorf("Expected to warm up in place, got %+v", intent)
+	}
+}
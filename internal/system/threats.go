@@ -0,0 +1,172 @@
+package system
+
+import (
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// selectThreatResponse is the threat-perception step of intent calculation. It runs ahead of
+// needs: a character that notices a threatening creature either flees toward shelter or
+// shoos it away. Returns nil if no threat is perceived or the character is still on fear cooldown.
+func selectThreatResponse(char *entity.Character, cpos types.Position, gameMap *game.Map, orders []*entity.Order, log *ActionLog) *entity.Intent {
+	if char.FearCooldown > 0 {
+		return nil
+	}
+	threat := PerceiveThreat(char, gameMap)
+	if threat == nil {
+		return nil
+	}
+
+	if char.TalkingWith != nil {
+		StopTalking(char, char.TalkingWith, log)
+	}
+	if char.AssignedOrderID != 0 {
+		if order := findOrderByID(orders, char.AssignedOrderID); order != nil {
+			PauseOrder(order, log, char.ID, char.Name)
+		}
+	}
+	char.FailedIntentCount = 0
+	char.ActionProgress = 0
+
+	if ShouldFlee(char, threat) {
+		dest := fleeDestination(gameMap, cpos, threat.Pos())
+		char.FearCooldown = config.FearCooldown
+		if dest == cpos {
+			return nil // Already sheltered, or nowhere safer to go
+		}
+		char.Mood -= config.FearMoodPenalty
+		if char.Mood < 0 {
+			char.Mood = 0
+		}
+		char.CurrentActivity = i18n.T("doing.fleeing", threat.DisplayName())
+		if log != nil {
+			log.AddMessage(char.ID, char.Name, "mood", "log.fled_from", threat.DisplayName())
+		}
+		intent := &entity.Intent{Dest: dest, Action: entity.ActionFlee, TargetCreature: threat}
+		return continueThreatResponse(char, intent, cpos, gameMap)
+	}
+
+	char.CurrentActivity = i18n.T("doing.shooing", threat.DisplayName())
+	intent := &entity.Intent{Dest: threat.Pos(), Action: entity.ActionShoo, TargetCreature: threat}
+	return continueThreatResponse(char, intent, cpos, gameMap)
+}
+
+// continueThreatResponse recalculates the next step of a flee or shoo intent.
+// Returns nil once the character is safe, or the creature being shooed is gone or already leaving.
+func continueThreatResponse(char *entity.Character, intent *entity.Intent, cpos types.Position, gameMap *game.Map) *entity.Intent {
+	switch intent.Action {
+	case entity.ActionFlee:
+		if cpos == intent.Dest {
+			return nil
+		}
+	case entity.ActionShoo:
+		target := intent.TargetCreature
+		if target == nil || target.Leaving || gameMap.CreatureAt(target.Pos()) != target {
+			return nil
+		}
+		intent.Dest = target.Pos()
+		if cpos.IsAdjacentTo(intent.Dest) {
+			intent.Target = cpos
+			return intent
+		}
+	}
+
+	nx, ny, usedBFS := nextStepBFSCore(cpos.X, cpos.Y, intent.Dest.X, intent.Dest.Y, gameMap, char.UsingBFS)
+	if usedBFS {
+		char.UsingBFS = true
+	}
+	intent.Target = types.Position{X: nx, Y: ny}
+	return intent
+}
+
+// isThreatResponse returns true for the flee and shoo actions
+func isThreatResponse(action entity.ActionType) bool {
+	return action == entity.ActionFlee || action == entity.ActionShoo
+}
+
+// PerceiveThreat returns the nearest threatening creature within PerceptionRadius, or nil.
+// Harmless creatures and ones already leaving the map are ignored.
+func PerceiveThreat(char *entity.Character, gameMap *game.Map) *entity.Creature {
+	cpos := char.Pos()
+	radius := PerceptionRadius(gameMap)
+	var nearest *entity.Creature
+	nearestDist := 0
+	for _, c := range gameMap.Creatures() {
+		if c.Leaving || c.Def().Threat <= 0 {
+			continue
+		}
+		dist := cpos.DistanceTo(c.Pos())
+		if dist > radius {
+			continue
+		}
+		if nearest == nil || dist < nearestDist {
+			nearest, nearestDist = c, dist
+		}
+	}
+	return nearest
+}
+
+// ShouldFlee returns true if the character is too frightened to face the creature.
+// Worse moods lower the bar: a creature's threat plus the character's mood tier
+// at or above ThreatFleeThreshold means flight.
+func ShouldFlee(char *entity.Character, c *entity.Creature) bool {
+	return float64(c.Def().Threat+char.MoodTier()) >= config.ThreatFleeThreshold
+}
+
+// ShooCreature completes a shoo: the creature gives up its visit and heads for the map edge
+func ShooCreature(char *entity.Character, c *entity.Creature, log *ActionLog) {
+	c.Leaving = true
+	c.IsSleeping = false
+	c.CurrentActivity = i18n.T("doing.leaving")
+	char.FearCooldown = config.FearCooldown
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.chased_off", c.DisplayName())
+	}
+}
+
+// fleeDestination returns where to flee: the nearest shelter tile if there is one,
+// otherwise the open tile within ThreatPerceptionRadius that is farthest from the threat.
+func fleeDestination(gameMap *game.Map, from, threatPos types.Position) types.Position {
+	if pos, ok := FindNearestShelter(gameMap, from); ok {
+		return pos
+	}
+
+	radius := int(config.ThreatPerceptionRadius)
+	best := from
+	bestDist := from.DistanceTo(threatPos)
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			pos := types.Position{X: from.X + dx, Y: from.Y + dy}
+			if !gameMap.IsValid(pos) || gameMap.IsBlocked(pos) || gameMap.CharacterAt(pos) != nil {
+				continue
+			}
+			if dist := pos.DistanceTo(threatPos); dist > bestDist {
+				best, bestDist = pos, dist
+			}
+		}
+	}
+	return best
+}
+
+// FindNearestShelter returns the nearest open, unoccupied tile inside a hut or tree hollow
+func FindNearestShelter(gameMap *game.Map, from types.Position) (types.Position, bool) {
+	var best types.Position
+	bestDist := -1
+	for _, region := range gameMap.Regions() {
+		if !region.IsShelter() {
+			continue
+		}
+		for _, pos := range region.Tiles {
+			if pos != from && gameMap.IsBlocked(pos) {
+				continue
+			}
+			if dist := from.DistanceTo(pos); bestDist < 0 || dist < bestDist {
+				best, bestDist = pos, dist
+			}
+		}
+	}
+	return best, bestDist >= 0
+}
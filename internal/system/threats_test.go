@@ -0,0 +1,157 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestPerceiveThreat(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	char := entity.NewCharacter(1, 10, 10, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+
+	far := entity.NewCreature(10, 10+int(config.ThreatPerceptionRadius)+1, "rabbit")
+	gameMap.AddCreature(far)
+	leaving := entity.NewCreature(11, 10, "rabbit")
+	leaving.Leaving = true
+	gameMap.AddCreature(leaving)
+
+	if got := PerceiveThreat(char, gameMap); got != nil {
+		t.Fatalf("Expected distant and leaving creatures to be ignored, got creature at %v", got.Pos())
+	}
+
+	near := entity.NewCreature(13, 10, "rabbit")
+	gameMap.AddCreature(near)
+	if got := PerceiveThreat(char, gameMap); got != near {
+		t.Errorf("Expected the nearby rabbit to be perceived, got %v", got)
+	}
+}
+
+func TestShouldFlee_DependsOnMood(t *testing.T) {
+	t.Parallel()
+
+	rabbit := entity.NewCreature(0, 0, "rabbit")
+	char := entity.NewCharacter(1, 0, 0, "Len", "berry", types.ColorRed)
+
+	char.Mood = 50 // Neutral
+	if ShouldFlee(char, rabbit) {
+		t.Error("Expected a neutral character to face a rabbit")
+	}
+	char.Mood = 20 // Unhappy
+	if !ShouldFlee(char, rabbit) {
+		t.Error("Expected an unhappy character to flee a rabbit")
+	}
+}
+
+func TestCalculateIntent_ShoosThreatAheadOfNeeds(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	char := entity.NewCharacter(1, 10, 10, "Len", "berry", types.ColorRed)
+	char.Hunger = 60 // Moderate, with food nearby
+	gameMap.AddCharacter(char)
+	berry := entity.NewBerry(9, 10, types.ColorRed, false, false)
+	gameMap.AddItem(berry)
+	rabbit := entity.NewCreature(13, 10, "rabbit")
+	gameMap.AddCreature(rabbit)
+
+	intent := CalculateIntent(char, gameMap.Items(), gameMap, nil, nil)
+
+	if intent == nil || intent.Action != entity.ActionShoo || intent.TargetCreature != rabbit {
+		t.Fatalf("Expected a shoo intent for the rabbit, got %+v", intent)
+	}
+	if intent.Target != (types.Position{X: 11, Y: 10}) {
+		t.Errorf("Expected first step toward the rabbit, got %v", intent.Target)
+	}
+}
+
+func TestCalculateIntent_UnhappyCharacterFleesToHut(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	game.AddTestHut(gameMap, 10, 10) // Interior is (11..13, 11..13), door at (12, 14)
+	char := entity.NewCharacter(1, 12, 17, "Len", "berry", types.ColorRed)
+	char.Mood = 20
+	gameMap.AddCharacter(char)
+	rabbit := entity.NewCreature(12, 20, "rabbit")
+	gameMap.AddCreature(rabbit)
+	log := NewActionLog(10)
+
+	intent := CalculateIntent(char, nil, gameMap, log, nil)
+
+	if intent == nil || intent.Action != entity.ActionFlee {
+		t.Fatalf("Expected a flee intent, got %+v", intent)
+	}
+	if intent.Dest != (types.Position{X: 12, Y: 13}) {
+		t.Errorf("Expected to flee to the nearest hut interior tile, got %v", intent.Dest)
+	}
+	if char.Mood != 20-config.FearMoodPenalty {
+		t.Errorf("Expected fear to cost %.0f mood, got %.0f", config.FearMoodPenalty, char.Mood)
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) == 0 || events[len(events)-1].Key != "log.fled_from" {
+		t.Errorf("Expected a fled_from log entry, got %v", events)
+	}
+	if char.FearCooldown <= 0 {
+		t.Error("Expected fleeing to start the fear cooldown")
+	}
+}
+
+func TestCalculateIntent_FleesAwayWithoutShelter(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	char := entity.NewCharacter(1, 15, 15, "Len", "berry", types.ColorRed)
+	char.Mood = 5
+	gameMap.AddCharacter(char)
+	rabbit := entity.NewCreature(17, 15, "rabbit")
+	gameMap.AddCreature(rabbit)
+
+	intent := CalculateIntent(char, nil, gameMap, nil, nil)
+
+	if intent == nil || intent.Action != entity.ActionFlee {
+		t.Fatalf("Expected a flee intent, got %+v", intent)
+	}
+	if intent.Dest.DistanceTo(rabbit.Pos()) <= char.Pos().DistanceTo(rabbit.Pos()) {
+		t.Errorf("Expected to flee away from the rabbit, got destination %v", intent.Dest)
+	}
+}
+
+func TestCalculateIntent_FearCooldownIgnoresThreats(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	char := entity.NewCharacter(1, 10, 10, "Len", "berry", types.ColorRed)
+	char.FearCooldown = 5
+	gameMap.AddCharacter(char)
+	gameMap.AddCreature(entity.NewCreature(12, 10, "rabbit"))
+
+	if intent := CalculateIntent(char, nil, gameMap, nil, nil); intent != nil && isThreatResponse(intent.Action) {
+		t.Errorf("Expected threats ignored during fear cooldown, got %+v", intent)
+	}
+}
+
+func TestShooCreature(t *testing.T) {
+	t.Parallel()
+
+	char := entity.NewCharacter(1, 10, 10, "Len", "berry", types.ColorRed)
+	rabbit := entity.NewCreature(11, 10, "rabbit")
+	rabbit.IsSleeping = true
+	log := NewActionLog(10)
+
+	ShooCreature(char, rabbit, log)
+
+	if !rabbit.Leaving || rabbit.IsSleeping {
+		t.Errorf("Expected shooed rabbit awake and leaving, got leaving=%v sleeping=%v", rabbit.Leaving, rabbit.IsSleeping)
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) != 1 || events[0].Key != "log.chased_off" {
+		t.Errorf("Expected a chased_off log entry, got %v", events)
+	}
+}
@@ -0,0 +1,216 @@
+package system
+
+import (
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/rng"
+	"petri/internal/types"
+)
+
+// weatherLogID is the action log key for weather events, which don't belong to a character
+const weatherLogID = 0
+
+// UpdateWeather counts down the current weather spell and applies its effects to the map.
+// When the spell ends the forecast takes over, a new forecast is rolled for the season,
+// and a change of weather is logged. Rain refills dried pond beds, while droughts and clear
+// summer days dry ponds up.
+func UpdateWeather(gameMap *game.Map, delta float64, log *ActionLog) {
+	current, forecast := gameMap.Weather(), gameMap.Forecast()
+	remaining := gameMap.WeatherRemaining() - delta
+	if remaining <= 0 {
+		gameMap.SetWeather(forecast, RollWeather(gameMap.Season()), RandomWeatherDuration())
+		if forecast != current && log != nil {
+			log.AddMessage(weatherLogID, i18n.T("weather.log_name"), "weather", "log.weather."+forecast.ID())
+		}
+	} else {
+		gameMap.SetWeather(current, forecast, remaining)
+	}
+
+	switch weather := gameMap.Weather(); {
+	case weather.IsWet():
+		rainOnTilledSoil(gameMap)
+		if weather == game.WeatherStorm {
+			stormDamage(gameMap, delta)
+		}
+		if rollInterval(delta, config.RainRefillInterval) {
+			refillPondBed(gameMap)
+		}
+	case weather == game.WeatherDrought:
+		if rollInterval(delta, config.DroughtEvaporationInterval) {
+			evaporatePondEdge(gameMap)
+		}
+	case gameMap.Season() == game.SeasonSummer:
+		if rollInterval(delta, config.SummerEvaporationInterval) {
+			evaporatePondEdge(gameMap)
+		}
+	}
+}
+
+// rollInterval returns true with the chance that an event happening every interval seconds on
+// average happens within delta seconds. A zero interval never happens.
+func rollInterval(delta, interval float64) bool {
+	return interval > 0 && rng.Float64() < delta/interval
+}
+
+// RollWeather picks the next spell's weather, weighted by the season's WeatherOdds.
+// Returns clear if the season has no odds.
+func RollWeather(season game.Season) game.Weather {
+	odds := config.WeatherOdds[season.ID()]
+	total := 0.0
+	for _, w := range game.AllWeather {
+		total += odds[w.ID()]
+	}
+	if total <= 0 {
+		return game.WeatherClear
+	}
+	roll := rng.Float64() * total
+	for _, w := range game.AllWeather {
+		roll -= odds[w.ID()]
+		if roll < 0 {
+			return w
+		}
+	}
+	return game.WeatherClear
+}
+
+// RandomWeatherDuration returns a randomized weather spell length.
+// Uses WeatherSpellDuration ± LifecycleIntervalVariance (same pattern as ground spawning).
+func RandomWeatherDuration() float64 {
+	base := config.WeatherSpellDuration
+	variance := base * config.LifecycleIntervalVariance
+	return base + (rng.Float64()*2-1)*variance
+}
+
+// rainOnTilledSoil waters every tilled tile, keeping its watered timer full while the rain lasts
+func rainOnTilledSoil(gameMap *game.Map) {
+	for _, pos := range gameMap.TilledPositions() {
+		gameMap.SetManuallyWatered(pos)
+	}
+}
+
+// stormDamage wears down every construct, and every durable item on the ground outside a shelter,
+// at StormDamageRate
+func stormDamage(gameMap *game.Map, delta float64) {
+	amount := config.StormDamageRate * delta
+	// Iterate copies: breaking removes entries from the underlying slices
+	for _, c := range append([]*entity.Construct(nil), gameMap.Constructs()...) {
+		DamageConstruct(gameMap, c, amount)
+	}
+	for _, item := range append([]*entity.Item(nil), gameMap.Items()...) {
+		if item.Durability == nil || isSheltered(gameMap, item.Pos()) {
+			continue
+		}
+		if item.Durability.Damage(amount) {
+			gameMap.RemoveItem(item)
+		}
+	}
+}
+
+// isSheltered returns true if pos is inside a hut or tree hollow
+func isSheltered(gameMap *game.Map, pos types.Position) bool {
+	region := gameMap.RegionOf(pos)
+	return region != nil && region.IsShelter()
+}
+
+// evaporatePondEdge dries up one random pond tile on the shore: a tile next to both land and other water,
+// so ponds shrink from the edges but never vanish entirely. The tile is left as a dried pond bed.
+// Springs, rivers and channels never dry up.
+func evaporatePondEdge(gameMap *game.Map) {
+	var edges []types.Position
+	for _, pos := range gameMap.WaterPositions() {
+		if gameMap.WaterAt(pos) != game.WaterPond {
+			continue
+		}
+		land, water := false, false
+		for _, dir := range [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+			next := types.Position{X: pos.X + dir[0], Y: pos.Y + dir[1]}
+			if !gameMap.IsValid(next) {
+				continue
+			}
+			if gameMap.IsWater(next) {
+				water = true
+			} else {
+				land = true
+			}
+		}
+		if land && water {
+			edges = append(edges, pos)
+		}
+	}
+	if len(edges) == 0 {
+		return
+	}
+	gameMap.DryPondTile(edges[rng.Intn(len(edges))])
+}
+
+// refillPondBed turns one random dried pond bed next to water back into pond, so ponds grow back
+// from their shores the way they shrank. Beds with something on them stay dry.
+func refillPondBed(gameMap *game.Map) {
+	var beds []types.Position
+	for _, pos := range gameMap.DriedPondBedPositions() {
+		if gameMap.TouchesWater(pos) && gameMap.CanWaterSpread(pos) {
+			beds = append(beds, pos)
+		}
+	}
+	if len(beds) == 0 {
+		return
+	}
+	gameMap.RefillPondTile(beds[rng.Intn(len(beds))])
+}
+
+// UpdateDroughtThirst makes an awake or sleeping character thirstier during a drought:
+// on top of UpdateSurvival's normal rate, thirst rises DroughtThirstFactor times as fast in total
+func UpdateDroughtThirst(char *entity.Character, gameMap *game.Map, delta float64) {
+	if char.IsDead || char.ThirstCooldown > 0 || gameMap.Weather() != game.WeatherDrought {
+		return
+	}
+	extra := config.ThirstIncreaseRate * (config.DroughtThirstFactor - 1) * delta
+	if extra <= 0 {
+		return
+	}
+	char.Thirst += extra
+	if char.Thirst > 100 {
+		char.Thirst = 100
+	}
+}
+
+// selectStormShelter is the storm step of intent calculation. During a storm, a character without
+// an urgent need heads for the nearest shelter and waits the storm out inside. Returns the intent and
+// true while the storm keeps the character indoors (a nil intent means already sheltered);
+// returns false if there is no storm, the character has an urgent need, or there is no shelter.
+func selectStormShelter(char *entity.Character, cpos types.Position, gameMap *game.Map, orders []*entity.Order, log *ActionLog, maxTier int) (*entity.Intent, bool) {
+	if gameMap.Weather() != game.WeatherStorm || maxTier >= entity.TierModerate {
+		return nil, false
+	}
+	if isSheltered(gameMap, cpos) {
+		char.CurrentActivity = i18n.T("doing.sheltering")
+		return nil, true
+	}
+	dest, ok := FindNearestShelter(gameMap, cpos)
+	if !ok {
+		return nil, false
+	}
+
+	if char.Intent == nil || char.Intent.Action != entity.ActionShelter {
+		if char.TalkingWith != nil {
+			StopTalking(char, char.TalkingWith, log)
+		}
+		if char.AssignedOrderID != 0 {
+			if order := findOrderByID(orders, char.AssignedOrderID); order != nil {
+				PauseOrder(order, log, char.ID, char.Name)
+			}
+		}
+		char.ActionProgress = 0
+		if log != nil {
+			log.AddMessage(char.ID, char.Name, "activity", "log.weather.took_shelter")
+		}
+	}
+	char.CurrentActivity = i18n.T("doing.taking_shelter")
+	nx, ny, usedBFS := nextStepBFSCore(cpos.X, cpos.Y, dest.X, dest.Y, gameMap, char.UsingBFS)
+	if usedBFS {
+		char.UsingBFS = true
+	}
+	return &entity.Intent{Target: types.Position{X: nx, Y: ny}, Dest: dest, Action: entity.ActionShelter}, true
+}
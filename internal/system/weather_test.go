@@ -0,0 +1,214 @@
+package system
+
+import (
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+func TestUpdateWeather_ForecastTakesOverWhenSpellEnds(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.SetWeather(game.WeatherClear, game.WeatherRain, 5)
+	log := NewActionLog(10)
+
+	UpdateWeather(gameMap, 2, log)
+	if gameMap.Weather() != game.WeatherClear || gameMap.WeatherRemaining() != 3 {
+		t.Fatalf("Expected 3s of clear weather left, got %s with %.1fs", gameMap.Weather().ID(), gameMap.WeatherRemaining())
+	}
+
+	UpdateWeather(gameMap, 3, log)
+	if gameMap.Weather() != game.WeatherRain {
+		t.Errorf("Expected the forecast rain to arrive, got %s", gameMap.Weather().ID())
+	}
+	if gameMap.WeatherRemaining() <= 0 {
+		t.Error("Expected a new spell to start")
+	}
+	events := log.Events(weatherLogID, 10)
+	if len(events) != 1 || events[0].Key != "log.weather.rain" {
+		t.Errorf("Expected a rain log entry, got %+v", events)
+	}
+}
+
+func TestRollWeather_FollowsSeasonOdds(t *testing.T) {
+	t.Parallel()
+
+	// Droughts only occur in summer
+	for i := 0; i < 200; i++ {
+		if w := RollWeather(game.SeasonWinter); w == game.WeatherDrought {
+			t.Fatal("Expected no drought in winter")
+		}
+	}
+}
+
+func TestUpdateWeather_RainWatersTilledSoil(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	tilled := types.Position{X: 3, Y: 3}
+	gameMap.SetTilled(tilled)
+	gameMap.SetWeather(game.WeatherRain, game.WeatherRain, 100)
+
+	UpdateWeather(gameMap, 1, nil)
+
+	if gameMap.WateredTimer(tilled) != config.WateredTileDuration {
+		t.Errorf("Expected rain to water tilled soil for %.0fs, got %.1f", config.WateredTileDuration, gameMap.WateredTimer(tilled))
+	}
+	if gameMap.IsManuallyWatered(types.Position{X: 4, Y: 3}) {
+		t.Error("Expected untilled ground to stay unwatered")
+	}
+}
+
+func TestEvaporatePondEdge_ShrinksPondsButNotSprings(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.AddWater(types.Position{X: 2, Y: 2}, game.WaterPond)
+	gameMap.AddWater(types.Position{X: 3, Y: 2}, game.WaterPond)
+	gameMap.AddWater(types.Position{X: 7, Y: 7}, game.WaterSpring)
+	gameMap.AddWater(types.Position{X: 7, Y: 8}, game.WaterSpring)
+
+	evaporatePondEdge(gameMap)
+	if got := len(gameMap.WaterPositions()); got != 3 {
+		t.Fatalf("Expected one pond tile to dry up, got %d water tiles", got)
+	}
+
+	// The last pond tile has no water neighbor, so it stays
+	evaporatePondEdge(gameMap)
+	if got := len(gameMap.WaterPositions()); got != 3 {
+		t.Errorf("Expected the pond's last tile and the springs to remain, got %d water tiles", got)
+	}
+}
+
+func TestRefillPondBed_RegrowsFromRemainingWater(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.AddWater(types.Position{X: 2, Y: 2}, game.WaterPond)
+	bed := types.Position{X: 3, Y: 2}
+	gameMap.AddWater(bed, game.WaterPond)
+	gameMap.DryPondTile(bed)
+	gameMap.SetDriedPondBed(types.Position{X: 8, Y: 8}) // Cut off from water: can't refill
+
+	refillPondBed(gameMap)
+
+	if gameMap.WaterAt(bed) != game.WaterPond {
+		t.Error("Expected the bed beside the pond to refill")
+	}
+	if !gameMap.IsDriedPondBed(types.Position{X: 8, Y: 8}) {
+		t.Error("Expected a bed away from water to stay dry")
+	}
+}
+
+func TestStormDamage_SparesItemsInsideHuts(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	game.AddTestHut(gameMap, 10, 10) // Interior is (11..13, 11..13)
+	outside := entity.NewHoe(5, 5, types.ColorSilver)
+	inside := entity.NewHoe(12, 12, types.ColorSilver)
+	gameMap.AddItem(outside)
+	gameMap.AddItem(inside)
+	fence := entity.NewFence(20, 20, "brick", types.ColorTerracotta)
+	gameMap.AddConstruct(fence)
+
+	stormDamage(gameMap, 10)
+
+	want := config.StormDamageRate * 10
+	if got := outside.Durability.Max - outside.Durability.Current; got != want {
+		t.Errorf("Expected the exposed hoe to lose %.0f durability, lost %.1f", want, got)
+	}
+	if inside.Durability.Current != inside.Durability.Max {
+		t.Error("Expected the hoe inside the hut to be sheltered")
+	}
+	if got := fence.Durability.Max - fence.Durability.Current; got != want {
+		t.Errorf("Expected the fence to lose %.0f durability, lost %.1f", want, got)
+	}
+}
+
+func TestUpdateDroughtThirst(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	char := entity.NewCharacter(1, 5, 5, "Len", "berry", types.ColorRed)
+	char.Thirst = 10
+
+	UpdateDroughtThirst(char, gameMap, 10)
+	if char.Thirst != 10 {
+		t.Fatalf("Expected no extra thirst under clear skies, got %.2f", char.Thirst)
+	}
+
+	gameMap.SetWeather(game.WeatherDrought, game.WeatherClear, 100)
+	UpdateDroughtThirst(char, gameMap, 10)
+	want := 10 + config.ThirstIncreaseRate*(config.DroughtThirstFactor-1)*10
+	if char.Thirst != want {
+		t.Errorf("Expected thirst %.2f in a drought, got %.2f", want, char.Thirst)
+	}
+}
+
+func TestCalculateIntent_StormSendsCharactersIndoors(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	game.AddTestHut(gameMap, 10, 10) // Interior is (11..13, 11..13), door at (12, 14)
+	char := entity.NewCharacter(1, 12, 17, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	gameMap.SetWeather(game.WeatherStorm, game.WeatherClear, 100)
+	log := NewActionLog(10)
+
+	intent := CalculateIntent(char, nil, gameMap, log, nil)
+	if intent == nil || intent.Action != entity.ActionShelter || intent.Dest != (types.Position{X: 12, Y: 13}) {
+		t.Fatalf("Expected to head for the hut interior, got %+v", intent)
+	}
+	events := log.Events(char.ID, 10)
+	if len(events) == 0 || events[len(events)-1].Key != "log.weather.took_shelter" {
+		t.Errorf("Expected a took_shelter log entry, got %v", events)
+	}
+
+	// Once inside, the character waits the storm out
+	gameMap.MoveCharacter(char, types.Position{X: 12, Y: 12})
+	char.Intent = nil
+	if intent := CalculateIntent(char, nil, gameMap, log, nil); intent != nil {
+		t.Errorf("Expected a sheltered character to stay put, got %+v", intent)
+	}
+
+	// Urgent needs still come first
+	gameMap.AddWater(types.Position{X: 20, Y: 20}, game.WaterPond)
+	char.Thirst = 80
+	if intent := CalculateIntent(char, nil, gameMap, log, nil); intent == nil || intent.Action == entity.ActionShelter {
+		t.Errorf("Expected a thirsty character to leave shelter for water, got %+v", intent)
+	}
+}
+
+func TestCalculateIntent_ShelterWalkStopsWhenStormEndsOrNeedsTurnUrgent(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(30, 30)
+	game.AddTestHut(gameMap, 10, 10)
+	char := entity.NewCharacter(1, 12, 20, "Len", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+	gameMap.AddWater(types.Position{X: 20, Y: 20}, game.WaterPond)
+	gameMap.SetWeather(game.WeatherStorm, game.WeatherClear, 100)
+
+	char.Intent = CalculateIntent(char, nil, gameMap, nil, nil)
+	if char.Intent == nil || char.Intent.Action != entity.ActionShelter {
+		t.Fatalf("Expected to head for shelter, got %+v", char.Intent)
+	}
+
+	// A crisis need overrides the walk to shelter mid-way
+	char.Thirst = 95
+	if intent := CalculateIntent(char, nil, gameMap, nil, nil); intent == nil || intent.Action == entity.ActionShelter {
+		t.Errorf("Expected a parched character to stop heading indoors, got %+v", intent)
+	}
+
+	// Once the storm has passed, the character stops heading indoors
+	char.Thirst = 0
+	gameMap.SetWeather(game.WeatherClear, game.WeatherClear, 100)
+	if intent := CalculateIntent(char, nil, gameMap, nil, nil); intent != nil && intent.Action == entity.ActionShelter {
+		t.Errorf("Expected the walk to shelter to end with the storm, got %+v", intent)
+	}
+}
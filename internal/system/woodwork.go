@@ -0,0 +1,135 @@
+package system
+
+import (
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/types"
+)
+
+// carvingTools are the item types that can carve wood, in order of preference
+var carvingTools = []string{"chisel", "flake", "axe"}
+
+// EnsureHasTool is EnsureHasItem for work any of several tools can do: returns nil if the character
+// already carries one of toolTypes, otherwise an intent to fetch the first type lying on the ground.
+// Returns nil if none are to be had.
+func EnsureHasTool(char *entity.Character, toolTypes []string, items []*entity.Item, gameMap *game.Map, log *ActionLog) *entity.Intent {
+	if findCarriedTool(char, toolTypes) != nil {
+		return nil
+	}
+	for _, toolType := range toolTypes {
+		if groundItemOfTypeExists(items, toolType) {
+			return EnsureHasItem(char, toolType, items, gameMap, log)
+		}
+	}
+	return nil
+}
+
+// findCarriedTool returns the first carried item of one of toolTypes, or nil
+func findCarriedTool(char *entity.Character, toolTypes []string) *entity.Item {
+	for _, toolType := range toolTypes {
+		if tool := char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == toolType }); tool != nil {
+			return tool
+		}
+	}
+	return nil
+}
+
+// anyItemExistsInWorld checks if an item of any of the given types exists anywhere (see itemExistsInWorld)
+func anyItemExistsInWorld(itemTypes []string, chars []*entity.Character, items []*entity.Item) bool {
+	for _, itemType := range itemTypes {
+		if itemExistsInWorld(itemType, chars, items) {
+			return true
+		}
+	}
+	return false
+}
+
+// findTreeWorkIntent creates an intent to work the nearest of the candidate tree tiles that has a free
+// tile beside it: walk there, then act. Shared by carving and chopping.
+// Returns nil if no candidate can be reached yet.
+func findTreeWorkIntent(char *entity.Character, pos types.Position, candidates []types.Position, action entity.ActionType, doingKey, movingKey string, gameMap *game.Map) *entity.Intent {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return pos.DistanceTo(candidates[i]) < pos.DistanceTo(candidates[j])
+	})
+
+	for _, candidate := range candidates {
+		standPos := pos
+		if !pos.IsCardinallyAdjacentTo(candidate) {
+			adjPos := findAdjacentStandingTile(candidate, gameMap)
+			if adjPos == nil {
+				continue // Deep inside solid wood — reachable once the tiles around it are worked
+			}
+			standPos = *adjPos
+		}
+		targetPos := candidate
+		nx, ny, usedBFS := nextStepBFSCore(pos.X, pos.Y, standPos.X, standPos.Y, gameMap, char.UsingBFS)
+		if usedBFS {
+			char.UsingBFS = true
+		}
+		newActivity := i18n.T(doingKey)
+		if pos != standPos {
+			newActivity = i18n.T(movingKey)
+		}
+		if char.CurrentActivity != newActivity {
+			char.CurrentActivity = newActivity
+		}
+		return &entity.Intent{
+			Target:         types.Position{X: nx, Y: ny},
+			Dest:           standPos,
+			Action:         action,
+			TargetBuildPos: &targetPos,
+		}
+	}
+	return nil
+}
+
+// findChopIntent creates an intent to chop down the nearest tree tile marked for chopping.
+// Flow: procure axe → find nearest marked solid wood with a free tile beside it → walk there → chop.
+// Returns nil when no marks are choppable (order complete) or when no axe exists (triggers abandonment).
+func findChopIntent(char *entity.Character, pos types.Position, items []*entity.Item, order *entity.Order, log *ActionLog, gameMap *game.Map) *entity.Intent {
+	if intent := EnsureHasItem(char, "axe", items, gameMap, log); intent != nil {
+		return intent
+	}
+	if char.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "axe" }) == nil {
+		return nil // No axe available — triggers abandonment
+	}
+
+	var candidates []types.Position
+	for _, mpos := range gameMap.MarkedForChoppingPositions() {
+		if gameMap.CanChop(mpos) {
+			candidates = append(candidates, mpos)
+		}
+	}
+	return findTreeWorkIntent(char, pos, candidates, entity.ActionChop, "doing.chopping", "doing.moving_to_chop", gameMap)
+}
+
+// HasChoppableMarks returns true if any tile marked for chopping still holds solid wood
+func HasChoppableMarks(gameMap *game.Map) bool {
+	for _, pos := range gameMap.MarkedForChoppingPositions() {
+		if gameMap.CanChop(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChopTreeTile completes chopping one tree tile: the wood is felled, leaving open ground, and
+// ChopWoodYield pieces of wood are dropped beside the site. Returns false if nothing was chopped.
+func ChopTreeTile(gameMap *game.Map, pos types.Position, char *entity.Character, log *ActionLog) bool {
+	if !gameMap.ChopTree(pos) {
+		return false
+	}
+	salvage := make([]*entity.Item, config.ChopWoodYield)
+	for i := range salvage {
+		salvage[i] = entity.NewWood(pos.X, pos.Y)
+	}
+	dropSalvage(gameMap, pos, salvage)
+	if log != nil {
+		log.AddMessage(char.ID, char.Name, "activity", "log.chopped_wood")
+	}
+	return true
+}
@@ -55,6 +55,7 @@ const (
 	StatEnergy StatType = "energy"
 	StatHealth StatType = "health"
 	StatMood   StatType = "mood"
+	StatWarmth StatType = "warmth"
 )
 
 // Pattern represents item surface patterns (descriptive attribute, opinion-formable)
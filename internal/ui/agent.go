@@ -0,0 +1,338 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/rng"
+	"petri/internal/system"
+	"petri/internal/types"
+)
+
+// DefaultAgentObsInterval is the number of ticks between observations
+// when a step request does not specify its own count
+const DefaultAgentObsInterval = 10
+
+// AgentEnv is a gym-style environment for external agents. The agent resets the
+// world with a seed, then alternates: receive an observation, send actions, and
+// the environment runs N ticks. Actions go through the same order, marking, and
+// rename paths as the TUI.
+type AgentEnv struct {
+	model       Model
+	testCfg     TestConfig
+	obsInterval int
+	tick        int
+	ready       bool // false until the first reset
+}
+
+// NewAgentEnv creates an environment. obsInterval <= 0 uses DefaultAgentObsInterval.
+func NewAgentEnv(testCfg TestConfig, obsInterval int) *AgentEnv {
+	if obsInterval <= 0 {
+		obsInterval = DefaultAgentObsInterval
+	}
+	return &AgentEnv{testCfg: testCfg, obsInterval: obsInterval}
+}
+
+// AgentRequest is one line from the agent
+type AgentRequest struct {
+	Type    string        `json:"type"`              // "reset" or "step"
+	Seed    int64         `json:"seed,omitempty"`    // reset: world generation seed
+	Ticks   int           `json:"ticks,omitempty"`   // step: ticks to run (0 = observation interval)
+	Actions []AgentAction `json:"actions,omitempty"` // step: applied before ticking, in order
+}
+
+// AgentAction is a single agent command.
+// Type is one of: create_order, cancel_order, mark_till, mark_fence, mark_hut, mark_deconstruct,
+// mark_carve, mark_channel, mark_campfire, mark_chop, rename, noop.
+type AgentAction struct {
+	Type        string         `json:"type"`
+	ActivityID  string         `json:"activity_id,omitempty"`  // create_order
+	TargetType  string         `json:"target_type,omitempty"`  // create_order
+	OrderID     int            `json:"order_id,omitempty"`     // cancel_order
+	Anchor      types.Position `json:"anchor"`                 // mark_*; mark_hut top-left corner, mark_campfire tile
+	Cursor      types.Position `json:"cursor"`                 // mark_* except mark_hut, mark_campfire
+	Unmark      bool           `json:"unmark,omitempty"`       // mark_*
+	CharacterID int            `json:"character_id,omitempty"` // rename
+	Name        string         `json:"name,omitempty"`         // rename
+}
+
+// AgentActionResult reports whether an action was applied
+type AgentActionResult struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// AgentObservation is one line sent to the agent
+type AgentObservation struct {
+	Tick       int                 `json:"tick"`
+	GameTime   float64             `json:"game_time"`
+	Day        int                 `json:"day"`
+	Done       bool                `json:"done"` // true when no characters are alive
+	Map        AgentMapSummary     `json:"map"`
+	Characters []AgentCharacter    `json:"characters"`
+	Orders     []OrderResponse     `json:"orders"`
+	Results    []AgentActionResult `json:"results,omitempty"` // one per action in the step request
+	Error      string              `json:"error,omitempty"`   // request-level error
+}
+
+// AgentMapSummary counts what is on the map without listing every tile
+type AgentMapSummary struct {
+	Width                   int            `json:"width"`
+	Height                  int            `json:"height"`
+	Items                   map[string]int `json:"items"`      // item type -> count on the ground
+	Constructs              map[string]int `json:"constructs"` // construct kind -> count
+	WaterTiles              int            `json:"water_tiles"`
+	ClayTiles               int            `json:"clay_tiles"`
+	TreeTiles               int            `json:"tree_tiles"`
+	OutcropTiles            int            `json:"outcrop_tiles"`
+	TilledTiles             int            `json:"tilled_tiles"`
+	MarkedForTilling        int            `json:"marked_for_tilling"`
+	MarkedForConstruction   int            `json:"marked_for_construction"`
+	MarkedForDeconstruction int            `json:"marked_for_deconstruction"`
+	MarkedForCarving        int            `json:"marked_for_carving"`
+	MarkedForDigging        int            `json:"marked_for_digging"`
+	MarkedForChopping       int            `json:"marked_for_chopping"`
+}
+
+// AgentCharacter is a character's position, stats, and current work
+type AgentCharacter struct {
+	ID              int      `json:"id"`
+	Name            string   `json:"name"`
+	X               int      `json:"x"`
+	Y               int      `json:"y"`
+	Health          float64  `json:"health"`
+	Hunger          float64  `json:"hunger"`
+	Thirst          float64  `json:"thirst"`
+	Energy          float64  `json:"energy"`
+	Warmth          float64  `json:"warmth"`
+	Mood            float64  `json:"mood"`
+	Activity        string   `json:"activity"`
+	IsSleeping      bool     `json:"is_sleeping"`
+	IsDead          bool     `json:"is_dead"`
+	AssignedOrderID int      `json:"assigned_order_id,omitempty"`
+	KnownActivities []string `json:"known_activities,omitempty"`
+}
+
+// Reset seeds the shared random source and generates a fresh world.
+// The world is not saved.
+func (e *AgentEnv) Reset(seed int64) AgentObservation {
+	rng.Seed(seed)
+	m := Model{
+		phase:           phasePlaying,
+		actionLog:       system.NewActionLog(200),
+		width:           80,
+		height:          40,
+		paused:          true,
+		testCfg:         e.testCfg,
+		nextOrderID:     1,
+		speedMultiplier: 1,
+	}
+	e.model = m.generateRandomWorld()
+	e.tick = 0
+	e.ready = true
+	return e.observe(nil)
+}
+
+// Step applies actions in order, then runs ticks simulation ticks
+// (ticks <= 0 uses the observation interval, at most config.MaxStepTicks).
+func (e *AgentEnv) Step(actions []AgentAction, ticks int) AgentObservation {
+	if !e.ready {
+		return AgentObservation{Error: "reset required before step"}
+	}
+	if ticks > config.MaxStepTicks {
+		return AgentObservation{Error: fmt.Sprintf("ticks must be at most %d", config.MaxStepTicks)}
+	}
+	if ticks <= 0 {
+		ticks = e.obsInterval
+	}
+
+	results := make([]AgentActionResult, len(actions))
+	for i, action := range actions {
+		if err := e.model.applyAgentAction(action); err != nil {
+			results[i] = AgentActionResult{Error: err.Error()}
+		} else {
+			results[i] = AgentActionResult{OK: true}
+		}
+	}
+
+	for i := 0; i < ticks; i++ {
+		e.model.stepForward()
+		e.tick++
+	}
+	return e.observe(results)
+}
+
+// applyAgentAction dispatches one action to the shared order/marking/rename paths
+func (m *Model) applyAgentAction(action AgentAction) error {
+	switch action.Type {
+	case "noop", "":
+		return nil
+	case "create_order":
+		_, err := m.createOrder(action.ActivityID, action.TargetType)
+		return err
+	case "cancel_order":
+		if !m.cancelOrder(action.OrderID) {
+			return fmt.Errorf("order %d not found", action.OrderID)
+		}
+		return nil
+	case "mark_till":
+		m.markTillingArea(action.Anchor, action.Cursor, action.Unmark)
+		return nil
+	case "mark_fence":
+		m.markFenceLine(action.Anchor, action.Cursor, action.Unmark)
+		return nil
+	case "mark_hut":
+		if action.Unmark {
+			if !m.unmarkHutAt(action.Anchor) {
+				return fmt.Errorf("position is not marked for construction")
+			}
+		} else if !m.markHutFootprint(action.Anchor.X, action.Anchor.Y) {
+			return fmt.Errorf("invalid hut footprint")
+		}
+		return nil
+	case "mark_deconstruct":
+		m.markDeconstructionArea(action.Anchor, action.Cursor, false, action.Unmark)
+		return nil
+	case "mark_carve":
+		m.markCarvingArea(action.Anchor, action.Cursor, action.Unmark)
+		return nil
+	case "mark_channel":
+		m.markDiggingLine(action.Anchor, action.Cursor, action.Unmark)
+		return nil
+	case "mark_campfire":
+		if !m.setCampfireMark(action.Anchor, action.Unmark) {
+			if action.Unmark {
+				return fmt.Errorf("position is not marked for a campfire")
+			}
+			return fmt.Errorf("cannot place a campfire there")
+		}
+		return nil
+	case "mark_chop":
+		m.markChoppingArea(action.Anchor, action.Cursor, action.Unmark)
+		return nil
+	case "rename":
+		if !m.renameCharacter(action.CharacterID, action.Name) {
+			return fmt.Errorf("cannot rename character %d to %q", action.CharacterID, action.Name)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown action type %q", action.Type)
+	}
+}
+
+// observe builds an observation of the current world
+func (e *AgentEnv) observe(results []AgentActionResult) AgentObservation {
+	m := e.model
+	gm := m.gameMap
+
+	summary := AgentMapSummary{
+		Width:                   gm.Width,
+		Height:                  gm.Height,
+		Items:                   make(map[string]int),
+		Constructs:              make(map[string]int),
+		WaterTiles:              len(gm.WaterPositions()),
+		ClayTiles:               len(gm.ClayPositions()),
+		TreeTiles:               len(gm.TreePositions()),
+		OutcropTiles:            len(gm.OutcropPositions()),
+		TilledTiles:             len(gm.TilledPositions()),
+		MarkedForTilling:        len(gm.MarkedForTillingPositions()),
+		MarkedForConstruction:   len(constructionMarksToSave(gm)),
+		MarkedForDeconstruction: len(gm.MarkedForDeconstructionPositions()),
+		MarkedForCarving:        len(gm.MarkedForCarvingPositions()),
+		MarkedForDigging:        len(gm.MarkedForDiggingPositions()),
+		MarkedForChopping:       len(gm.MarkedForChoppingPositions()),
+	}
+	for _, item := range gm.Items() {
+		summary.Items[item.ItemType]++
+	}
+	for _, c := range gm.Constructs() {
+		summary.Constructs[c.Kind]++
+	}
+
+	obs := AgentObservation{
+		Tick:     e.tick,
+		GameTime: m.elapsedGameTime,
+		Day:      system.WorldDay(m.elapsedGameTime),
+		Done:     true,
+		Map:      summary,
+		Orders:   ordersToResponse(openOrders(m.orders)),
+		Results:  results,
+	}
+	for _, char := range gm.Characters() {
+		if !char.IsDead {
+			obs.Done = false
+		}
+		pos := char.Pos()
+		known := append([]string(nil), char.KnownActivities...)
+		sort.Strings(known)
+		obs.Characters = append(obs.Characters, AgentCharacter{
+			ID:              char.ID,
+			Name:            char.Name,
+			X:               pos.X,
+			Y:               pos.Y,
+			Health:          char.Health,
+			Hunger:          char.Hunger,
+			Thirst:          char.Thirst,
+			Energy:          char.Energy,
+			Warmth:          char.Warmth,
+			Mood:            char.Mood,
+			Activity:        char.CurrentActivity,
+			IsSleeping:      char.IsSleeping,
+			IsDead:          char.IsDead,
+			AssignedOrderID: char.AssignedOrderID,
+			KnownActivities: known,
+		})
+	}
+	return obs
+}
+
+// Serve runs the protocol over newline-delimited JSON: one AgentRequest per
+// input line, one AgentObservation per output line. Returns at EOF.
+func (e *AgentEnv) Serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req AgentRequest
+		var obs AgentObservation
+		if err := json.Unmarshal(line, &req); err != nil {
+			obs = AgentObservation{Error: "invalid request: " + err.Error()}
+		} else {
+			switch req.Type {
+			case "reset":
+				obs = e.Reset(req.Seed)
+			case "step":
+				obs = e.Step(req.Actions, req.Ticks)
+			default:
+				obs = AgentObservation{Error: fmt.Sprintf("unknown request type %q", req.Type)}
+			}
+		}
+
+		if err := enc.Encode(obs); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// openOrders returns orders that have not been completed
+func openOrders(orders []*entity.Order) []*entity.Order {
+	var result []*entity.Order
+	for _, order := range orders {
+		if order.Status != entity.OrderCompleted {
+			result = append(result, order)
+		}
+	}
+	return result
+}
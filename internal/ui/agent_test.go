@@ -0,0 +1,334 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/types"
+)
+
+// Not parallel: reset seeds the shared random source, so other tests must not
+// draw from it while the worlds are generated and stepped.
+func TestAgentEnv_ResetWithSameSeedIsReproducible(t *testing.T) {
+	env := NewAgentEnv(TestConfig{}, 5)
+	first := env.Reset(42)
+	firstPositions := characterPositions(first)
+
+	second := env.Reset(42)
+	if !reflect.DeepEqual(firstPositions, characterPositions(second)) {
+		t.Errorf("Expected same character positions, got %v and %v", firstPositions, characterPositions(second))
+	}
+	if !reflect.DeepEqual(first.Map, second.Map) {
+		t.Errorf("Expected same map summary, got %+v and %+v", first.Map, second.Map)
+	}
+	if first.Characters[0].Name != second.Characters[0].Name {
+		t.Errorf("Expected same names, got %s and %s", first.Characters[0].Name, second.Characters[0].Name)
+	}
+
+	// Stepping the same actions from the same seed must replay the same run
+	firstRun := runSeededEpisode(env, 7)
+	secondRun := runSeededEpisode(env, 7)
+	for i := range firstRun {
+		if !reflect.DeepEqual(firstRun[i], secondRun[i]) {
+			t.Fatalf("Expected the same observation at tick %d, got\n%+v\nand\n%+v", firstRun[i].Tick, firstRun[i], secondRun[i])
+		}
+	}
+}
+
+// runSeededEpisode resets to seed, sets the characters tilling and gathering, and returns
+// an observation every 100 ticks for 2000 ticks
+func runSeededEpisode(env *AgentEnv, seed int64) []AgentObservation {
+	env.Reset(seed)
+	for _, c := range env.model.gameMap.Characters() {
+		c.LearnActivity("tillSoil")
+		c.AddToInventory(entity.NewHoe(0, 0, types.ColorSilver))
+	}
+	actions := []AgentAction{
+		{Type: "mark_till", Anchor: types.Position{X: 20, Y: 20}, Cursor: types.Position{X: 26, Y: 24}},
+		{Type: "create_order", ActivityID: "tillSoil"},
+		{Type: "create_order", ActivityID: "gather", TargetType: "stick"},
+	}
+	var observations []AgentObservation
+	for i := 0; i < 20; i++ {
+		observations = append(observations, env.Step(actions, 100))
+		actions = nil
+	}
+	return observations
+}
+
+// characterPositions returns character positions keyed by ID
+func characterPositions(obs AgentObservation) map[int]types.Position {
+	result := make(map[int]types.Position)
+	for _, c := range obs.Characters {
+		result[c.ID] = types.Position{X: c.X, Y: c.Y}
+	}
+	return result
+}
+
+func TestAgentEnv_StepRequiresReset(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 5)
+	obs := env.Step(nil, 1)
+	if obs.Error == "" {
+		t.Error("Expected error stepping before reset")
+	}
+}
+
+func TestAgentEnv_StepRunsObservationInterval(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 5)
+	env.Reset(1)
+
+	obs := env.Step(nil, 0)
+	if obs.Tick != 5 {
+		t.Errorf("Expected tick 5 after default step, got %d", obs.Tick)
+	}
+	obs = env.Step(nil, 3)
+	if obs.Tick != 8 {
+		t.Errorf("Expected tick 8 after stepping 3 more, got %d", obs.Tick)
+	}
+	if obs.GameTime <= 0 {
+		t.Error("Expected game time to advance")
+	}
+}
+
+func TestAgentEnv_StepRejectsTooManyTicks(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(1)
+	obs := env.Step([]AgentAction{{Type: "noop"}}, config.MaxStepTicks+1)
+	if obs.Error == "" || obs.Tick != 0 {
+		t.Errorf("Expected an error and no ticks run, got tick %d error %q", obs.Tick, obs.Error)
+	}
+}
+
+func TestAgentEnv_ActionsReportResults(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	obs := env.Reset(3)
+	charID := obs.Characters[0].ID
+
+	obs = env.Step([]AgentAction{
+		{Type: "rename", CharacterID: charID, Name: "Robin"},
+		{Type: "mark_till", Anchor: types.Position{X: 0, Y: 0}, Cursor: types.Position{X: 1, Y: 0}},
+		{Type: "create_order", ActivityID: "notAnActivity"},
+		{Type: "cancel_order", OrderID: 99},
+		{Type: "fly"},
+		{Type: "noop"},
+	}, 1)
+
+	want := []bool{true, true, false, false, false, true}
+	if len(obs.Results) != len(want) {
+		t.Fatalf("Expected %d results, got %d", len(want), len(obs.Results))
+	}
+	for i, ok := range want {
+		if obs.Results[i].OK != ok {
+			t.Errorf("Action %d: expected ok=%v, got %+v", i, ok, obs.Results[i])
+		}
+	}
+
+	for _, c := range obs.Characters {
+		if c.ID == charID && c.Name != "Robin" {
+			t.Errorf("Expected renamed character, got %s", c.Name)
+		}
+	}
+	if obs.Map.MarkedForTilling == 0 {
+		t.Error("Expected tiles marked for tilling")
+	}
+}
+
+// openAgentRow finds n open tiles in a row on the env's map, for placing marks
+func openAgentRow(t *testing.T, env *AgentEnv, n int) []types.Position {
+	t.Helper()
+	gm := env.model.gameMap
+	for y := 0; y < gm.Height; y++ {
+		run := 0
+		for x := 0; x < gm.Width; x++ {
+			pos := types.Position{X: x, Y: y}
+			if !isValidFenceTarget(pos, gm) || !isValidDigTarget(pos, gm) {
+				run = 0
+				continue
+			}
+			run++
+			if run == n {
+				row := make([]types.Position, n)
+				for i := range row {
+					row[i] = types.Position{X: x - n + 1 + i, Y: y}
+				}
+				return row
+			}
+		}
+	}
+	t.Fatalf("Expected a row of %d open tiles", n)
+	return nil
+}
+
+// assertAgentResults checks each action result's ok flag against want
+func assertAgentResults(t *testing.T, obs AgentObservation, want ...bool) {
+	t.Helper()
+	if len(obs.Results) != len(want) {
+		t.Fatalf("Expected %d results, got %d", len(want), len(obs.Results))
+	}
+	for i, ok := range want {
+		if obs.Results[i].OK != ok {
+			t.Errorf("Action %d: expected ok=%v, got %+v", i, ok, obs.Results[i])
+		}
+	}
+}
+
+func TestAgentEnv_MarkDeconstruct(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(3)
+	gm := env.model.gameMap
+	pos := openAgentRow(t, env, 1)[0]
+	gm.AddConstruct(entity.NewFence(pos.X, pos.Y, "stick", types.ColorBrown))
+
+	obs := env.Step([]AgentAction{{Type: "mark_deconstruct", Anchor: pos, Cursor: pos}}, 0)
+	assertAgentResults(t, obs, true)
+	if !gm.IsMarkedForDeconstruction(pos) || obs.Map.MarkedForDeconstruction != 1 {
+		t.Errorf("Expected the fence marked for deconstruction, got %d marked", obs.Map.MarkedForDeconstruction)
+	}
+
+	env.Step([]AgentAction{{Type: "mark_deconstruct", Anchor: pos, Cursor: pos, Unmark: true}}, 0)
+	if gm.IsMarkedForDeconstruction(pos) {
+		t.Error("Expected the deconstruction mark cleared")
+	}
+}
+
+func TestAgentEnv_MarkCarve(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(3)
+	gm := env.model.gameMap
+	pos := openAgentRow(t, env, 1)[0]
+	gm.SetTree(pos, game.TreeHeartwood)
+
+	obs := env.Step([]AgentAction{{Type: "mark_carve", Anchor: pos, Cursor: pos}}, 0)
+	assertAgentResults(t, obs, true)
+	if !gm.IsMarkedForCarving(pos) || obs.Map.MarkedForCarving != 1 {
+		t.Errorf("Expected the heartwood marked for carving, got %d marked", obs.Map.MarkedForCarving)
+	}
+
+	env.Step([]AgentAction{{Type: "mark_carve", Anchor: pos, Cursor: pos, Unmark: true}}, 0)
+	if gm.IsMarkedForCarving(pos) {
+		t.Error("Expected the carving mark cleared")
+	}
+}
+
+func TestAgentEnv_MarkChannel(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(3)
+	gm := env.model.gameMap
+	row := openAgentRow(t, env, 3)
+
+	obs := env.Step([]AgentAction{{Type: "mark_channel", Anchor: row[0], Cursor: row[2]}}, 0)
+	assertAgentResults(t, obs, true)
+	if obs.Map.MarkedForDigging != 3 {
+		t.Errorf("Expected 3 tiles marked for digging, got %d", obs.Map.MarkedForDigging)
+	}
+
+	env.Step([]AgentAction{{Type: "mark_channel", Anchor: row[0], Cursor: row[2], Unmark: true}}, 0)
+	if len(gm.MarkedForDiggingPositions()) != 0 {
+		t.Error("Expected the channel marks cleared")
+	}
+}
+
+func TestAgentEnv_MarkCampfire(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(3)
+	gm := env.model.gameMap
+	pos := openAgentRow(t, env, 1)[0]
+
+	obs := env.Step([]AgentAction{
+		{Type: "mark_campfire", Anchor: pos},
+		{Type: "mark_campfire", Anchor: pos},
+	}, 0)
+	assertAgentResults(t, obs, true, false)
+	if mark, ok := gm.GetConstructionMark(pos); !ok || mark.ConstructKind != "campfire" {
+		t.Errorf("Expected campfire mark at %v, got %+v", pos, mark)
+	}
+
+	obs = env.Step([]AgentAction{
+		{Type: "mark_campfire", Anchor: pos, Unmark: true},
+		{Type: "mark_campfire", Anchor: pos, Unmark: true},
+	}, 0)
+	assertAgentResults(t, obs, true, false)
+	if _, ok := gm.GetConstructionMark(pos); ok {
+		t.Error("Expected campfire mark cleared")
+	}
+}
+
+func TestAgentEnv_MarkChop(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 1)
+	env.Reset(3)
+	gm := env.model.gameMap
+	pos := openAgentRow(t, env, 1)[0]
+	gm.SetTree(pos, game.TreeLivewood)
+
+	obs := env.Step([]AgentAction{{Type: "mark_chop", Anchor: pos, Cursor: pos}}, 0)
+	assertAgentResults(t, obs, true)
+	if !gm.IsMarkedForChopping(pos) || obs.Map.MarkedForChopping != 1 {
+		t.Errorf("Expected the tree marked for chopping, got %d marked", obs.Map.MarkedForChopping)
+	}
+
+	env.Step([]AgentAction{{Type: "mark_chop", Anchor: pos, Cursor: pos, Unmark: true}}, 0)
+	if gm.IsMarkedForChopping(pos) {
+		t.Error("Expected the chopping mark cleared")
+	}
+}
+
+func TestAgentEnv_ServeLineProtocol(t *testing.T) {
+	t.Parallel()
+
+	env := NewAgentEnv(TestConfig{}, 2)
+	in := strings.NewReader(`{"type":"reset","seed":9}
+{"type":"step","actions":[{"type":"noop"}]}
+not json
+{"type":"jump"}
+`)
+	var out bytes.Buffer
+	if err := env.Serve(in, &out); err != nil {
+		t.Fatalf("Serve: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected 4 observation lines, got %d", len(lines))
+	}
+	var obs []AgentObservation
+	for _, line := range lines {
+		var o AgentObservation
+		if err := json.Unmarshal([]byte(line), &o); err != nil {
+			t.Fatalf("decode %q: %v", line, err)
+		}
+		obs = append(obs, o)
+	}
+	if obs[0].Tick != 0 || len(obs[0].Characters) == 0 {
+		t.Errorf("Expected reset observation with characters, got %+v", obs[0])
+	}
+	if obs[1].Tick != 2 {
+		t.Errorf("Expected tick 2 after step, got %d", obs[1].Tick)
+	}
+	if obs[2].Error == "" || obs[3].Error == "" {
+		t.Error("Expected errors for malformed and unknown requests")
+	}
+}
@@ -1,12 +1,11 @@
 package ui
 
 import (
-	"fmt"
-	"math/rand"
-
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/rng"
 	"petri/internal/system"
 	"petri/internal/types"
 )
@@ -62,6 +61,28 @@ func (m *Model) applyIntent(char *entity.Character, delta float64) {
 		m.applyBuildFence(char, delta)
 	case entity.ActionBuildHut:
 		m.applyBuildHut(char, delta)
+	case entity.ActionFlee:
+		m.applyFlee(char, delta)
+	case entity.ActionShoo:
+		m.applyShoo(char, delta)
+	case entity.ActionDeconstruct:
+		m.applyDeconstruct(char, delta)
+	case entity.ActionCarve:
+		m.applyCarve(char, delta)
+	case entity.ActionChop:
+		m.applyChop(char, delta)
+	case entity.ActionDigChannel:
+		m.applyDigChannel(char, delta)
+	case entity.ActionCompost:
+		m.applyCompost(char, delta)
+	case entity.ActionBuildCampfire:
+		m.applyBuildCampfire(char, delta)
+	case entity.ActionCook:
+		m.applyCook(char, delta)
+	case entity.ActionExtinguish:
+		m.applyExtinguish(char, delta)
+	case entity.ActionWarmUp, entity.ActionShelter:
+		m.applyWarmUp(char, delta)
 	}
 }
 
@@ -85,9 +106,9 @@ func (m *Model) applyMove(char *entity.Character, delta float64) {
 				// At target item - eating in progress, duration varies by food tier
 				// Update activity from "Moving to X" to "Eating X"
 				if isVesselWithFood {
-					char.CurrentActivity = "Eating " + targetItem.Container.Contents[0].Variety.Description() + " from vessel"
+					char.CurrentActivity = i18n.T("doing.eating_from_vessel", targetItem.Container.Contents[0].Variety.Description())
 				} else {
-					char.CurrentActivity = "Eating " + targetItem.Description()
+					char.CurrentActivity = i18n.T("doing.eating", targetItem.Description())
 				}
 				duration := config.GetMealSize(getEatenItemType(targetItem)).Duration
 				char.ActionProgress += delta
@@ -173,16 +194,16 @@ func (m *Model) applyMove(char *entity.Character, delta float64) {
 		// Log energy milestones crossed by movement drain
 		if !char.IsSleeping && m.actionLog != nil {
 			if prevEnergy > 50 && char.Energy <= 50 {
-				m.actionLog.Add(char.ID, char.Name, "energy", "Getting tired")
+				m.actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.mild")
 			}
 			if prevEnergy > 25 && char.Energy <= 25 {
-				m.actionLog.Add(char.ID, char.Name, "energy", "Very tired!")
+				m.actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.moderate")
 			}
 			if prevEnergy > 10 && char.Energy <= 10 {
-				m.actionLog.Add(char.ID, char.Name, "energy", "Exhausted!")
+				m.actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.severe")
 			}
 			if prevEnergy > 0 && char.Energy <= 0 {
-				m.actionLog.Add(char.ID, char.Name, "energy", "Collapsed from exhaustion!")
+				m.actionLog.AddMessage(char.ID, char.Name, "energy", "log.energy.crisis")
 			}
 		}
 	}
@@ -291,6 +312,47 @@ func (m *Model) applyTalk(char *entity.Character, delta float64) {
 	}
 }
 
+// applyFlee handles ActionFlee: run toward shelter. The intent ends on arrival
+// (fear cost and logging happen when the flight starts).
+func (m *Model) applyFlee(char *entity.Character, delta float64) {
+	cpos := char.Pos()
+	if cpos == char.Intent.Dest {
+		char.Intent = nil
+		return
+	}
+	m.moveWithCollision(char, cpos, delta)
+}
+
+// applyWarmUp handles ActionWarmUp and ActionShelter: walk indoors, then stay put
+// (the intent is recalculated each tick until warmth is no longer a need or the storm passes).
+func (m *Model) applyWarmUp(char *entity.Character, delta float64) {
+	cpos := char.Pos()
+	if cpos == char.Intent.Dest {
+		return
+	}
+	m.moveWithCollision(char, cpos, delta)
+}
+
+// applyShoo handles ActionShoo: walk up to the creature, then chase it off.
+func (m *Model) applyShoo(char *entity.Character, delta float64) {
+	target := char.Intent.TargetCreature
+	if target == nil {
+		return
+	}
+	cpos := char.Pos()
+	if !cpos.IsAdjacentTo(target.Pos()) {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationShort {
+		char.ActionProgress = 0
+		system.ShooCreature(char, target, m.actionLog)
+		char.Intent = nil
+	}
+}
+
 // applyPickup handles ActionPickup: timed item pickup used by harvest orders and
 // order prerequisites. Handles vessel filling continuation and order completion.
 func (m *Model) applyPickup(char *entity.Character, delta float64) {
@@ -349,7 +411,7 @@ func (m *Model) applyPickup(char *entity.Character, delta float64) {
 					// Order complete or no continuation target - go idle
 					char.Intent = nil
 					char.IdleCooldown = config.IdleCooldown
-					char.CurrentActivity = "Idle"
+					char.CurrentActivity = i18n.T("doing.idle")
 					return
 				}
 
@@ -380,7 +442,7 @@ func (m *Model) applyPickup(char *entity.Character, delta float64) {
 					}
 					char.Intent = nil
 					char.IdleCooldown = config.IdleCooldown
-					char.CurrentActivity = "Idle"
+					char.CurrentActivity = i18n.T("doing.idle")
 					return
 				}
 
@@ -389,7 +451,7 @@ func (m *Model) applyPickup(char *entity.Character, delta float64) {
 				if result == system.PickupFailed {
 					char.Intent = nil
 					char.IdleCooldown = config.IdleCooldown
-					char.CurrentActivity = "Idle"
+					char.CurrentActivity = i18n.T("doing.idle")
 					return
 				}
 
@@ -505,7 +567,7 @@ func (m *Model) applyCraft(char *entity.Character, delta float64) {
 	}
 
 	char.ActionProgress += delta
-	if char.ActionProgress >= recipe.Duration {
+	if char.ActionProgress >= recipe.CraftDuration() {
 		char.ActionProgress = 0
 
 		// Consume all recipe inputs
@@ -528,6 +590,12 @@ func (m *Model) applyCraft(char *entity.Character, delta float64) {
 			crafted = system.CreateHoe(consumed["shell"], recipe)
 		case "clay-brick":
 			crafted = system.CreateBrick(consumed["clay"], recipe)
+		case "shell-chisel":
+			crafted = system.CreateChisel(consumed["shell"], recipe)
+		case "stone-flake":
+			crafted = system.CreateFlake(consumed["stone"], recipe)
+		case "stone-axe":
+			crafted = system.CreateAxe(consumed["stone"], recipe)
 		}
 
 		if crafted != nil {
@@ -538,7 +606,7 @@ func (m *Model) applyCraft(char *entity.Character, delta float64) {
 
 		// Log the craft
 		if m.actionLog != nil {
-			m.actionLog.Add(char.ID, char.Name, "activity", "Crafted "+recipe.Name)
+			m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.crafted", recipe.Name)
 		}
 
 		// Complete the order (skip for repeatable recipes — order loops until world-state condition is met)
@@ -548,7 +616,7 @@ func (m *Model) applyCraft(char *entity.Character, delta float64) {
 			}
 		}
 
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 	}
 }
@@ -596,10 +664,10 @@ func (m *Model) applyTillSoil(char *entity.Character, delta float64) {
 		}
 
 		if m.actionLog != nil {
-			m.actionLog.Add(char.ID, char.Name, "activity", "Tilled soil")
+			m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.tilled")
 		}
 
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 
 		// Check if till order is complete (pool exhausted)
@@ -643,7 +711,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 			order = m.findOrderByID(char.AssignedOrderID)
 		}
 		if order == nil {
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -651,7 +719,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 		// Consume a plantable item matching the order
 		plantedItem := system.ConsumePlantable(char, order.TargetType, order.LockedVariety)
 		if plantedItem == nil {
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -667,7 +735,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 			parentVariety = registry.GetByAttributes(plantedItem.ItemType, plantedItem.Kind, plantedItem.Color, plantedItem.Pattern, plantedItem.Texture)
 		}
 		if parentVariety == nil {
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -678,6 +746,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 		// Create sprout from the parent variety
 		sprout := entity.CreateSprout(dest.X, dest.Y, parentVariety)
 		m.gameMap.AddItem(sprout)
+		m.gameMap.PlantSoil(dest, parentVariety.ItemType)
 
 		// Lock the variety on the order (subsequent plants use same variety)
 		if order.LockedVariety == "" {
@@ -687,7 +756,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 		}
 
 		if m.actionLog != nil {
-			m.actionLog.Add(char.ID, char.Name, "activity", fmt.Sprintf("Planted %s", plantedItem.Description()))
+			m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.planted", plantedItem.Description())
 		}
 
 		// Check if plant order is complete (no more tiles or no more items)
@@ -696,7 +765,7 @@ func (m *Model) applyPlant(char *entity.Character, delta float64) {
 			system.CompleteOrder(char, order, m.actionLog)
 		}
 
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 	}
 }
@@ -726,7 +795,7 @@ func (m *Model) applyForage(char *entity.Character, delta float64) {
 			foodIntent := system.FindForageFoodIntent(char, cpos, m.gameMap.Items(), m.actionLog, m.gameMap)
 			if foodIntent == nil {
 				// No food available — go idle
-				char.CurrentActivity = "Idle"
+				char.CurrentActivity = i18n.T("doing.idle")
 				char.Intent = nil
 				char.IdleCooldown = config.IdleCooldown
 				return
@@ -738,7 +807,7 @@ func (m *Model) applyForage(char *entity.Character, delta float64) {
 
 	// Phase 2: food pickup
 	if target == nil {
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 		return
 	}
@@ -757,7 +826,7 @@ func (m *Model) applyForage(char *entity.Character, delta float64) {
 			// For PickupToVessel, Pickup does NOT clear intent, so we do it explicitly
 			char.Intent = nil
 			char.IdleCooldown = config.IdleCooldown
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 		}
 		return
 	}
@@ -789,7 +858,7 @@ func (m *Model) applyFillVessel(char *entity.Character, delta float64) {
 		// Vessel in hand — check if already has water (ground water vessel pickup)
 		if vessel != nil && vessel.Container != nil && len(vessel.Container.Contents) > 0 {
 			// Already has water — mission accomplished, go idle
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -807,7 +876,7 @@ func (m *Model) applyFillVessel(char *entity.Character, delta float64) {
 	case system.FillFailed:
 		return
 	case system.FillReady:
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 	}
 }
@@ -838,7 +907,7 @@ func (m *Model) applyWaterGarden(char *entity.Character, delta float64) {
 		case system.ProcureReady:
 			// Vessel in hand — clear intent so findWaterGardenIntent
 			// re-evaluates for Phase 2 (fill) or Phase 3 (water tiles)
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -859,7 +928,7 @@ func (m *Model) applyWaterGarden(char *entity.Character, delta float64) {
 		case system.FillReady:
 			// Vessel filled — clear intent so findWaterGardenIntent
 			// re-evaluates for Phase 3 (water tiles)
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -873,8 +942,8 @@ func (m *Model) applyWaterGarden(char *entity.Character, delta float64) {
 	}
 
 	// At destination — accumulate watering progress
-	if char.CurrentActivity != "Watering garden" {
-		char.CurrentActivity = "Watering garden"
+	if char.CurrentActivity != i18n.T("doing.watering") {
+		char.CurrentActivity = i18n.T("doing.watering")
 	}
 	char.ActionProgress += delta
 	if char.ActionProgress >= config.ActionDurationShort {
@@ -889,10 +958,10 @@ func (m *Model) applyWaterGarden(char *entity.Character, delta float64) {
 		}
 
 		if m.actionLog != nil {
-			m.actionLog.Add(char.ID, char.Name, "activity", "Watered the garden")
+			m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.watered")
 		}
 
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 
 		// Check order completion — no dry tilled planted tiles remain
@@ -926,7 +995,7 @@ func (m *Model) applyHelpFeed(char *entity.Character, delta float64) {
 				}
 			}
 		}
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 		return
 	}
@@ -948,7 +1017,7 @@ func (m *Model) applyHelpFeed(char *entity.Character, delta float64) {
 						// Food absorbed into carried vessel — deliver the vessel instead
 						deliveryItem = char.GetCarriedVessel()
 					} else if result == system.PickupFailed {
-						char.CurrentActivity = "Idle"
+						char.CurrentActivity = i18n.T("doing.idle")
 						char.Intent = nil
 						return
 					}
@@ -963,9 +1032,9 @@ func (m *Model) applyHelpFeed(char *entity.Character, delta float64) {
 						TargetItem:      deliveryItem,
 						TargetCharacter: needer,
 					}
-					char.CurrentActivity = "Bringing food to " + needer.Name
+					char.CurrentActivity = i18n.T("doing.bringing_food_to", needer.Name)
 					if m.actionLog != nil {
-						m.actionLog.Add(char.ID, char.Name, "activity", "Bringing food to "+needer.Name)
+						m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.help.bringing_food", needer.Name)
 					}
 				}
 				return
@@ -984,7 +1053,7 @@ func (m *Model) applyHelpFeed(char *entity.Character, delta float64) {
 		}
 		if !inInventory {
 			// Food gone, not in inventory — give up
-			char.CurrentActivity = "Idle"
+			char.CurrentActivity = i18n.T("doing.idle")
 			char.Intent = nil
 			return
 		}
@@ -1005,28 +1074,26 @@ func (m *Model) applyHelpFeed(char *entity.Character, delta float64) {
 					target.Y = dropPos.Y
 					m.gameMap.AddItem(target)
 					if m.actionLog != nil {
-						m.actionLog.Add(char.ID, char.Name, "activity",
-							"Brought "+target.Description()+" to "+needer.Name)
+						m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.help.brought", target.Description(), needer.Name)
 					}
 					// Signal the needer to re-evaluate — clear their current intent
 					// so they notice the closer food on their next tick
 					needer.Intent = nil
 					if m.actionLog != nil {
-						m.actionLog.Add(char.ID, char.Name, "social",
-							char.Name+" called out to "+needer.Name)
+						m.actionLog.AddMessage(char.ID, char.Name, "social", "log.help.called_out", char.Name, needer.Name)
 					}
 					break
 				}
 			}
 		}
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 		char.IdleCooldown = config.IdleCooldown
 		return
 	}
 
 	// Not adjacent yet — move toward needer
-	char.CurrentActivity = "Bringing food to " + needer.Name
+	char.CurrentActivity = i18n.T("doing.bringing_food_to", needer.Name)
 	m.moveWithCollision(char, cpos, delta)
 }
 
@@ -1051,7 +1118,7 @@ func (m *Model) applyHelpWater(char *entity.Character, delta float64) {
 				}
 			}
 		}
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 		return
 	}
@@ -1081,9 +1148,9 @@ func (m *Model) applyHelpWater(char *entity.Character, delta float64) {
 					TargetItem:      vessel,
 					TargetCharacter: needer,
 				}
-				char.CurrentActivity = "Bringing water to " + needer.Name
+				char.CurrentActivity = i18n.T("doing.bringing_water_to", needer.Name)
 				if m.actionLog != nil {
-					m.actionLog.Add(char.ID, char.Name, "activity", "Bringing water to "+needer.Name)
+					m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.help.bringing_water", needer.Name)
 				}
 				return
 			}
@@ -1118,9 +1185,9 @@ func (m *Model) applyHelpWater(char *entity.Character, delta float64) {
 				TargetItem:      vessel,
 				TargetCharacter: needer,
 			}
-			char.CurrentActivity = "Bringing water to " + needer.Name
+			char.CurrentActivity = i18n.T("doing.bringing_water_to", needer.Name)
 			if m.actionLog != nil {
-				m.actionLog.Add(char.ID, char.Name, "activity", "Bringing water to "+needer.Name)
+				m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.help.bringing_water", needer.Name)
 			}
 			return
 		}
@@ -1139,27 +1206,25 @@ func (m *Model) applyHelpWater(char *entity.Character, delta float64) {
 					vessel.Y = dropPos.Y
 					m.gameMap.AddItem(vessel)
 					if m.actionLog != nil {
-						m.actionLog.Add(char.ID, char.Name, "activity",
-							"Brought water to "+needer.Name)
+						m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.help.brought_water", needer.Name)
 					}
 					// Signal the needer to re-evaluate
 					needer.Intent = nil
 					if m.actionLog != nil {
-						m.actionLog.Add(char.ID, char.Name, "social",
-							char.Name+" called out to "+needer.Name)
+						m.actionLog.AddMessage(char.ID, char.Name, "social", "log.help.called_out", char.Name, needer.Name)
 					}
 					break
 				}
 			}
 		}
-		char.CurrentActivity = "Idle"
+		char.CurrentActivity = i18n.T("doing.idle")
 		char.Intent = nil
 		char.IdleCooldown = config.IdleCooldown
 		return
 	}
 
 	// Not adjacent yet — move toward needer
-	char.CurrentActivity = "Bringing water to " + needer.Name
+	char.CurrentActivity = i18n.T("doing.bringing_water_to", needer.Name)
 	m.moveWithCollision(char, cpos, delta)
 }
 
@@ -1172,7 +1237,7 @@ func findEmptyCardinalTile(center types.Position, avoidPos types.Position, gameM
 		if pos == avoidPos {
 			continue // Skip helper's position
 		}
-		if !gameMap.IsValid(pos) || gameMap.IsWater(pos) {
+		if !gameMap.IsValid(pos) || gameMap.IsWater(pos) || gameMap.IsSolidTree(pos) || gameMap.IsOutcrop(pos) {
 			continue
 		}
 		if occupant := gameMap.CharacterAt(pos); occupant != nil {
@@ -1212,7 +1277,7 @@ func findEmptyAdjacentTile(center types.Position, avoidPos types.Position, gameM
 		if pos == avoidPos {
 			continue
 		}
-		if !gameMap.IsValid(pos) || gameMap.IsWater(pos) {
+		if !gameMap.IsValid(pos) || gameMap.IsWater(pos) || gameMap.IsSolidTree(pos) || gameMap.IsOutcrop(pos) {
 			continue
 		}
 		if f := gameMap.FeatureAt(pos); f != nil && !f.IsPassable() {
@@ -1322,7 +1387,7 @@ func (m *Model) initiateDisplacement(char *entity.Character, cx, cy, tx, ty int)
 
 	// Randomly select primary and secondary perpendicular directions
 	var pDX, pDY, sDX, sDY int
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		pDX, pDY, sDX, sDY = perp1DX, perp1DY, perp2DX, perp2DY
 	} else {
 		pDX, pDY, sDX, sDY = perp2DX, perp2DY, perp1DX, perp1DY
@@ -1521,7 +1586,7 @@ func (m *Model) applyExtract(char *entity.Character, delta float64) {
 	if !routed {
 		// No room for seeds — log and pause
 		if m.actionLog != nil {
-			m.actionLog.Add(char.ID, char.Name, "extract", "No room for seeds")
+			m.actionLog.AddMessage(char.ID, char.Name, "extract", "log.no_room_for_seeds")
 		}
 		char.Intent = nil
 		return
@@ -1529,7 +1594,7 @@ func (m *Model) applyExtract(char *entity.Character, delta float64) {
 
 	// Set seed timer on the plant
 	if cfg, ok := config.ItemLifecycle[plant.ItemType]; ok {
-		plant.Plant.SeedTimer = cfg.SpawnInterval * float64(len(m.gameMap.Items()))
+		plant.Plant.SeedTimer = cfg.SpawnSeconds() * float64(len(m.gameMap.Items()))
 	}
 
 	// Lock the variety on the order (subsequent extractions target same variety)
@@ -1542,8 +1607,7 @@ func (m *Model) applyExtract(char *entity.Character, delta float64) {
 	}
 
 	if m.actionLog != nil {
-		m.actionLog.Add(char.ID, char.Name, "activity",
-			fmt.Sprintf("Extracted %s from %s", seed.Kind, plant.Description()))
+		m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.extracted", seed.Kind, plant.Description())
 	}
 
 	// Check if extract order is complete: inventory full and no vessel can accept more seeds
@@ -1589,7 +1653,7 @@ func (m *Model) applyDig(char *entity.Character, delta float64) {
 	char.AddToInventory(clay)
 
 	if m.actionLog != nil {
-		m.actionLog.Add(char.ID, char.Name, "activity", "Dug clay")
+		m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.dug_clay")
 	}
 
 	// Clear intent — ordered action pattern: next tick re-evaluates via findDigIntent
@@ -1627,8 +1691,8 @@ func (m *Model) applyBuildFence(char *entity.Character, delta float64) {
 	}
 
 	// Working phase: accumulate progress
-	if char.CurrentActivity != "Building fence" {
-		char.CurrentActivity = "Building fence"
+	if char.CurrentActivity != i18n.T("doing.building_fence") {
+		char.CurrentActivity = i18n.T("doing.building_fence")
 	}
 	char.ActionProgress += delta
 	if char.ActionProgress < config.ActionDurationMedium {
@@ -1712,13 +1776,310 @@ func (m *Model) applyBuildFence(char *entity.Character, delta float64) {
 	}
 
 	if m.actionLog != nil {
-		m.actionLog.Add(char.ID, char.Name, "activity", fmt.Sprintf("Built %s fence", material))
+		m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.built", fence.DisplayName())
 	}
 
 	// Clear intent — ordered action pattern: next tick re-evaluates via findBuildFenceIntent
 	char.Intent = nil
 }
 
+// applyDeconstruct handles ActionDeconstruct: walk to a tile beside the marked construct, then
+// dismantle it with ActionDurationMedium. Ordered action pattern: clear intent afterwards so the
+// next tick re-evaluates via findDeconstructIntent.
+func (m *Model) applyDeconstruct(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+	construct := m.gameMap.ConstructAt(targetPos)
+	if construct == nil || !m.gameMap.IsMarkedForDeconstruction(targetPos) {
+		char.Intent = nil // Already gone or unmarked — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.deconstructing") {
+		char.CurrentActivity = i18n.T("doing.deconstructing")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.DeconstructConstruct(m.gameMap, construct, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyCarve handles ActionCarve: walk to a tile beside the marked tree tile, then carve it
+// with ActionDurationMedium. Ordered action pattern: clear intent afterwards so the next tick
+// re-evaluates via findCarveIntent.
+func (m *Model) applyCarve(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+	if !m.gameMap.IsMarkedForCarving(targetPos) || !m.gameMap.CanCarve(targetPos) {
+		char.Intent = nil // Already carved or unmarked — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.carving") {
+		char.CurrentActivity = i18n.T("doing.carving")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.CarveTreeTile(m.gameMap, targetPos, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyChop handles ActionChop: walk to a tile beside the marked tree tile, then fell it
+// with ActionDurationMedium. Ordered action pattern: clear intent afterwards so the next tick
+// re-evaluates via findChopIntent.
+func (m *Model) applyChop(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+	if !m.gameMap.IsMarkedForChopping(targetPos) || !m.gameMap.CanChop(targetPos) {
+		char.Intent = nil // Already chopped or unmarked — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.chopping") {
+		char.CurrentActivity = i18n.T("doing.chopping")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.ChopTreeTile(m.gameMap, targetPos, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyDigChannel handles ActionDigChannel: walk to a tile beside the channel tile, then dig it
+// out (or clear its silt) with ActionDurationMedium. Ordered action pattern: clear intent afterwards
+// so the next tick re-evaluates via findDigChannelIntent.
+func (m *Model) applyDigChannel(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+	if !system.IsChannelWork(m.gameMap, targetPos) {
+		char.Intent = nil // Already dug, cleared or unmarked — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet at the standing tile
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.digging_channel") {
+		char.CurrentActivity = i18n.T("doing.digging_channel")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.DigChannelTile(m.gameMap, targetPos, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyCompost handles ActionCompost: walk onto the tilled tile, then work a shell into it
+// with ActionDurationMedium. Ordered action pattern: clear intent afterwards so the next tick
+// re-evaluates via findCompostIntent.
+func (m *Model) applyCompost(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+	if !m.gameMap.CanCompost(targetPos) {
+		char.Intent = nil // Already composted to the full — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet on the tile
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.composting") {
+		char.CurrentActivity = i18n.T("doing.composting")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.CompostTile(m.gameMap, targetPos, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyBuildCampfire handles ActionBuildCampfire: walk beside the marked tile or campfire, then
+// build or feed the fire
+func (m *Model) applyBuildCampfire(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	targetPos := *char.Intent.TargetBuildPos
+
+	// Walking phase: not yet beside the fire
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.tending_campfire") {
+		char.CurrentActivity = i18n.T("doing.tending_campfire")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	system.BuildCampfire(m.gameMap, targetPos, char, m.actionLog)
+	char.Intent = nil
+}
+
+// applyCook handles ActionCook: walk beside a lit campfire, then cook a piece of carried food.
+// One cooked item completes the order.
+func (m *Model) applyCook(char *entity.Character, delta float64) {
+	if char.Intent.TargetBuildPos == nil {
+		char.Intent = nil
+		return
+	}
+	firePos := *char.Intent.TargetBuildPos
+	if fire := m.gameMap.ConstructAt(firePos); fire == nil || !fire.IsLit() {
+		char.Intent = nil // Fire went out — re-evaluate
+		return
+	}
+
+	// Walking phase: not yet beside the fire
+	cpos := char.Pos()
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+
+	// Working phase: accumulate progress
+	if char.CurrentActivity != i18n.T("doing.cooking") {
+		char.CurrentActivity = i18n.T("doing.cooking")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress < config.ActionDurationMedium {
+		return
+	}
+	char.ActionProgress = 0
+
+	cooked := system.CookItem(m.gameMap, firePos, char, m.actionLog)
+	char.Intent = nil
+	if cooked && char.AssignedOrderID != 0 {
+		if order := m.findOrderByID(char.AssignedOrderID); order != nil && order.ActivityID == "cook" {
+			system.CompleteOrder(char, order, m.actionLog)
+		}
+	}
+}
+
+// applyExtinguish handles ActionExtinguish: self-managing like applyWaterGarden — procure a
+// vessel, fill it, walk beside the burning tile and douse it
+func (m *Model) applyExtinguish(char *entity.Character, delta float64) {
+	cpos := char.Pos()
+	vessel := char.Intent.TargetItem
+	if char.Intent.TargetBuildPos == nil || !m.gameMap.IsBurning(*char.Intent.TargetBuildPos) {
+		char.Intent = nil // Fire is out — re-evaluate
+		return
+	}
+	firePos := *char.Intent.TargetBuildPos
+
+	// Phase 1: vessel procurement (if vessel is on the ground)
+	if vessel != nil && m.gameMap.HasItemOnMap(vessel) {
+		switch system.RunVesselProcurement(char, vessel, m.gameMap, m.actionLog, m.gameMap.Varieties(), delta) {
+		case system.ProcureApproaching:
+			m.moveWithCollision(char, cpos, delta)
+		case system.ProcureReady:
+			char.CurrentActivity = i18n.T("doing.idle")
+			char.Intent = nil
+		}
+		return
+	}
+
+	// Phase 2: fill vessel at water source (vessel in inventory, empty)
+	if vessel != nil && vessel.Container != nil && len(vessel.Container.Contents) == 0 {
+		switch system.RunWaterFill(char, vessel, entity.ActionExtinguish, m.gameMap, m.actionLog, m.gameMap.Varieties(), delta) {
+		case system.FillApproaching:
+			m.moveWithCollision(char, cpos, delta)
+		case system.FillReady:
+			char.CurrentActivity = i18n.T("doing.idle")
+			char.Intent = nil
+		}
+		return
+	}
+
+	// Phase 3: walk beside the fire and douse it
+	if cpos != char.Intent.Dest {
+		m.moveWithCollision(char, cpos, delta)
+		return
+	}
+	if char.CurrentActivity != i18n.T("doing.extinguishing") {
+		char.CurrentActivity = i18n.T("doing.extinguishing")
+	}
+	char.ActionProgress += delta
+	if char.ActionProgress >= config.ActionDurationShort {
+		char.ActionProgress = 0
+		system.ExtinguishFire(m.gameMap, firePos, char, vessel, m.actionLog)
+		char.CurrentActivity = i18n.T("doing.idle")
+		char.Intent = nil
+	}
+}
+
 // hasMaterialInInventory checks if a character has any items of the given type in inventory.
 func (m *Model) hasMaterialInInventory(char *entity.Character, material string) bool {
 	for _, inv := range char.Inventory {
@@ -1793,8 +2154,8 @@ func (m *Model) applyBuildHut(char *entity.Character, delta float64) {
 	}
 
 	// Working phase: accumulate progress
-	if char.CurrentActivity != "Building hut" {
-		char.CurrentActivity = "Building hut"
+	if char.CurrentActivity != i18n.T("doing.building_hut") {
+		char.CurrentActivity = i18n.T("doing.building_hut")
 	}
 	char.ActionProgress += delta
 	if char.ActionProgress < config.ActionDurationMedium {
@@ -1881,12 +2242,8 @@ func (m *Model) applyBuildHut(char *entity.Character, delta float64) {
 		}
 	}
 
-	roleLabel := "wall"
-	if wallRole == "door" {
-		roleLabel = "door"
-	}
 	if m.actionLog != nil {
-		m.actionLog.Add(char.ID, char.Name, "activity", fmt.Sprintf("Built %s hut %s", material, roleLabel))
+		m.actionLog.AddMessage(char.ID, char.Name, "activity", "log.built", construct.DisplayName())
 	}
 
 	// Clear intent — ordered action pattern: next tick re-evaluates via findBuildHutIntent
@@ -6,9 +6,9 @@ import (
 )
 
 // isValidTillTarget returns true if the position can be marked for tilling.
-// Rejects water, features, already-tilled, and already-marked positions.
+// Rejects water, trees, rock, features, already-tilled, and already-marked positions.
 func isValidTillTarget(pos types.Position, gameMap *game.Map) bool {
-	if gameMap.IsWater(pos) {
+	if gameMap.IsWater(pos) || gameMap.TreeAt(pos) != game.TreeNone || gameMap.IsOutcrop(pos) {
 		return false
 	}
 	if gameMap.FeatureAt(pos) != nil {
@@ -30,9 +30,9 @@ func isValidUnmarkTarget(pos types.Position, gameMap *game.Map) bool {
 }
 
 // isValidFenceTarget returns true if the position can be marked for fence construction.
-// Rejects water, impassable features, existing constructs, and already-marked-for-construction tiles.
+// Rejects water, trees, rock, impassable features, existing constructs, and already-marked-for-construction tiles.
 func isValidFenceTarget(pos types.Position, gameMap *game.Map) bool {
-	if gameMap.IsWater(pos) {
+	if gameMap.IsWater(pos) || gameMap.TreeAt(pos) != game.TreeNone || gameMap.IsOutcrop(pos) {
 		return false
 	}
 	if f := gameMap.FeatureAt(pos); f != nil && !f.Passable {
@@ -52,6 +52,46 @@ func isValidUnmarkFenceTarget(pos types.Position, gameMap *game.Map) bool {
 	return gameMap.IsMarkedForConstruction(pos) && gameMap.ConstructAt(pos) == nil
 }
 
+// isValidDeconstructTarget returns true if the position holds a construct not yet marked for deconstruction.
+func isValidDeconstructTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.ConstructAt(pos) != nil && !gameMap.IsMarkedForDeconstruction(pos)
+}
+
+// isValidUnmarkDeconstructTarget returns true if the position can be unmarked from the deconstruction pool.
+func isValidUnmarkDeconstructTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.IsMarkedForDeconstruction(pos)
+}
+
+// isValidCarveTarget returns true if the position holds carvable tree wood not yet marked for carving.
+func isValidCarveTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.CanCarve(pos) && !gameMap.IsMarkedForCarving(pos)
+}
+
+// isValidUnmarkCarveTarget returns true if the position can be unmarked from the carving pool.
+func isValidUnmarkCarveTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.IsMarkedForCarving(pos)
+}
+
+// isValidChopTarget returns true if the position holds solid tree wood not yet marked for chopping.
+func isValidChopTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.CanChop(pos) && !gameMap.IsMarkedForChopping(pos)
+}
+
+// isValidUnmarkChopTarget returns true if the position can be unmarked from the chopping pool.
+func isValidUnmarkChopTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.IsMarkedForChopping(pos)
+}
+
+// isValidDigTarget returns true if the position is open land not yet marked for a channel.
+func isValidDigTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.CanMarkForDigging(pos) && !gameMap.IsMarkedForDigging(pos)
+}
+
+// isValidUnmarkDigTarget returns true if the position can be unmarked from the digging pool.
+func isValidUnmarkDigTarget(pos types.Position, gameMap *game.Map) bool {
+	return gameMap.IsMarkedForDigging(pos)
+}
+
 // getValidLinePositions returns valid positions along a cardinal line from anchor to cursor.
 // The line is constrained to horizontal or vertical: the axis with the larger delta wins.
 // For equal deltas, horizontal wins. The validator filters out invalid positions.
@@ -148,7 +188,7 @@ func isInRect(pos, corner1, corner2 types.Position) bool {
 }
 
 // isValidHutFootprint checks if a 5×5 hut footprint can be placed with top-left at (cursorX, cursorY).
-// All tiles block on: water, built constructs, impassable features, map edges.
+// All tiles block on: water, trees, rock, built constructs, impassable features, map edges.
 // Interior tiles block on existing hut marks (no walls inside a room).
 // Interior fence marks are allowed (erased during placement).
 // Perimeter tiles allow all existing construction marks (shared walls / fence overwrite).
@@ -159,7 +199,7 @@ func isValidHutFootprint(cursorX, cursorY int, gameMap *game.Map) bool {
 			if !gameMap.IsValid(pos) {
 				return false
 			}
-			if gameMap.IsWater(pos) {
+			if gameMap.IsWater(pos) || gameMap.TreeAt(pos) != game.TreeNone || gameMap.IsOutcrop(pos) {
 				return false
 			}
 			if gameMap.ConstructAt(pos) != nil {
@@ -246,3 +286,158 @@ func getValidPositions(anchor, cursor types.Position, gameMap *game.Map, validat
 	}
 	return positions
 }
+
+// markTillingArea marks (or unmarks) every valid position in the rectangle
+// between anchor and cursor for tilling.
+func (m *Model) markTillingArea(anchor, cursor types.Position, unmark bool) {
+	if unmark {
+		for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidUnmarkTarget) {
+			m.gameMap.UnmarkForTilling(pos)
+		}
+		return
+	}
+	for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidTillTarget) {
+		m.gameMap.MarkForTilling(pos)
+	}
+}
+
+// markFenceLine marks (or unmarks) every valid position on the cardinal line
+// between anchor and cursor for fence construction. Each marked line gets its own LineID.
+func (m *Model) markFenceLine(anchor, cursor types.Position, unmark bool) {
+	if unmark {
+		for _, pos := range getValidLinePositions(anchor, cursor, m.gameMap, isValidUnmarkFenceTarget) {
+			m.gameMap.UnmarkForConstruction(pos)
+		}
+		return
+	}
+	lineID := m.gameMap.NextConstructionLineID()
+	for _, pos := range getValidLinePositions(anchor, cursor, m.gameMap, isValidFenceTarget) {
+		m.gameMap.MarkForConstruction(pos, lineID, "fence", "")
+	}
+}
+
+// markDeconstructionArea marks (or unmarks) every construct between anchor and cursor for
+// deconstruction, using the cardinal line tool when line is true and the rectangle tool otherwise.
+func (m *Model) markDeconstructionArea(anchor, cursor types.Position, line, unmark bool) {
+	selectPositions := getValidPositions
+	if line {
+		selectPositions = getValidLinePositions
+	}
+	if unmark {
+		for _, pos := range selectPositions(anchor, cursor, m.gameMap, isValidUnmarkDeconstructTarget) {
+			m.gameMap.UnmarkForDeconstruction(pos)
+		}
+		return
+	}
+	for _, pos := range selectPositions(anchor, cursor, m.gameMap, isValidDeconstructTarget) {
+		m.gameMap.MarkForDeconstruction(pos)
+	}
+}
+
+// markCarvingArea marks (or unmarks) every carvable tree tile in the rectangle between anchor and cursor.
+func (m *Model) markCarvingArea(anchor, cursor types.Position, unmark bool) {
+	if unmark {
+		for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidUnmarkCarveTarget) {
+			m.gameMap.UnmarkForCarving(pos)
+		}
+		return
+	}
+	for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidCarveTarget) {
+		m.gameMap.MarkForCarving(pos)
+	}
+}
+
+// markChoppingArea marks (or unmarks) every solid tree tile in the rectangle between anchor and cursor.
+func (m *Model) markChoppingArea(anchor, cursor types.Position, unmark bool) {
+	if unmark {
+		for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidUnmarkChopTarget) {
+			m.gameMap.UnmarkForChopping(pos)
+		}
+		return
+	}
+	for _, pos := range getValidPositions(anchor, cursor, m.gameMap, isValidChopTarget) {
+		m.gameMap.MarkForChopping(pos)
+	}
+}
+
+// markDiggingLine marks (or unmarks) every valid position on the cardinal line between anchor
+// and cursor for an irrigation channel.
+func (m *Model) markDiggingLine(anchor, cursor types.Position, unmark bool) {
+	if unmark {
+		for _, pos := range getValidLinePositions(anchor, cursor, m.gameMap, isValidUnmarkDigTarget) {
+			m.gameMap.UnmarkForDigging(pos)
+		}
+		return
+	}
+	for _, pos := range getValidLinePositions(anchor, cursor, m.gameMap, isValidDigTarget) {
+		m.gameMap.MarkForDigging(pos)
+	}
+}
+
+// markHutFootprint marks a 5×5 hut footprint with its top-left corner at (x, y).
+// Returns false if the footprint is invalid and nothing was marked.
+func (m *Model) markHutFootprint(x, y int) bool {
+	if !isValidHutFootprint(x, y, m.gameMap) {
+		return false
+	}
+	lineID := m.gameMap.NextConstructionLineID()
+	doorPos := types.Position{X: x + 2, Y: y + 4} // center of south wall (DD-42)
+	for _, pos := range getHutPerimeterPositions(x, y) {
+		if mark, ok := m.gameMap.GetConstructionMark(pos); ok {
+			if mark.ConstructKind == "hut" {
+				continue // First-wins for shared walls (DD-46)
+			}
+			// Fence marks get overwritten by hut marks (DD-46)
+			m.gameMap.UnmarkForConstruction(pos)
+		}
+		wallRole := "wall"
+		if pos == doorPos {
+			wallRole = "door"
+		}
+		m.gameMap.MarkForConstruction(pos, lineID, "hut", wallRole)
+	}
+	// Clear any interior marks (shouldn't exist if validator passed, but defensive)
+	for _, pos := range getHutInteriorPositions(x, y) {
+		if m.gameMap.IsMarkedForConstruction(pos) {
+			m.gameMap.UnmarkForConstruction(pos)
+		}
+	}
+	return true
+}
+
+// toggleCampfireMark marks pos for a campfire, or clears an existing unbuilt campfire mark there.
+// Returns false if the tile can't take a campfire.
+func (m *Model) toggleCampfireMark(pos types.Position) bool {
+	if mark, ok := m.gameMap.GetConstructionMark(pos); ok {
+		if mark.ConstructKind != "campfire" || m.gameMap.ConstructAt(pos) != nil {
+			return false
+		}
+		m.gameMap.UnmarkForConstruction(pos)
+		return true
+	}
+	if !isValidFenceTarget(pos, m.gameMap) {
+		return false
+	}
+	return m.gameMap.MarkForConstruction(pos, m.gameMap.NextConstructionLineID(), "campfire", "")
+}
+
+// setCampfireMark marks pos for a campfire, or with unmark set clears its unbuilt campfire mark.
+// Returns false if the tile is already in that state or can't take the change.
+func (m *Model) setCampfireMark(pos types.Position, unmark bool) bool {
+	// toggleCampfireMark flips the mark, so only call it when the tile is in the other state
+	if _, marked := m.gameMap.GetConstructionMark(pos); marked != unmark {
+		return false
+	}
+	return m.toggleCampfireMark(pos)
+}
+
+// unmarkHutAt removes the entire hut footprint whose mark covers pos (by LineID).
+// Returns false if pos is not marked.
+func (m *Model) unmarkHutAt(pos types.Position) bool {
+	mark, ok := m.gameMap.GetConstructionMark(pos)
+	if !ok {
+		return false
+	}
+	m.gameMap.UnmarkByLineID(mark.LineID)
+	return true
+}
@@ -321,3 +321,69 @@ func TestIsValidUnmarkFenceTarget_ReturnsFalseForUnmarked(t *testing.T) {
 		t.Error("isValidUnmarkFenceTarget() should return false for unmarked tiles")
 	}
 }
+
+func TestMarkDeconstructionArea_RectangleMarksOnlyConstructs(t *testing.T) {
+	t.Parallel()
+	gameMap := game.NewMap(20, 20)
+	for _, pos := range []types.Position{{X: 5, Y: 5}, {X: 6, Y: 6}, {X: 9, Y: 9}} {
+		gameMap.AddConstruct(entity.NewFence(pos.X, pos.Y, "stick", types.ColorBrown))
+	}
+	m := Model{gameMap: gameMap}
+
+	m.markDeconstructionArea(types.Position{X: 4, Y: 4}, types.Position{X: 7, Y: 7}, false, false)
+
+	if !gameMap.IsMarkedForDeconstruction(types.Position{X: 5, Y: 5}) || !gameMap.IsMarkedForDeconstruction(types.Position{X: 6, Y: 6}) {
+		t.Error("Expected both fences inside the rectangle to be marked")
+	}
+	if gameMap.IsMarkedForDeconstruction(types.Position{X: 9, Y: 9}) {
+		t.Error("Fence outside the rectangle should not be marked")
+	}
+	if got := len(gameMap.MarkedForDeconstructionPositions()); got != 2 {
+		t.Errorf("Expected only construct tiles marked, got %d marks", got)
+	}
+
+	m.markDeconstructionArea(types.Position{X: 6, Y: 6}, types.Position{X: 6, Y: 6}, false, true)
+	if gameMap.IsMarkedForDeconstruction(types.Position{X: 6, Y: 6}) {
+		t.Error("Expected unmark mode to clear the mark")
+	}
+}
+
+func TestMarkDeconstructionArea_LineSnapsToAxis(t *testing.T) {
+	t.Parallel()
+	gameMap := game.NewMap(20, 20)
+	for _, pos := range []types.Position{{X: 5, Y: 5}, {X: 6, Y: 5}, {X: 6, Y: 6}} {
+		gameMap.AddConstruct(entity.NewFence(pos.X, pos.Y, "stick", types.ColorBrown))
+	}
+	m := Model{gameMap: gameMap}
+
+	// Wider than tall: snaps to the horizontal line through the anchor
+	m.markDeconstructionArea(types.Position{X: 5, Y: 5}, types.Position{X: 8, Y: 6}, true, false)
+
+	if !gameMap.IsMarkedForDeconstruction(types.Position{X: 5, Y: 5}) || !gameMap.IsMarkedForDeconstruction(types.Position{X: 6, Y: 5}) {
+		t.Error("Expected fences on the line to be marked")
+	}
+	if gameMap.IsMarkedForDeconstruction(types.Position{X: 6, Y: 6}) {
+		t.Error("Fence off the line should not be marked")
+	}
+}
+
+func TestMarkDiggingLine_SkipsWaterAndUnmarks(t *testing.T) {
+	t.Parallel()
+	gameMap := game.NewMap(20, 20)
+	gameMap.AddWater(types.Position{X: 5, Y: 5}, game.WaterPond)
+	m := Model{gameMap: gameMap}
+
+	m.markDiggingLine(types.Position{X: 5, Y: 5}, types.Position{X: 8, Y: 6}, false)
+
+	if gameMap.IsMarkedForDigging(types.Position{X: 5, Y: 5}) {
+		t.Error("Water should not be marked for digging")
+	}
+	if got := len(gameMap.MarkedForDiggingPositions()); got != 3 {
+		t.Errorf("Expected the 3 land tiles on the line marked, got %d", got)
+	}
+
+	m.markDiggingLine(types.Position{X: 8, Y: 5}, types.Position{X: 8, Y: 5}, true)
+	if gameMap.IsMarkedForDigging(types.Position{X: 8, Y: 5}) {
+		t.Error("Expected unmark mode to clear the mark")
+	}
+}
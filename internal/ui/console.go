@@ -0,0 +1,482 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/i18n"
+	"petri/internal/save"
+	"petri/internal/system"
+	"petri/internal/types"
+)
+
+// consoleLogID is the action log key for console commands that don't target a character
+const consoleLogID = 0
+
+// maxAdvanceSeconds caps "advance" so a typo can't hang the UI (the same limit as one /step request)
+const maxAdvanceSeconds = config.MaxStepTicks * float64(config.UpdateInterval) / float64(time.Second)
+
+// consoleArg identifies what a command argument completes against
+type consoleArg int
+
+const (
+	argNone consoleArg = iota
+	argCharacter
+	argVariety
+	argKnowHow
+	argStat
+	argWeather
+)
+
+// consoleCommand is one debug console command
+type consoleCommand struct {
+	name  string
+	usage string
+	args  []consoleArg // Completion kind per argument position
+	run   func(m *Model, args []string) (string, error)
+}
+
+// consoleStats are the character stats "set" accepts
+var consoleStats = []string{"energy", "health", "hunger", "mood", "thirst", "warmth"}
+
+// consoleCommands returns every console command, sorted by name.
+// A function rather than a var because help lists the commands (a var would be an init cycle).
+func consoleCommands() []consoleCommand {
+	return []consoleCommand{
+		{"advance", "advance <N>d|<N>h|<N>s", nil, (*Model).consoleAdvance},
+		{"dump", "dump <char>", []consoleArg{argCharacter}, (*Model).consoleDump},
+		{"help", "help", nil, (*Model).consoleHelp},
+		{"kill", "kill <char>", []consoleArg{argCharacter}, (*Model).consoleKill},
+		{"revive", "revive <char>", []consoleArg{argCharacter}, (*Model).consoleRevive},
+		{"set", "set <char> <stat> <0-100>", []consoleArg{argCharacter, argStat}, (*Model).consoleSet},
+		{"spawn", "spawn <variety> <x> <y>", []consoleArg{argVariety}, (*Model).consoleSpawn},
+		{"teach", "teach <char> <activity|recipe>", []consoleArg{argCharacter, argKnowHow}, (*Model).consoleTeach},
+		{"tp", "tp <char> <x> <y>", []consoleArg{argCharacter}, (*Model).consoleTeleport},
+		{"weather", "weather [clear|rain|storm|drought]", []consoleArg{argWeather}, (*Model).consoleWeather},
+	}
+}
+
+// findConsoleCommand looks up a command by name
+func findConsoleCommand(name string) (consoleCommand, bool) {
+	for _, cmd := range consoleCommands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return consoleCommand{}, false
+}
+
+// runConsoleCommand parses and executes one console line, returning the message to show.
+// Every known command except help is recorded in the action log, so manipulated runs are visible.
+func (m *Model) runConsoleCommand(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+	cmd, ok := findConsoleCommand(strings.ToLower(fields[0]))
+	if !ok {
+		return fmt.Sprintf("Unknown command %q (try help)", fields[0])
+	}
+	args := fields[1:]
+
+	// Log before running so the entry precedes anything the command causes (e.g. advance)
+	if cmd.name != "help" {
+		logID, logName := consoleLogID, "Console"
+		if len(cmd.args) > 0 && cmd.args[0] == argCharacter && len(args) > 0 {
+			if char := m.findConsoleCharacter(args[0]); char != nil {
+				logID, logName = char.ID, char.Name
+			}
+		}
+		m.actionLog.AddMessage(logID, logName, "console", "log.console", strings.Join(fields, " "))
+	}
+
+	result, err := cmd.run(m, args)
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	return result
+}
+
+// findConsoleCharacter finds a character by ID or case-insensitive name
+func (m *Model) findConsoleCharacter(ref string) *entity.Character {
+	id, idErr := strconv.Atoi(ref)
+	for _, char := range m.gameMap.Characters() {
+		if (idErr == nil && char.ID == id) || strings.EqualFold(char.Name, ref) {
+			return char
+		}
+	}
+	return nil
+}
+
+// consoleCharacterArg resolves the first argument to a character
+func (m *Model) consoleCharacterArg(args []string, usage string) (*entity.Character, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("usage: %s", usage)
+	}
+	char := m.findConsoleCharacter(args[0])
+	if char == nil {
+		return nil, fmt.Errorf("no character %q", args[0])
+	}
+	return char, nil
+}
+
+// parseConsolePosition parses x y arguments and checks they are on the map
+func (m *Model) parseConsolePosition(xs, ys string) (types.Position, error) {
+	x, errX := strconv.Atoi(xs)
+	y, errY := strconv.Atoi(ys)
+	if errX != nil || errY != nil {
+		return types.Position{}, fmt.Errorf("invalid position %s %s", xs, ys)
+	}
+	pos := types.Position{X: x, Y: y}
+	if !m.gameMap.IsValid(pos) {
+		return types.Position{}, fmt.Errorf("(%d,%d) is off the map", x, y)
+	}
+	return pos, nil
+}
+
+// consoleSpawn places an item of a registered variety on the map
+func (m *Model) consoleSpawn(args []string) (string, error) {
+	if len(args) != 3 {
+		return "", fmt.Errorf("usage: spawn <variety> <x> <y>")
+	}
+	v := m.gameMap.Varieties().Get(args[0])
+	if v == nil {
+		return "", fmt.Errorf("no variety %q", args[0])
+	}
+	pos, err := m.parseConsolePosition(args[1], args[2])
+	if err != nil {
+		return "", err
+	}
+	if m.gameMap.ItemAt(pos) != nil {
+		return "", fmt.Errorf("(%d,%d) already has an item", pos.X, pos.Y)
+	}
+	m.gameMap.AddItem(game.CreateItemFromVariety(v, pos.X, pos.Y))
+	return fmt.Sprintf("Spawned %s at (%d,%d)", v.Description(), pos.X, pos.Y), nil
+}
+
+// consoleTeach grants an activity or recipe (recipes also grant their activity and bundled activities)
+func (m *Model) consoleTeach(args []string) (string, error) {
+	char, err := m.consoleCharacterArg(args, "teach <char> <activity|recipe>")
+	if err != nil {
+		return "", err
+	}
+	if len(args) != 2 {
+		return "", fmt.Errorf("usage: teach <char> <activity|recipe>")
+	}
+	id := args[1]
+	if recipe, ok := entity.RecipeRegistry[id]; ok {
+		char.LearnActivity(recipe.ActivityID)
+		char.LearnRecipe(recipe.ID)
+		for _, bundledID := range recipe.BundledActivities {
+			char.LearnActivity(bundledID)
+		}
+		return fmt.Sprintf("%s learned recipe %s", char.Name, recipe.Name), nil
+	}
+	if activity, ok := entity.ActivityRegistry[id]; ok {
+		char.LearnActivity(activity.ID)
+		return fmt.Sprintf("%s learned %s", char.Name, activity.Name), nil
+	}
+	return "", fmt.Errorf("no activity or recipe %q", id)
+}
+
+// consoleSet sets one of a character's stats
+func (m *Model) consoleSet(args []string) (string, error) {
+	usage := "set <char> <stat> <0-100>"
+	char, err := m.consoleCharacterArg(args, usage)
+	if err != nil {
+		return "", err
+	}
+	if len(args) != 3 {
+		return "", fmt.Errorf("usage: %s", usage)
+	}
+	value, err := strconv.ParseFloat(args[2], 64)
+	if err != nil || value < 0 || value > 100 {
+		return "", fmt.Errorf("value must be 0-100, got %s", args[2])
+	}
+	switch strings.ToLower(args[1]) {
+	case "hunger":
+		char.Hunger = value
+	case "thirst":
+		char.Thirst = value
+	case "energy":
+		char.Energy = value
+	case "health":
+		char.Health = value
+	case "mood":
+		char.Mood = value
+	case "warmth":
+		char.Warmth = value
+	default:
+		return "", fmt.Errorf("unknown stat %q (one of %s)", args[1], strings.Join(consoleStats, ", "))
+	}
+	return fmt.Sprintf("%s %s = %g", char.Name, strings.ToLower(args[1]), value), nil
+}
+
+// consoleKill kills a character the same way survival does
+func (m *Model) consoleKill(args []string) (string, error) {
+	char, err := m.consoleCharacterArg(args, "kill <char>")
+	if err != nil {
+		return "", err
+	}
+	if char.IsDead {
+		return "", fmt.Errorf("%s is already dead", char.Name)
+	}
+	char.Health = 0
+	char.IsDead = true
+	char.IsSleeping = false
+	char.CurrentActivity = i18n.T("doing.dead")
+	char.Intent = nil
+	m.actionLog.AddMessage(char.ID, char.Name, "death", "log.died")
+	return char.Name + " died", nil
+}
+
+// consoleRevive brings a dead character back with full health and calm needs
+func (m *Model) consoleRevive(args []string) (string, error) {
+	char, err := m.consoleCharacterArg(args, "revive <char>")
+	if err != nil {
+		return "", err
+	}
+	if !char.IsDead {
+		return "", fmt.Errorf("%s is not dead", char.Name)
+	}
+	char.IsDead = false
+	char.Health = 100
+	char.Hunger = 50
+	char.Thirst = 50
+	char.Energy = 100
+	char.Warmth = 100
+	char.Poisoned = false
+	char.PoisonTimer = 0
+	char.CurrentActivity = i18n.T("doing.idle")
+	char.Intent = nil
+	char.ActionProgress = 0
+	return char.Name + " revived", nil
+}
+
+// consoleTeleport moves a character to a passable tile
+func (m *Model) consoleTeleport(args []string) (string, error) {
+	usage := "tp <char> <x> <y>"
+	char, err := m.consoleCharacterArg(args, usage)
+	if err != nil {
+		return "", err
+	}
+	if len(args) != 3 {
+		return "", fmt.Errorf("usage: %s", usage)
+	}
+	pos, err := m.parseConsolePosition(args[1], args[2])
+	if err != nil {
+		return "", err
+	}
+	if !m.gameMap.MoveCharacter(char, pos) {
+		return "", fmt.Errorf("(%d,%d) is blocked", pos.X, pos.Y)
+	}
+	char.Intent = nil
+	char.ActionProgress = 0
+	return fmt.Sprintf("%s moved to (%d,%d)", char.Name, pos.X, pos.Y), nil
+}
+
+// consoleWeather reports the current weather and forecast, or starts a new spell of the given weather
+func (m *Model) consoleWeather(args []string) (string, error) {
+	switch len(args) {
+	case 0:
+		return fmt.Sprintf("Weather: %s (%.0fs left), forecast: %s",
+			m.gameMap.Weather().ID(), m.gameMap.WeatherRemaining(), m.gameMap.Forecast().ID()), nil
+	case 1:
+		weather, ok := game.ParseWeather(strings.ToLower(args[0]))
+		if !ok {
+			return "", fmt.Errorf("unknown weather %q", args[0])
+		}
+		m.gameMap.SetWeather(weather, m.gameMap.Forecast(), system.RandomWeatherDuration())
+		return "Weather set to " + weather.ID(), nil
+	default:
+		return "", fmt.Errorf("usage: weather [clear|rain|storm|drought]")
+	}
+}
+
+// consoleAdvance runs the simulation forward by a world duration (d = world days, h = world hours, s = game seconds)
+func (m *Model) consoleAdvance(args []string) (string, error) {
+	if len(args) != 1 || len(args[0]) < 2 {
+		return "", fmt.Errorf("usage: advance <N>d|<N>h|<N>s")
+	}
+	spec := args[0]
+	n, err := strconv.ParseFloat(spec[:len(spec)-1], 64)
+	if err != nil || n <= 0 {
+		return "", fmt.Errorf("invalid duration %q", spec)
+	}
+	var seconds float64
+	switch spec[len(spec)-1] {
+	case 'd':
+		seconds = n * config.WorldDaySeconds
+	case 'h':
+		seconds = n * config.WorldDaySeconds / 24
+	case 's':
+		seconds = n
+	default:
+		return "", fmt.Errorf("invalid duration %q (use d, h, or s)", spec)
+	}
+	if seconds > maxAdvanceSeconds {
+		return "", fmt.Errorf("%s is more than the %gd limit", spec, maxAdvanceSeconds/config.WorldDaySeconds)
+	}
+
+	ticks := int(seconds / config.UpdateInterval.Seconds())
+	for i := 0; i < ticks; i++ {
+		m.stepForward()
+	}
+	return fmt.Sprintf("Advanced %s (%d ticks)", spec, ticks), nil
+}
+
+// consoleDump writes a character's full saved state to ~/.petri/dumps
+func (m *Model) consoleDump(args []string) (string, error) {
+	char, err := m.consoleCharacterArg(args, "dump <char>")
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(charactersToSave([]*entity.Character{char})[0], "", "  ")
+	if err != nil {
+		return "", err
+	}
+	baseDir, err := save.BaseDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(baseDir, "dumps")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%d-day%d.json", char.Name, char.ID, system.WorldDay(m.elapsedGameTime)))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return "Wrote " + path, nil
+}
+
+// consoleHelp lists command usages
+func (m *Model) consoleHelp(args []string) (string, error) {
+	var usages []string
+	for _, cmd := range consoleCommands() {
+		usages = append(usages, cmd.usage)
+	}
+	return strings.Join(usages, " | "), nil
+}
+
+// completeConsoleInput completes the last word of the input.
+// Returns the new input and, when several candidates remain, the candidates to show.
+func (m *Model) completeConsoleInput(input string) (string, []string) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 || strings.HasSuffix(input, " ") {
+		fields = append(fields, "")
+	}
+	word := fields[len(fields)-1]
+	position := len(fields) - 1
+
+	var candidates []string
+	if position == 0 {
+		for _, cmd := range consoleCommands() {
+			candidates = append(candidates, cmd.name)
+		}
+	} else if cmd, ok := findConsoleCommand(strings.ToLower(fields[0])); ok && position-1 < len(cmd.args) {
+		candidates = m.consoleCandidates(cmd.args[position-1])
+	}
+
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(word)) {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return input, nil
+	}
+
+	prefix := input[:len(input)-len(word)]
+	if len(matches) == 1 {
+		return prefix + matches[0] + " ", nil
+	}
+	return prefix + commonPrefix(matches), matches
+}
+
+// consoleCandidates lists completion values for an argument kind, sorted
+func (m *Model) consoleCandidates(kind consoleArg) []string {
+	var result []string
+	switch kind {
+	case argCharacter:
+		for _, char := range m.gameMap.Characters() {
+			result = append(result, char.Name)
+		}
+	case argVariety:
+		if registry := m.gameMap.Varieties(); registry != nil {
+			for _, v := range registry.AllVarieties() {
+				result = append(result, v.ID)
+			}
+		}
+	case argKnowHow:
+		for id := range entity.ActivityRegistry {
+			result = append(result, id)
+		}
+		for id := range entity.RecipeRegistry {
+			result = append(result, id)
+		}
+	case argStat:
+		result = append(result, consoleStats...)
+	case argWeather:
+		for _, w := range game.AllWeather {
+			result = append(result, w.ID())
+		}
+	}
+	// Sort for consistent ordering (maps iterate randomly)
+	sort.Strings(result)
+	return result
+}
+
+// handleConsoleKey handles input while the console is open
+func (m Model) handleConsoleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.consoleOpen = false
+		m.consoleInput = ""
+		m.consoleMessage = ""
+	case tea.KeyEnter:
+		m.consoleMessage = m.runConsoleCommand(m.consoleInput)
+		m.consoleInput = ""
+	case tea.KeyTab:
+		var candidates []string
+		m.consoleInput, candidates = m.completeConsoleInput(m.consoleInput)
+		m.consoleMessage = strings.Join(candidates, " ")
+	case tea.KeyBackspace:
+		if runes := []rune(m.consoleInput); len(runes) > 0 {
+			m.consoleInput = string(runes[:len(runes)-1])
+		}
+	case tea.KeySpace:
+		m.consoleInput += " "
+	case tea.KeyRunes:
+		m.consoleInput += string(msg.Runes)
+	}
+	return m, nil
+}
+
+// commonPrefix returns the longest prefix shared by all strings, ignoring case (matching is
+// case-insensitive). The prefix keeps the casing of the first string.
+func commonPrefix(values []string) string {
+	prefix := []rune(values[0])
+	for _, v := range values[1:] {
+		runes := []rune(v)
+		n := 0
+		for n < len(prefix) && n < len(runes) && unicode.ToLower(prefix[n]) == unicode.ToLower(runes[n]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return string(prefix)
+}
@@ -0,0 +1,228 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/save"
+	"petri/internal/types"
+)
+
+// newConsoleTestModel creates a paused debug model with two characters and generated varieties
+func newConsoleTestModel() Model {
+	m := newTestModel(20, 20,
+		entity.NewCharacter(1, 5, 5, "Alice", "berry", types.ColorRed),
+		entity.NewCharacter(2, 8, 8, "Bob", "berry", types.ColorBlue),
+	)
+	m.testCfg.Debug = true
+	return m
+}
+
+func TestConsole_CommandsAreLogged(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	if msg := m.runConsoleCommand("set alice hunger 80"); msg != "Alice hunger = 80" {
+		t.Errorf("Unexpected result: %q", msg)
+	}
+	alice := m.findConsoleCharacter("Alice")
+	if alice.Hunger != 80 {
+		t.Errorf("Expected hunger 80, got %v", alice.Hunger)
+	}
+	events := m.actionLog.Events(alice.ID, 10)
+	if len(events) != 1 || events[0].Type != "console" || events[0].Message != "Console: set alice hunger 80" {
+		t.Errorf("Expected console event in Alice's log, got %+v", events)
+	}
+
+	// Commands without a character target go to the console log, failures included
+	m.runConsoleCommand("advance 99x")
+	events = m.actionLog.Events(consoleLogID, 10)
+	if len(events) != 1 || events[0].CharName != "Console" {
+		t.Errorf("Expected console log entry, got %+v", events)
+	}
+}
+
+func TestConsole_KillAndRevive(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	m.runConsoleCommand("kill Bob")
+	bob := m.findConsoleCharacter("Bob")
+	if !bob.IsDead || bob.Health != 0 {
+		t.Fatalf("Expected Bob dead, got dead=%v health=%v", bob.IsDead, bob.Health)
+	}
+	if msg := m.runConsoleCommand("kill Bob"); !strings.HasPrefix(msg, "Error:") {
+		t.Errorf("Expected error killing a dead character, got %q", msg)
+	}
+	m.runConsoleCommand("revive 2")
+	if bob.IsDead || bob.Health != 100 {
+		t.Errorf("Expected Bob revived by ID, got dead=%v health=%v", bob.IsDead, bob.Health)
+	}
+}
+
+func TestConsole_SpawnTeachTeleport(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	variety := m.gameMap.Varieties().VarietiesOfType("berry")[0]
+	m.runConsoleCommand("spawn " + variety.ID + " 3 4")
+	item := m.gameMap.ItemAt(types.Position{X: 3, Y: 4})
+	if item == nil || item.ItemType != "berry" || item.Color != variety.Color {
+		t.Errorf("Expected %s at (3,4), got %+v", variety.ID, item)
+	}
+
+	m.runConsoleCommand("teach Alice tillSoil")
+	alice := m.findConsoleCharacter("Alice")
+	if !alice.KnowsActivity("tillSoil") {
+		t.Error("Expected Alice to know tillSoil")
+	}
+
+	// Teleporting onto another character is refused
+	if msg := m.runConsoleCommand("tp Alice 8 8"); !strings.Contains(msg, "blocked") {
+		t.Errorf("Expected blocked teleport, got %q", msg)
+	}
+	m.runConsoleCommand("tp Alice 10 12")
+	if alice.Pos() != (types.Position{X: 10, Y: 12}) || m.gameMap.CharacterAt(types.Position{X: 10, Y: 12}) != alice {
+		t.Errorf("Expected Alice at (10,12), got %v", alice.Pos())
+	}
+}
+
+func TestConsole_AdvanceRunsTicks(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	m.runConsoleCommand("advance 1h")
+	hour := config.WorldDaySeconds / 24
+	if m.elapsedGameTime < hour-0.1 || m.elapsedGameTime > hour+0.1 {
+		t.Errorf("Expected ~%gs elapsed, got %v", hour, m.elapsedGameTime)
+	}
+	if msg := m.runConsoleCommand("advance 30d"); !strings.Contains(msg, "limit") {
+		t.Errorf("Expected limit error, got %q", msg)
+	}
+}
+
+func TestConsole_Weather(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	if msg := m.runConsoleCommand("weather"); !strings.HasPrefix(msg, "Weather: clear") {
+		t.Errorf("Expected a clear weather report, got %q", msg)
+	}
+	if msg := m.runConsoleCommand("weather storm"); msg != "Weather set to storm" {
+		t.Errorf("Unexpected result: %q", msg)
+	}
+	if m.gameMap.Weather() != game.WeatherStorm || m.gameMap.WeatherRemaining() <= 0 {
+		t.Errorf("Expected a storm spell to start, got %s with %.1fs", m.gameMap.Weather().ID(), m.gameMap.WeatherRemaining())
+	}
+	if msg := m.runConsoleCommand("weather hail"); !strings.HasPrefix(msg, "Error: unknown weather") {
+		t.Errorf("Expected an unknown weather error, got %q", msg)
+	}
+}
+
+// Not parallel: SetBaseDir mutates global state
+func TestConsole_DumpWritesCharacter(t *testing.T) {
+	save.SetBaseDir(t.TempDir())
+	t.Cleanup(save.ResetBaseDir)
+
+	m := newConsoleTestModel()
+	msg := m.runConsoleCommand("dump Alice")
+	if !strings.HasPrefix(msg, "Wrote ") || !strings.HasSuffix(msg, "Alice-1-day1.json") {
+		t.Errorf("Unexpected result: %q", msg)
+	}
+}
+
+func TestConsole_TabCompletion(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"te", "teach "},
+		{"teach al", "teach Alice "},
+		{"set Bob hu", "set Bob hunger "},
+		{"teach Alice tillS", "teach Alice tillSoil "},
+		{"weather st", "weather storm "},
+		{"zz", "zz"},
+	}
+	for _, tt := range tests {
+		if got, _ := m.completeConsoleInput(tt.input); got != tt.want {
+			t.Errorf("complete(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	// Ambiguous prefixes complete as far as they can and list candidates
+	got, candidates := m.completeConsoleInput("spawn berry")
+	if !strings.HasPrefix(got, "spawn berry-") || len(candidates) < 2 {
+		t.Errorf("Expected partial variety completion with candidates, got %q %v", got, candidates)
+	}
+}
+
+func TestCommonPrefix_IgnoresCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{[]string{"berry-red", "berry-blue"}, "berry-"},
+		{[]string{"Alice", "alex"}, "Al"},
+		{[]string{"Zoë", "zoëy"}, "Zoë"},
+		{[]string{"abc", "xyz"}, ""},
+	}
+	for _, tt := range tests {
+		if got := commonPrefix(tt.values); got != tt.want {
+			t.Errorf("commonPrefix(%q) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestConsole_BackspaceRemovesLastRune(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	m.consoleOpen = true
+	m.consoleInput = "spawn Zoë"
+	result, _ := m.handleConsoleKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	if got := result.(Model).consoleInput; got != "spawn Zo" {
+		t.Errorf("Expected backspace to remove the whole rune, got %q", got)
+	}
+}
+
+func TestConsole_DebugOnlyAndKeyHandling(t *testing.T) {
+	t.Parallel()
+
+	m := newConsoleTestModel()
+	m.testCfg.Debug = false
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	if result.(Model).consoleOpen {
+		t.Fatal("Expected console unavailable without debug")
+	}
+
+	m.testCfg.Debug = true
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("kill")},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("Al")},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyEnter},
+	} {
+		result, _ = result.(Model).Update(key)
+	}
+	m = result.(Model)
+	if !m.consoleOpen || m.consoleMessage != "Alice died" {
+		t.Errorf("Expected console open with result, got open=%v message=%q", m.consoleOpen, m.consoleMessage)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if result.(Model).consoleOpen {
+		t.Error("Expected Esc to close the console")
+	}
+}
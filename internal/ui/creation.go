@@ -1,12 +1,12 @@
 package ui
 
 import (
-	"math/rand"
 	"sort"
 	"strings"
 
 	"petri/internal/config"
 	"petri/internal/game"
+	"petri/internal/rng"
 	"petri/internal/types"
 )
 
@@ -130,7 +130,7 @@ func (s *CharacterCreationState) AddCharacter() bool {
 	}
 	name := "Character"
 	if len(available) > 0 {
-		name = available[rand.Intn(len(available))]
+		name = available[rng.Intn(len(available))]
 	}
 
 	s.Characters = append(s.Characters, CharacterCreationData{
@@ -223,11 +223,11 @@ func (s *CharacterCreationState) IsOptionFieldSelected() bool {
 // Helper functions
 
 func randomFood() string {
-	return foodOptions[rand.Intn(len(foodOptions))]
+	return foodOptions[rng.Intn(len(foodOptions))]
 }
 
 func randomColor() string {
-	return colorOptions[rand.Intn(len(colorOptions))]
+	return colorOptions[rng.Intn(len(colorOptions))]
 }
 
 // randomUniqueNames returns n unique random names from config.CharacterNames
@@ -235,7 +235,7 @@ func randomUniqueNames(n int) []string {
 	// Shuffle a copy of CharacterNames
 	shuffled := make([]string, len(config.CharacterNames))
 	copy(shuffled, config.CharacterNames)
-	rand.Shuffle(len(shuffled), func(i, j int) {
+	rng.Shuffle(len(shuffled), func(i, j int) {
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	})
 	// Return first n names
@@ -0,0 +1,246 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"petri/internal/system"
+)
+
+// phaseBuckets are histogram upper bounds in seconds for tick phase durations
+var phaseBuckets = []float64{0.00005, 0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1}
+
+// Counter names, derived from action log events each tick
+const (
+	counterDiscoveries     = "petri_discoveries_total"
+	counterPreferences     = "petri_preferences_formed_total"
+	counterDeaths          = "petri_deaths_total"
+	counterOrdersCompleted = "petri_orders_completed_total"
+	counterOrdersAbandoned = "petri_orders_abandoned_total"
+	counterConstructsBuilt = "petri_constructs_built_total"
+)
+
+// metricsCounters lists counters with their help text, in exposition order
+var metricsCounters = []struct{ name, help string }{
+	{counterDiscoveries, "Activities and recipes discovered."},
+	{counterPreferences, "New preferences formed."},
+	{counterDeaths, "Character deaths."},
+	{counterOrdersCompleted, "Orders completed."},
+	{counterOrdersAbandoned, "Orders abandoned for lack of materials."},
+	{counterConstructsBuilt, "Constructs built (fence segments and hut pieces)."},
+}
+
+// histogram is a cumulative-bucket histogram in the Prometheus style
+type histogram struct {
+	counts []uint64 // counts[i] = observations <= phaseBuckets[i]
+	sum    float64
+	count  uint64
+}
+
+// observe adds one observation to every bucket it fits in
+func (h *histogram) observe(v float64) {
+	for i, bound := range phaseBuckets {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// metricsSnapshot holds gauge values captured at the end of the last tick
+type metricsSnapshot struct {
+	population int
+	averages   map[string]float64 // stat name -> average over living characters
+	items      map[string]int     // item type -> count on the ground
+}
+
+// Metrics collects simulation metrics and serves them in the Prometheus text format.
+// The model records into it at the end of every tick; scrapes read the last snapshot,
+// so the handler never touches live game state.
+type Metrics struct {
+	mu       sync.Mutex
+	snapshot metricsSnapshot
+	counters map[string]float64
+	phases   map[system.Phase]*histogram
+	events   eventCursor
+}
+
+// NewMetrics creates an empty metrics collector
+func NewMetrics() *Metrics {
+	mt := &Metrics{
+		counters: make(map[string]float64),
+		phases:   make(map[system.Phase]*histogram),
+	}
+	for _, phase := range system.AllPhases {
+		mt.phases[phase] = &histogram{counts: make([]uint64, len(phaseBuckets))}
+	}
+	return mt
+}
+
+// WithMetrics attaches a metrics collector; the model records into it after every tick
+func (m Model) WithMetrics(mt *Metrics) Model {
+	m.metrics = mt
+	return m
+}
+
+// SetMetrics attaches a metrics collector to the served world
+func (s *Server) SetMetrics(mt *Metrics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.metrics = mt
+}
+
+// observePhase adds one phase duration to its histogram
+func (mt *Metrics) observePhase(phase system.Phase, d time.Duration) {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	if h, ok := mt.phases[phase]; ok {
+		h.observe(d.Seconds())
+	}
+}
+
+// recordMetrics captures gauges and counts new events, if metrics are enabled
+func (m *Model) recordMetrics() {
+	if m.metrics == nil {
+		return
+	}
+
+	snap := metricsSnapshot{
+		averages: make(map[string]float64),
+		items:    make(map[string]int),
+	}
+	for _, char := range m.gameMap.Characters() {
+		if char.IsDead {
+			continue
+		}
+		snap.population++
+		snap.averages["hunger"] += char.Hunger
+		snap.averages["thirst"] += char.Thirst
+		snap.averages["energy"] += char.Energy
+		snap.averages["health"] += char.Health
+		snap.averages["mood"] += char.Mood
+	}
+	if snap.population > 0 {
+		for stat := range snap.averages {
+			snap.averages[stat] /= float64(snap.population)
+		}
+	}
+	for _, item := range m.gameMap.Items() {
+		snap.items[item.ItemType]++
+	}
+
+	mt := m.metrics
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	for _, e := range mt.events.next(m.actionLog) {
+		if name := counterForEvent(e); name != "" {
+			mt.counters[name]++
+		}
+	}
+	mt.snapshot = snap
+}
+
+// eventCursor remembers the last action log event a consumer has seen
+type eventCursor struct {
+	log *system.ActionLog // Log the cursor points into; nil until first use
+	seq uint64            // Sequence number of the last event seen
+}
+
+// next returns the events logged since the previous call, including any logged while paused.
+// On first use, and whenever the model switches to a new log, it starts at the current tick
+// so saved history is skipped.
+func (c *eventCursor) next(log *system.ActionLog) []system.Event {
+	if c.log != log {
+		c.log = log
+		c.seq = log.TickStartSeq()
+	}
+	events := log.EventsAfter(c.seq)
+	if len(events) > 0 {
+		c.seq = events[len(events)-1].Seq
+	}
+	return events
+}
+
+// keyCounters maps action log message keys to the counter they increment
+var keyCounters = map[string]string{
+	"log.preference.likes":    counterPreferences,
+	"log.preference.dislikes": counterPreferences,
+	"log.order.completed":     counterOrdersCompleted,
+	"log.order.abandoning":    counterOrdersAbandoned,
+	"log.built":               counterConstructsBuilt,
+}
+
+// counterForEvent maps an action log event to the counter it increments, or "" for none
+func counterForEvent(e system.Event) string {
+	switch e.Type {
+	case "discovery":
+		return counterDiscoveries
+	case "death":
+		return counterDeaths
+	}
+	return keyCounters[e.Key]
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format
+func (mt *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	mt.writeTo(w)
+}
+
+// writeTo writes the exposition. Label values are sorted so output is stable between scrapes.
+func (mt *Metrics) writeTo(w io.Writer) {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+
+	writeHeader(w, "petri_population", "gauge", "Living characters.")
+	fmt.Fprintf(w, "petri_population %d\n", mt.snapshot.population)
+
+	for _, stat := range []string{"hunger", "thirst", "energy", "health", "mood"} {
+		name := "petri_character_" + stat + "_average"
+		writeHeader(w, name, "gauge", "Average "+stat+" of living characters.")
+		fmt.Fprintf(w, "%s %s\n", name, formatFloat(mt.snapshot.averages[stat]))
+	}
+
+	writeHeader(w, "petri_items", "gauge", "Items on the ground by type.")
+	itemTypes := make([]string, 0, len(mt.snapshot.items))
+	for itemType := range mt.snapshot.items {
+		itemTypes = append(itemTypes, itemType)
+	}
+	sort.Strings(itemTypes)
+	for _, itemType := range itemTypes {
+		fmt.Fprintf(w, "petri_items{type=%q} %d\n", itemType, mt.snapshot.items[itemType])
+	}
+
+	for _, c := range metricsCounters {
+		writeHeader(w, c.name, "counter", c.help)
+		fmt.Fprintf(w, "%s %s\n", c.name, formatFloat(mt.counters[c.name]))
+	}
+
+	name := "petri_tick_phase_duration_seconds"
+	writeHeader(w, name, "histogram", "Wall-clock time spent in each tick phase.")
+	for _, phase := range system.AllPhases {
+		h := mt.phases[phase]
+		label := phase.String()
+		for i, bound := range phaseBuckets {
+			fmt.Fprintf(w, "%s_bucket{phase=%q,le=%q} %d\n", name, label, formatFloat(bound), h.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{phase=%q,le=\"+Inf\"} %d\n", name, label, h.count)
+		fmt.Fprintf(w, "%s_sum{phase=%q} %s\n", name, label, formatFloat(h.sum))
+		fmt.Fprintf(w, "%s_count{phase=%q} %d\n", name, label, h.count)
+	}
+}
+
+// writeHeader writes the HELP and TYPE lines for a metric family
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// formatFloat formats a sample value the way Prometheus clients do
+func formatFloat(v float64) string {
+	return fmt.Sprintf("%g", v)
+}
@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"petri/internal/entity"
+	"petri/internal/system"
+	"petri/internal/types"
+)
+
+// newMetricsTestModel creates a paused model with two characters and metrics attached
+func newMetricsTestModel() (Model, *Metrics) {
+	alice := entity.NewCharacter(1, 5, 5, "Alice", "berry", types.ColorRed)
+	alice.Hunger = 40
+	bob := entity.NewCharacter(2, 8, 8, "Bob", "berry", types.ColorBlue)
+	bob.Hunger = 60
+	mt := NewMetrics()
+	return newTestModel(20, 20, alice, bob).WithMetrics(mt), mt
+}
+
+// scrape calls the metrics handler directly and returns the body
+func scrape(t *testing.T, mt *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	mt.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Expected text/plain content type, got %q", ct)
+	}
+	return rec.Body.String()
+}
+
+// expectLine fails if body does not contain line exactly
+func expectLine(t *testing.T, body, line string) {
+	t.Helper()
+	for _, l := range strings.Split(body, "\n") {
+		if l == line {
+			return
+		}
+	}
+	t.Errorf("Expected line %q in:\n%s", line, body)
+}
+
+func TestMetrics_GaugesReflectLastTick(t *testing.T) {
+	t.Parallel()
+
+	m, mt := newMetricsTestModel()
+	m.gameMap.AddItem(entity.NewBerry(1, 1, types.ColorRed, false, false))
+	m.gameMap.AddItem(entity.NewBerry(2, 1, types.ColorBlue, false, false))
+	m.gameMap.AddItem(entity.NewFlower(3, 1, types.ColorYellow))
+	m.recordMetrics()
+
+	body := scrape(t, mt)
+	expectLine(t, body, "# TYPE petri_population gauge")
+	expectLine(t, body, "petri_population 2")
+	expectLine(t, body, "petri_character_hunger_average 50")
+	expectLine(t, body, `petri_items{type="berry"} 2`)
+	expectLine(t, body, `petri_items{type="flower"} 1`)
+
+	// Dead characters are excluded from population and averages
+	m.gameMap.Characters()[1].IsDead = true
+	m.recordMetrics()
+	body = scrape(t, mt)
+	expectLine(t, body, "petri_population 1")
+	expectLine(t, body, "petri_character_hunger_average 40")
+}
+
+func TestMetrics_CountersCountEachEventOnce(t *testing.T) {
+	t.Parallel()
+
+	m, mt := newMetricsTestModel()
+	// History from before metrics started is not counted
+	m.actionLog.Add(1, "Alice", "death", "Died")
+	m.elapsedGameTime = 1
+	m.actionLog.SetGameTime(1)
+	m.recordMetrics()
+
+	m.elapsedGameTime = 2
+	m.actionLog.SetGameTime(2)
+	m.actionLog.Add(1, "Alice", "discovery", "Discovered how to harvest!")
+	m.actionLog.AddMessage(1, "Alice", "preference", "log.preference.likes", "berries")
+	m.actionLog.AddMessage(2, "Bob", "preference", "log.preference.no_longer_likes", "berries")
+	m.actionLog.AddMessage(2, "Bob", "order", "log.order.completed", "Harvest berries")
+	m.actionLog.AddMessage(2, "Bob", "order", "log.order.abandoning", "Craft vessel")
+	m.actionLog.AddMessage(2, "Bob", "order", "log.order.taking", "Harvest berries")
+	m.actionLog.AddMessage(1, "Alice", "activity", "log.built", "stick fence")
+	m.actionLog.AddMessage(1, "Alice", "activity", "log.tilled")
+	// Unkeyed text is never counted, whatever its language
+	m.actionLog.Add(1, "Alice", "activity", "Built stick fence")
+	m.actionLog.Add(2, "Bob", "death", "Died")
+	m.recordMetrics()
+	// A second record with no new events must not double count
+	m.recordMetrics()
+
+	body := scrape(t, mt)
+	expectLine(t, body, "# TYPE petri_discoveries_total counter")
+	expectLine(t, body, "petri_discoveries_total 1")
+	expectLine(t, body, "petri_preferences_formed_total 1")
+	expectLine(t, body, "petri_deaths_total 1")
+	expectLine(t, body, "petri_orders_completed_total 1")
+	expectLine(t, body, "petri_orders_abandoned_total 1")
+	expectLine(t, body, "petri_constructs_built_total 1")
+}
+
+func TestMetrics_CountsEventsLoggedWhilePaused(t *testing.T) {
+	t.Parallel()
+
+	m, mt := newMetricsTestModel()
+	m.elapsedGameTime = 1
+	m.actionLog.SetGameTime(1)
+	m.recordMetrics()
+
+	// Game time does not advance while paused
+	m.actionLog.Add(2, "Bob", "death", "Died")
+	m.recordMetrics()
+	m.recordMetrics()
+
+	expectLine(t, scrape(t, mt), "petri_deaths_total 1")
+}
+
+func TestMetrics_StepForwardObservesEveryPhase(t *testing.T) {
+	t.Parallel()
+
+	m, mt := newMetricsTestModel()
+	m.stepForward()
+	m.stepForward()
+
+	body := scrape(t, mt)
+	expectLine(t, body, "# TYPE petri_tick_phase_duration_seconds histogram")
+	for _, phase := range system.AllPhases {
+		expectLine(t, body, `petri_tick_phase_duration_seconds_count{phase="`+phase.String()+`"} 2`)
+		expectLine(t, body, `petri_tick_phase_duration_seconds_bucket{phase="`+phase.String()+`",le="+Inf"} 2`)
+	}
+}
+
+func TestMetrics_NilMetricsIsNoop(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newMetricsTestModel()
+	m.metrics = nil
+	m.stepForward() // must not panic
+}
+
+func TestServer_MetricsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, http.MethodGet, "/metrics", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	expectLine(t, rec.Body.String(), "# TYPE petri_population gauge")
+}
@@ -77,6 +77,17 @@ type Model struct {
 	showInventoryPanel   bool
 	showPreferencesPanel bool
 
+	// Tuning panel (debug only): effective config values and their sources
+	showTuningPanel bool
+
+	// Soil overlay: tilled tiles tinted by fertility
+	showSoilOverlay bool
+
+	// Debug console (":" in debug mode)
+	consoleOpen    bool
+	consoleInput   string
+	consoleMessage string // Result of the last command, or completion candidates
+
 	// Orders system
 	orders      []*entity.Order
 	nextOrderID int
@@ -101,9 +112,11 @@ type Model struct {
 	// Area selection state (used during ordersAddStep == 2 for tillSoil)
 	areaSelectAnchor     *types.Position // nil = no anchor set yet
 	areaSelectUnmarkMode bool            // true = unmark mode, false = mark mode
+	areaSelectLineMode   bool            // true = cardinal line tool, false = rectangle (deconstruct only)
 
 	// Character creation state
 	creationState *CharacterCreationState
+	terrainPreset string // Terrain preset ID for the next new world (see game.TerrainPresets)
 
 	// Character name editing state (during gameplay)
 	editingCharacterName bool
@@ -121,8 +134,26 @@ type Model struct {
 	// Ground spawning timers (sticks, nuts, shells)
 	groundSpawnTimers system.GroundSpawnTimers
 
+	// Countdown to the next wild creature arrival
+	creatureSpawnTimer float64
+
 	// Speed control (1 = normal, 2 = half speed, 4 = quarter speed)
 	speedMultiplier int
+
+	// Live event streaming (nil = disabled)
+	streamer *Streamer
+
+	// Content packs the world was created with ("id@version")
+	contentPacks []string
+
+	// Per-world tuning overrides (tunable key -> value); nil = global tuning only
+	tuning map[string]float64
+
+	// Per-tick systems, in phase order (nil = default pipeline, created on first tick)
+	pipeline *system.Pipeline
+
+	// Prometheus metrics (nil = disabled)
+	metrics *Metrics
 }
 
 // NewModel creates a new game model
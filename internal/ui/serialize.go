@@ -24,11 +24,23 @@ func (m Model) ToSaveState() *save.SaveState {
 		Items:                      itemsToSave(m.gameMap.Items()),
 		Features:                   featuresToSave(m.gameMap.Features()),
 		Constructs:                 constructsToSave(m.gameMap.Constructs()),
+		Creatures:                  creaturesToSave(m.gameMap.Creatures()),
 		WaterTiles:                 waterTilesToSave(m.gameMap),
+		TreeTiles:                  treeTilesToSave(m.gameMap),
+		Biomes:                     biomeTilesToSave(m.gameMap),
+		ChannelTiles:               channelTilesToSave(m.gameMap),
+		DriedPondBeds:              m.gameMap.DriedPondBedPositions(),
 		ClayPositions:              m.gameMap.ClayPositions(),
+		OutcropPositions:           m.gameMap.OutcropPositions(),
 		TilledPositions:            m.gameMap.TilledPositions(),
+		SoilTiles:                  soilTilesToSave(m.gameMap),
+		BurningTiles:               burningTilesToSave(m.gameMap),
 		MarkedForTillingPositions:  m.gameMap.MarkedForTillingPositions(),
 		MarkedForConstructionTiles: constructionMarksToSave(m.gameMap),
+		MarkedForDeconstruction:    m.gameMap.MarkedForDeconstructionPositions(),
+		MarkedForCarving:           m.gameMap.MarkedForCarvingPositions(),
+		MarkedForChopping:          m.gameMap.MarkedForChoppingPositions(),
+		MarkedForDigging:           m.gameMap.MarkedForDiggingPositions(),
 		ConstructionLineID:         m.gameMap.ConstructionLineID(),
 		WateredTiles:               wateredTilesToSaveManual(m.gameMap),
 		ActionLogs:                 actionLogsToSave(m.actionLog),
@@ -38,7 +50,22 @@ func (m Model) ToSaveState() *save.SaveState {
 		GroundSpawnStick: m.groundSpawnTimers.Stick,
 		GroundSpawnNut:   m.groundSpawnTimers.Nut,
 		GroundSpawnShell: m.groundSpawnTimers.Shell,
+		GroundSpawnStone: m.groundSpawnTimers.Stone,
+
+		CreatureSpawnTimer: m.creatureSpawnTimer,
+
+		Season:        m.gameMap.Season().ID(),
+		SeasonElapsed: m.gameMap.SeasonElapsed(),
+
+		Weather:          m.gameMap.Weather().ID(),
+		Forecast:         m.gameMap.Forecast().ID(),
+		WeatherRemaining: m.gameMap.WeatherRemaining(),
 	}
+	if m.pipeline != nil {
+		state.DisabledSystems = m.pipeline.Disabled()
+	}
+	state.ContentPacks = m.contentPacks
+	state.Tuning = m.tuning
 	return state
 }
 
@@ -123,14 +150,19 @@ func charactersToSave(characters []*entity.Character) []save.CharacterSave {
 					Edible:          item.IsEdible(),
 					Poisonous:       item.IsPoisonous(),
 					Healing:         item.IsHealing(),
+					Cooked:          item.IsCooked(),
 					Plantable:       item.Plantable,
 					SourceVarietyID: item.SourceVarietyID,
 					BundleCount:     item.BundleCount,
 					DeathTimer:      item.DeathTimer,
 				}
+				if item.Durability != nil {
+					inventory[idx].Durability = item.Durability.Current
+				}
 			}
 		}
 
+		warmth := c.Warmth
 		result[i] = save.CharacterSave{
 			ID:       c.ID,
 			Name:     c.Name,
@@ -141,6 +173,7 @@ func charactersToSave(characters []*entity.Character) []save.CharacterSave {
 			Thirst: c.Thirst,
 			Energy: c.Energy,
 			Mood:   c.Mood,
+			Warmth: &warmth,
 
 			Poisoned:    c.Poisoned,
 			PoisonTimer: c.PoisonTimer,
@@ -153,6 +186,7 @@ func charactersToSave(characters []*entity.Character) []save.CharacterSave {
 			FailedIntentCount: c.FailedIntentCount,
 
 			IdleCooldown:  c.IdleCooldown,
+			FearCooldown:  c.FearCooldown,
 			LastLookedX:   c.LastLookedX,
 			LastLookedY:   c.LastLookedY,
 			HasLastLooked: c.HasLastLooked,
@@ -262,11 +296,15 @@ func itemsToSave(items []*entity.Item) []save.ItemSave {
 			Edible:          item.IsEdible(),
 			Poisonous:       item.IsPoisonous(),
 			Healing:         item.IsHealing(),
+			Cooked:          item.IsCooked(),
 			Plantable:       item.Plantable,
 			SourceVarietyID: item.SourceVarietyID,
 			BundleCount:     item.BundleCount,
 			DeathTimer:      item.DeathTimer,
 		}
+		if item.Durability != nil {
+			result[i].Durability = item.Durability.Current
+		}
 	}
 	return result
 }
@@ -301,6 +339,30 @@ func constructsToSave(constructs []*entity.Construct) []save.ConstructSave {
 			Passable:      c.Passable,
 			Movable:       c.Movable,
 			WallRole:      c.WallRole,
+			Fuel:          c.Fuel,
+		}
+		if c.Durability != nil {
+			result[i].Durability = c.Durability.Current
+		}
+	}
+	return result
+}
+
+// creaturesToSave converts creatures to save format
+func creaturesToSave(creatures []*entity.Creature) []save.CreatureSave {
+	result := make([]save.CreatureSave, len(creatures))
+	for i, c := range creatures {
+		result[i] = save.CreatureSave{
+			ID:              c.ID,
+			Kind:            c.Kind,
+			Position:        c.Pos(),
+			Hunger:          c.Hunger,
+			Thirst:          c.Thirst,
+			Energy:          c.Energy,
+			IsSleeping:      c.IsSleeping,
+			StayTimer:       c.StayTimer,
+			Leaving:         c.Leaving,
+			CurrentActivity: c.CurrentActivity,
 		}
 	}
 	return result
@@ -335,6 +397,66 @@ func waterTilesToSave(gameMap *game.Map) []save.WaterTileSave {
 	return result
 }
 
+func treeTilesToSave(gameMap *game.Map) []save.TreeTileSave {
+	positions := gameMap.TreePositions()
+	result := make([]save.TreeTileSave, len(positions))
+	for i, pos := range positions {
+		result[i] = save.TreeTileSave{
+			Position: pos,
+			TreeTile: int(gameMap.TreeAt(pos)),
+		}
+	}
+	return result
+}
+
+func biomeTilesToSave(gameMap *game.Map) []save.BiomeTileSave {
+	positions := gameMap.BiomePositions()
+	result := make([]save.BiomeTileSave, len(positions))
+	for i, pos := range positions {
+		result[i] = save.BiomeTileSave{
+			Position: pos,
+			Biome:    int(gameMap.BiomeAt(pos)),
+		}
+	}
+	return result
+}
+
+func channelTilesToSave(gameMap *game.Map) []save.ChannelTileSave {
+	positions := gameMap.ChannelPositions()
+	result := make([]save.ChannelTileSave, len(positions))
+	for i, pos := range positions {
+		silt, _ := gameMap.ChannelSilt(pos)
+		result[i] = save.ChannelTileSave{
+			Position: pos,
+			Silt:     silt,
+		}
+	}
+	return result
+}
+
+func soilTilesToSave(gameMap *game.Map) []save.SoilTileSave {
+	positions := gameMap.TilledPositions()
+	result := make([]save.SoilTileSave, len(positions))
+	for i, pos := range positions {
+		result[i] = save.SoilTileSave{
+			Position:  pos,
+			Fertility: gameMap.Fertility(pos),
+			LastCrop:  gameMap.LastCrop(pos),
+		}
+	}
+	return result
+}
+
+func burningTilesToSave(gameMap *game.Map) []save.BurningTileSave {
+	positions := gameMap.BurningPositions()
+	result := make([]save.BurningTileSave, len(positions))
+	for i, pos := range positions {
+		remaining, _ := gameMap.BurnRemaining(pos)
+		result[i] = save.BurningTileSave{Position: pos, Remaining: remaining}
+	}
+	return result
+}
+
 func wateredTilesToSaveManual(gameMap *game.Map) []save.WateredTileSave {
 	positions := gameMap.WateredPositions()
 	result := make([]save.WateredTileSave, len(positions))
@@ -362,10 +484,16 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 		worldID:          worldID,
 		lastSaveGameTime: state.ElapsedGameTime, // Treat load time as last save
 		speedMultiplier:  1,                     // Normal speed
+		contentPacks:     state.ContentPacks,
 	}
 
 	// Create map
 	m.gameMap = game.NewMap(state.MapWidth, state.MapHeight)
+	m.gameMap.SetGameTime(state.ElapsedGameTime)
+	m.gameMap.SetCalendar(game.ParseSeason(state.Season), state.SeasonElapsed)
+	weather, _ := game.ParseWeather(state.Weather)
+	forecast, _ := game.ParseWeather(state.Forecast)
+	m.gameMap.SetWeather(weather, forecast, state.WeatherRemaining)
 
 	// Restore variety registry
 	registry := varietiesFromSave(state.Varieties)
@@ -406,6 +534,43 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 	// Restore water tiles
 	for _, ws := range state.WaterTiles {
 		m.gameMap.AddWater(ws.Position, game.WaterType(ws.WaterType))
+		if game.WaterType(ws.WaterType) == game.WaterChannel {
+			m.gameMap.SetChannelSilt(ws.Position, config.ChannelSiltTime) // Overwritten below when saved
+		}
+	}
+	for _, cs := range state.ChannelTiles {
+		m.gameMap.SetChannelSilt(cs.Position, cs.Silt)
+	}
+	for _, pos := range state.DriedPondBeds {
+		m.gameMap.SetDriedPondBed(pos)
+	}
+
+	// Restore biomes
+	for _, bs := range state.Biomes {
+		m.gameMap.SetBiome(bs.Position, game.Biome(bs.Biome))
+	}
+
+	// Restore rock outcrops
+	for _, pos := range state.OutcropPositions {
+		m.gameMap.SetOutcrop(pos)
+	}
+
+	// Restore tree tiles, then the carving and chopping marks on them
+	trees := make(map[types.Position]game.TreeTile, len(state.TreeTiles))
+	for _, ts := range state.TreeTiles {
+		trees[ts.Position] = game.TreeTile(ts.TreeTile)
+	}
+	m.gameMap.SetTrees(trees)
+	for _, pos := range state.MarkedForCarving {
+		m.gameMap.MarkForCarving(pos)
+	}
+	for _, pos := range state.MarkedForChopping {
+		m.gameMap.MarkForChopping(pos)
+	}
+
+	// Restore channel digging marks
+	for _, pos := range state.MarkedForDigging {
+		m.gameMap.MarkForDigging(pos)
 	}
 
 	// Restore clay positions
@@ -417,6 +582,14 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 	for _, pos := range state.TilledPositions {
 		m.gameMap.SetTilled(pos)
 	}
+	for _, ss := range state.SoilTiles {
+		m.gameMap.SetSoil(ss.Position, ss.Fertility, ss.LastCrop)
+	}
+
+	// Restore wildfires
+	for _, bs := range state.BurningTiles {
+		m.gameMap.SetBurning(bs.Position, bs.Remaining)
+	}
 
 	// Restore marked-for-tilling positions
 	for _, pos := range state.MarkedForTillingPositions {
@@ -467,10 +640,28 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 		}
 	}
 
+	// Restore marked-for-deconstruction positions (after their constructs)
+	for _, pos := range state.MarkedForDeconstruction {
+		m.gameMap.MarkForDeconstruction(pos)
+	}
+
+	// Restore creatures (without auto-assigning IDs); kinds no longer registered are dropped
+	maxCreatureID := 0
+	for _, cs := range state.Creatures {
+		if _, ok := entity.CreatureKindRegistry[cs.Kind]; !ok {
+			continue
+		}
+		m.gameMap.AddCreatureDirect(creatureFromSave(cs))
+		if cs.ID > maxCreatureID {
+			maxCreatureID = cs.ID
+		}
+	}
+
 	// Set ID counters to max + 1 for future spawns
 	m.gameMap.SetNextItemID(maxItemID)
 	m.gameMap.SetNextFeatureID(maxFeatureID)
 	m.gameMap.SetNextConstructID(maxConstructID)
+	m.gameMap.SetNextCreatureID(maxCreatureID)
 
 	// Restore action logs
 	m.actionLog.SetAllLogs(actionLogsFromSave(state.ActionLogs))
@@ -489,6 +680,7 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 		Stick: state.GroundSpawnStick,
 		Nut:   state.GroundSpawnNut,
 		Shell: state.GroundSpawnShell,
+		Stone: state.GroundSpawnStone,
 	}
 	if m.groundSpawnTimers.Stick <= 0 {
 		m.groundSpawnTimers.Stick = system.RandomGroundSpawnInterval()
@@ -499,6 +691,33 @@ func FromSaveState(state *save.SaveState, worldID string, testCfg TestConfig) Mo
 	if m.groundSpawnTimers.Shell <= 0 {
 		m.groundSpawnTimers.Shell = system.RandomGroundSpawnInterval()
 	}
+	if m.groundSpawnTimers.Stone <= 0 {
+		m.groundSpawnTimers.Stone = system.RandomGroundSpawnInterval()
+	}
+
+	// Restore creature spawn timer (default to random if loading old save without it)
+	m.creatureSpawnTimer = state.CreatureSpawnTimer
+	if m.creatureSpawnTimer <= 0 {
+		m.creatureSpawnTimer = system.RandomCreatureSpawnInterval()
+	}
+
+	// Restore disabled systems (names no longer registered are dropped)
+	if len(state.DisabledSystems) > 0 {
+		pipeline := m.worldPipeline()
+		for _, name := range state.DisabledSystems {
+			if !pipeline.SetEnabled(name, false) {
+				save.LogWarning("Ignoring unknown disabled system %q", name)
+			}
+		}
+	}
+
+	// Apply per-world tuning (an invalid set is dropped rather than blocking the load)
+	if err := config.SetWorldTuning(state.Tuning); err != nil {
+		save.LogWarning("Ignoring world tuning: %v", err)
+		config.SetWorldTuning(nil)
+	} else {
+		m.tuning = state.Tuning
+	}
 
 	// Set cursor to first character position if any
 	chars := m.gameMap.Characters()
@@ -583,6 +802,7 @@ func characterFromSave(cs save.CharacterSave, registry *game.VarietyRegistry) *e
 		FailedIntentCount: cs.FailedIntentCount,
 
 		IdleCooldown:  cs.IdleCooldown,
+		FearCooldown:  cs.FearCooldown,
 		LastLookedX:   cs.LastLookedX,
 		LastLookedY:   cs.LastLookedY,
 		HasLastLooked: cs.HasLastLooked,
@@ -603,6 +823,11 @@ func characterFromSave(cs save.CharacterSave, registry *game.VarietyRegistry) *e
 		KnownRecipes:    cs.KnownRecipes,
 	}
 
+	char.Warmth = 100
+	if cs.Warmth != nil {
+		char.Warmth = *cs.Warmth
+	}
+
 	// Set position and symbol via BaseEntity
 	char.X = cs.Position.X
 	char.Y = cs.Position.Y
@@ -704,6 +929,7 @@ func itemFromSave(is save.ItemSave, registry *game.VarietyRegistry) *entity.Item
 		edible = &entity.EdibleProperties{
 			Poisonous: is.Poisonous,
 			Healing:   is.Healing,
+			Cooked:    is.Cooked,
 		}
 	}
 
@@ -756,6 +982,16 @@ func itemFromSave(is save.ItemSave, registry *game.VarietyRegistry) *entity.Item
 		item.Sym = config.CharClay
 	case "brick":
 		item.Sym = config.CharBrick
+	case "chisel":
+		item.Sym = config.CharChisel
+	case "wood":
+		item.Sym = config.CharWood
+	case "stone":
+		item.Sym = config.CharStone
+	case "flake":
+		item.Sym = config.CharFlake
+	case "axe":
+		item.Sym = config.CharAxe
 	}
 
 	// Override symbol for sprouts (must come after type-based switch)
@@ -773,6 +1009,11 @@ func itemFromSave(is save.ItemSave, registry *game.VarietyRegistry) *entity.Item
 		item.Kind = "tall grass"
 	}
 
+	// Crafted items wear; older saves without durability load at full
+	if item.Kind != "" {
+		item.Durability = durabilityFromSave(item.Material, is.Durability)
+	}
+
 	// Backward compat: old saves don't have Name on sticks/grass
 	if item.Name == "" {
 		switch item.ItemType {
@@ -839,6 +1080,8 @@ func constructFromSave(cs save.ConstructSave) *entity.Construct {
 		Passable:      cs.Passable,
 		Movable:       cs.Movable,
 		WallRole:      wallRole,
+		Durability:    durabilityFromSave(cs.Material, cs.Durability),
+		Fuel:          cs.Fuel,
 	}
 	c.X = cs.Position.X
 	c.Y = cs.Position.Y
@@ -853,11 +1096,39 @@ func constructFromSave(cs save.ConstructSave) *entity.Construct {
 		if wallRole == "door" {
 			c.Sym = config.CharHutDoor
 		}
+	case "campfire":
+		c.Sym = config.CharCampfire
+		c.Durability = nil // Campfires don't wear, they burn their fuel
 	}
 
 	return c
 }
 
+// creatureFromSave converts a saved creature back to an entity
+func creatureFromSave(cs save.CreatureSave) *entity.Creature {
+	c := entity.NewCreature(cs.X, cs.Y, cs.Kind)
+	c.ID = cs.ID
+	c.Hunger = cs.Hunger
+	c.Thirst = cs.Thirst
+	c.Energy = cs.Energy
+	c.IsSleeping = cs.IsSleeping
+	c.StayTimer = cs.StayTimer
+	c.Leaving = cs.Leaving
+	if cs.CurrentActivity != "" {
+		c.CurrentActivity = cs.CurrentActivity
+	}
+	return c
+}
+
+// durabilityFromSave restores durability for a material. A zero saved value (older saves) loads at full.
+func durabilityFromSave(material string, current float64) *entity.Durability {
+	d := entity.NewDurability(material)
+	if d != nil && current > 0 && current < d.Max {
+		d.Current = current
+	}
+	return d
+}
+
 // actionLogsFromSave converts saved action logs back to Event format
 func actionLogsFromSave(logs map[int][]save.EventSave) map[int][]system.Event {
 	result := make(map[int][]system.Event)
@@ -870,6 +1141,7 @@ func actionLogsFromSave(logs map[int][]save.EventSave) map[int][]system.Event {
 				CharName: se.CharName,
 				Type:     se.Type,
 				Message:  se.Message,
+				Key:      se.Key,
 			}
 		}
 		result[charID] = events
@@ -891,6 +1163,7 @@ func actionLogsToSave(log *system.ActionLog) map[int][]save.EventSave {
 				CharName: e.CharName,
 				Type:     e.Type,
 				Message:  e.Message,
+				Key:      e.Key,
 			}
 		}
 		result[charID] = savedEvents
@@ -6,6 +6,7 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/save"
 	"petri/internal/system"
 	"petri/internal/types"
 )
@@ -746,30 +747,15 @@ func TestBundleCountSerialization_RoundTrip(t *testing.T) {
 
 // createTestModel creates a Model with basic game state for testing
 func createTestModel() Model {
-	m := Model{
-		phase:           phasePlaying,
-		actionLog:       system.NewActionLog(200),
-		width:           80,
-		height:          40,
-		paused:          false,
-		elapsedGameTime: 50.0,
-		testCfg:         TestConfig{},
-	}
-
-	// Create map
-	m.gameMap = game.NewMap(40, 30)
-
-	// Generate and set varieties
-	registry := game.GenerateVarieties()
-	m.gameMap.SetVarieties(registry)
-
-	// Add a character
-	char := entity.NewCharacter(1, 10, 10, "TestChar", "berry", types.ColorRed)
-	m.gameMap.AddCharacter(char)
-
-	// Add second character for talking tests
-	char2 := entity.NewCharacter(2, 12, 12, "TestChar2", "mushroom", types.ColorBlue)
-	m.gameMap.AddCharacter(char2)
+	m := newTestModel(40, 30,
+		entity.NewCharacter(1, 10, 10, "TestChar", "berry", types.ColorRed),
+		// Second character for talking tests
+		entity.NewCharacter(2, 12, 12, "TestChar2", "mushroom", types.ColorBlue),
+	)
+	m.width = 80
+	m.height = 40
+	m.paused = false
+	m.elapsedGameTime = 50.0
 
 	// Add some items
 	m.gameMap.AddItem(entity.NewBerry(5, 5, types.ColorRed, false, false))
@@ -778,6 +764,21 @@ func createTestModel() Model {
 	return m
 }
 
+// newTestModel builds a paused, playing model on an empty map with generated varieties and the given characters
+func newTestModel(mapWidth, mapHeight int, chars ...*entity.Character) Model {
+	gameMap := game.NewMap(mapWidth, mapHeight)
+	gameMap.SetVarieties(game.GenerateVarieties())
+	for _, char := range chars {
+		gameMap.AddCharacter(char)
+	}
+	return Model{
+		phase:     phasePlaying,
+		paused:    true,
+		gameMap:   gameMap,
+		actionLog: system.NewActionLog(200),
+	}
+}
+
 func TestSproutSerialization_RoundTrip(t *testing.T) {
 	t.Parallel()
 
@@ -1304,6 +1305,112 @@ func TestFromSaveState_RestoresBrickItemSymbol(t *testing.T) {
 	}
 }
 
+func TestCreatureSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	m.creatureSpawnTimer = 123.5
+
+	rabbit := entity.NewCreature(4, 6, "rabbit")
+	rabbit.Hunger = 55
+	rabbit.Thirst = 30
+	rabbit.Energy = 8
+	rabbit.IsSleeping = true
+	rabbit.StayTimer = 42
+	m.gameMap.AddCreature(rabbit)
+	leaving := entity.NewCreature(0, 10, "rabbit")
+	leaving.Leaving = true
+	m.gameMap.AddCreature(leaving)
+
+	state := m.ToSaveState()
+	state.Creatures = append(state.Creatures, save.CreatureSave{ID: 9, Kind: "removed-kind"})
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	creatures := restored.gameMap.Creatures()
+	if len(creatures) != 2 {
+		t.Fatalf("Expected 2 creatures after round-trip (unknown kind dropped), got %d", len(creatures))
+	}
+	found := restored.gameMap.CreatureAt(types.Position{X: 4, Y: 6})
+	if found == nil {
+		t.Fatal("Rabbit not found at (4,6) after round-trip")
+	}
+	if found.ID != rabbit.ID || found.Kind != "rabbit" || found.Hunger != 55 || found.Thirst != 30 || found.Energy != 8 {
+		t.Errorf("Rabbit stats not restored: %+v", found)
+	}
+	if !found.IsSleeping || found.StayTimer != 42 || found.Leaving {
+		t.Errorf("Rabbit state not restored: sleeping=%v stay=%.1f leaving=%v", found.IsSleeping, found.StayTimer, found.Leaving)
+	}
+	if found.Sym != config.CharRabbit || found.Type() != entity.TypeCreature {
+		t.Errorf("Rabbit symbol/type not restored: %c, %d", found.Sym, found.Type())
+	}
+	if other := restored.gameMap.CreatureAt(types.Position{X: 0, Y: 10}); other == nil || !other.Leaving {
+		t.Error("Expected leaving rabbit restored as leaving")
+	}
+	if restored.creatureSpawnTimer != 123.5 {
+		t.Errorf("creatureSpawnTimer: got %.1f, want 123.5", restored.creatureSpawnTimer)
+	}
+
+	// New creatures get IDs past the restored ones
+	next := entity.NewCreature(8, 8, "rabbit")
+	restored.gameMap.AddCreature(next)
+	if next.ID <= leaving.ID {
+		t.Errorf("Expected new creature ID above %d, got %d", leaving.ID, next.ID)
+	}
+}
+
+func TestFromSaveState_OldSaveWithoutCreatureTimer_Randomizes(t *testing.T) {
+	m := createTestModel()
+	state := m.ToSaveState()
+	state.CreatureSpawnTimer = 0
+
+	restored := FromSaveState(state, "test-world", m.testCfg)
+	if restored.creatureSpawnTimer <= 0 {
+		t.Errorf("Expected a fresh creature spawn timer for old saves, got %.1f", restored.creatureSpawnTimer)
+	}
+}
+
+func TestDurabilitySerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+
+	fence := entity.NewFence(3, 3, "stick", types.ColorBrown)
+	fence.Durability.Current = 1234
+	m.gameMap.AddConstruct(fence)
+
+	vessel := entity.NewVessel(6, 6, "hollow gourd", "gourd")
+	vessel.Durability.Current = 567
+	m.gameMap.AddItem(vessel)
+
+	hoe := entity.NewHoe(0, 0, types.ColorSilver)
+	hoe.Durability.Current = 89
+	char := m.gameMap.Characters()[0]
+	char.AddToInventory(hoe)
+
+	restored := FromSaveState(m.ToSaveState(), "test-world", m.testCfg)
+
+	if c := restored.gameMap.ConstructAt(types.Position{X: 3, Y: 3}); c == nil || c.Durability == nil || c.Durability.Current != 1234 {
+		t.Errorf("Expected fence durability 1234 after round-trip, got %+v", c)
+	}
+	if item := restored.gameMap.ItemAt(types.Position{X: 6, Y: 6}); item == nil || item.Durability == nil || item.Durability.Current != 567 {
+		t.Errorf("Expected vessel durability 567 after round-trip, got %+v", item)
+	}
+	restoredChar := restored.gameMap.CharacterAt(char.Pos())
+	restoredHoe := restoredChar.FindInInventory(func(i *entity.Item) bool { return i.ItemType == "hoe" })
+	if restoredHoe == nil || restoredHoe.Durability == nil || restoredHoe.Durability.Current != 89 {
+		t.Errorf("Expected carried hoe durability 89 after round-trip, got %+v", restoredHoe)
+	}
+}
+
+func TestFromSaveState_OldSaveWithoutDurability_LoadsFull(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.AddConstruct(entity.NewFence(3, 3, "brick", types.ColorTerracotta))
+	state := m.ToSaveState()
+	state.Constructs[0].Durability = 0
+
+	restored := FromSaveState(state, "test-world", m.testCfg)
+	c := restored.gameMap.ConstructAt(types.Position{X: 3, Y: 3})
+	if c == nil || c.Durability == nil || c.Durability.Current != c.Durability.Max {
+		t.Errorf("Expected old-save construct at full durability, got %+v", c)
+	}
+}
+
 func TestConstructSerialization_RoundTrip(t *testing.T) {
 	m := createTestModel()
 
@@ -1548,6 +1655,178 @@ func TestConstructionMarkSerialization_BackwardCompat_NoWallRole(t *testing.T) {
 	}
 }
 
+func TestDeconstructionMarkSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	pos := types.Position{X: 3, Y: 3}
+	m.gameMap.AddConstruct(entity.NewFence(pos.X, pos.Y, "stick", types.ColorBrown))
+	m.gameMap.MarkForDeconstruction(pos)
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if !restored.gameMap.IsMarkedForDeconstruction(pos) {
+		t.Error("Deconstruction mark not restored after round-trip")
+	}
+	if got := len(restored.gameMap.MarkedForDeconstructionPositions()); got != 1 {
+		t.Errorf("MarkedForDeconstructionPositions() after round-trip: got %d, want 1", got)
+	}
+}
+
+func TestTreeSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	tiles := map[types.Position]game.TreeTile{
+		{X: 4, Y: 4}: game.TreeLivewood, {X: 5, Y: 4}: game.TreeOpening, {X: 6, Y: 4}: game.TreeLivewood,
+		{X: 4, Y: 5}: game.TreeLivewood, {X: 5, Y: 5}: game.TreeHollow, {X: 6, Y: 5}: game.TreeHeartwood,
+		{X: 4, Y: 6}: game.TreeLivewood, {X: 5, Y: 6}: game.TreeLivewood, {X: 6, Y: 6}: game.TreeLivewood,
+	}
+	m.gameMap.SetTrees(tiles)
+	m.gameMap.MarkForCarving(types.Position{X: 6, Y: 5})
+	m.gameMap.AddItem(entity.NewChisel(2, 2, types.ColorSilver))
+	m.gameMap.AddItem(entity.NewWood(3, 2))
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	for pos, tile := range tiles {
+		if got := restored.gameMap.TreeAt(pos); got != tile {
+			t.Errorf("Tree tile at %v: got %v, want %v", pos, got, tile)
+		}
+	}
+	if !restored.gameMap.IsMarkedForCarving(types.Position{X: 6, Y: 5}) {
+		t.Error("Carving mark not restored after round-trip")
+	}
+	if r := restored.gameMap.RegionOf(types.Position{X: 5, Y: 5}); r == nil || r.Kind != game.RegionTreeHollow {
+		t.Errorf("Expected the hollow to be a tree hollow region after round-trip, got %v", r)
+	}
+	if chisel := restored.gameMap.ItemAt(types.Position{X: 2, Y: 2}); chisel == nil || chisel.Sym != config.CharChisel {
+		t.Errorf("Expected the chisel symbol to be restored, got %v", chisel)
+	}
+	if wood := restored.gameMap.ItemAt(types.Position{X: 3, Y: 2}); wood == nil || wood.Sym != config.CharWood {
+		t.Errorf("Expected the wood symbol to be restored, got %v", wood)
+	}
+}
+
+func TestStoneSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.SetOutcrop(types.Position{X: 8, Y: 8})
+	m.gameMap.SetOutcrop(types.Position{X: 9, Y: 8})
+	m.gameMap.SetTrees(map[types.Position]game.TreeTile{{X: 4, Y: 4}: game.TreeLivewood})
+	m.gameMap.MarkForChopping(types.Position{X: 4, Y: 4})
+	m.gameMap.AddItem(entity.NewStone(2, 2))
+	m.gameMap.AddItem(entity.NewFlake(3, 2))
+	m.gameMap.AddItem(entity.NewAxe(4, 2))
+	m.groundSpawnTimers.Stone = 42
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if got := restored.gameMap.OutcropPositions(); len(got) != 2 || !restored.gameMap.IsOutcrop(types.Position{X: 9, Y: 8}) {
+		t.Errorf("Outcrops after round-trip: got %v", got)
+	}
+	if !restored.gameMap.IsMarkedForChopping(types.Position{X: 4, Y: 4}) {
+		t.Error("Chopping mark not restored after round-trip")
+	}
+	if restored.groundSpawnTimers.Stone != 42 {
+		t.Errorf("Stone spawn timer: got %v, want 42", restored.groundSpawnTimers.Stone)
+	}
+	wantSyms := map[types.Position]rune{{X: 2, Y: 2}: config.CharStone, {X: 3, Y: 2}: config.CharFlake, {X: 4, Y: 2}: config.CharAxe}
+	for pos, sym := range wantSyms {
+		if item := restored.gameMap.ItemAt(pos); item == nil || item.Sym != sym {
+			t.Errorf("Expected symbol %q at %v after round-trip, got %v", sym, pos, item)
+		}
+	}
+}
+
+func TestTerrainSerialization_BiomesAndRivers(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.SetBiome(types.Position{X: 1, Y: 1}, game.BiomeWetland)
+	m.gameMap.SetBiome(types.Position{X: 2, Y: 1}, game.BiomeClayFlats)
+	m.gameMap.AddWater(types.Position{X: 8, Y: 8}, game.WaterRiver)
+
+	state := m.ToSaveState()
+	if len(state.Biomes) != 2 {
+		t.Fatalf("Expected only the 2 non-meadow tiles saved, got %d", len(state.Biomes))
+	}
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if got := restored.gameMap.BiomeAt(types.Position{X: 1, Y: 1}); got != game.BiomeWetland {
+		t.Errorf("Biome at (1,1): got %s, want wetland", got.ID())
+	}
+	if got := restored.gameMap.BiomeAt(types.Position{X: 2, Y: 1}); got != game.BiomeClayFlats {
+		t.Errorf("Biome at (2,1): got %s, want clay_flats", got.ID())
+	}
+	if got := restored.gameMap.BiomeAt(types.Position{X: 3, Y: 1}); got != game.BiomeMeadow {
+		t.Errorf("Biome at (3,1): got %s, want meadow", got.ID())
+	}
+	if got := restored.gameMap.WaterAt(types.Position{X: 8, Y: 8}); got != game.WaterRiver {
+		t.Errorf("Water at (8,8): got %v, want river", got)
+	}
+}
+
+func TestWaterSerialization_ChannelsBedsAndDiggingMarks(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.AddWater(types.Position{X: 10, Y: 10}, game.WaterPond)
+	m.gameMap.AddWater(types.Position{X: 10, Y: 11}, game.WaterPond)
+	m.gameMap.DryPondTile(types.Position{X: 10, Y: 11})
+	channel := types.Position{X: 11, Y: 10}
+	m.gameMap.MarkForDigging(channel)
+	m.gameMap.DigChannel(channel)
+	m.gameMap.SetChannelSilt(channel, 42)
+	m.gameMap.MarkForDigging(types.Position{X: 12, Y: 10})
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if silt, ok := restored.gameMap.ChannelSilt(channel); !ok || silt != 42 {
+		t.Errorf("Channel at (11,10): got silt %.1f (channel %v), want 42", silt, ok)
+	}
+	if !restored.gameMap.IsDriedPondBed(types.Position{X: 10, Y: 11}) {
+		t.Error("Expected the dried pond bed to be restored")
+	}
+	if !restored.gameMap.IsMarkedForDigging(types.Position{X: 12, Y: 10}) {
+		t.Error("Expected the digging mark to be restored")
+	}
+}
+
+func TestCalendarSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.SetCalendar(game.SeasonAutumn, 123.5)
+
+	state := m.ToSaveState()
+	if state.Season != "autumn" {
+		t.Errorf("Saved season: got %q, want autumn", state.Season)
+	}
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if got := restored.gameMap.Season(); got != game.SeasonAutumn {
+		t.Errorf("Season after round-trip: got %s, want autumn", got.ID())
+	}
+	if got := restored.gameMap.SeasonElapsed(); got != 123.5 {
+		t.Errorf("SeasonElapsed after round-trip: got %.1f, want 123.5", got)
+	}
+}
+
+func TestWeatherSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	m.gameMap.SetWeather(game.WeatherStorm, game.WeatherDrought, 42.5)
+
+	state := m.ToSaveState()
+	if state.Weather != "storm" || state.Forecast != "drought" {
+		t.Errorf("Saved weather: got %q then %q, want storm then drought", state.Weather, state.Forecast)
+	}
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if got := restored.gameMap.Weather(); got != game.WeatherStorm {
+		t.Errorf("Weather after round-trip: got %s, want storm", got.ID())
+	}
+	if got := restored.gameMap.Forecast(); got != game.WeatherDrought {
+		t.Errorf("Forecast after round-trip: got %s, want drought", got.ID())
+	}
+	if got := restored.gameMap.WeatherRemaining(); got != 42.5 {
+		t.Errorf("WeatherRemaining after round-trip: got %.1f, want 42.5", got)
+	}
+}
+
 func TestGetOrderableActivities_IncludesConstructionCategory(t *testing.T) {
 	m := createTestModel()
 	char := m.gameMap.Characters()[0]
@@ -1566,3 +1845,115 @@ func TestGetOrderableActivities_IncludesConstructionCategory(t *testing.T) {
 		t.Error("getOrderableActivities() should include category:construction when a character knows buildFence")
 	}
 }
+
+func TestFromSaveState_RestoresDisabledSystems(t *testing.T) {
+	m := createTestModel()
+	m.worldPipeline().SetEnabled("groundSpawning", false)
+
+	state := m.ToSaveState()
+	if len(state.DisabledSystems) != 1 || state.DisabledSystems[0] != "groundSpawning" {
+		t.Fatalf("Expected groundSpawning saved as disabled, got %v", state.DisabledSystems)
+	}
+
+	state.DisabledSystems = append(state.DisabledSystems, "noSuchSystem")
+	restored := FromSaveState(state, "test-world", m.testCfg)
+	pipeline := restored.worldPipeline()
+	if pipeline.Enabled("groundSpawning") {
+		t.Error("Expected groundSpawning to stay disabled after load")
+	}
+	if !pipeline.Enabled("survival") {
+		t.Error("Expected other systems to stay enabled")
+	}
+}
+
+func TestFromSaveState_RestoresContentPacks(t *testing.T) {
+	m := createTestModel()
+	m.contentPacks = []string{"base@1", "reeds@2"}
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+	if len(restored.contentPacks) != 2 || restored.contentPacks[1] != "reeds@2" {
+		t.Errorf("Expected content packs preserved, got %v", restored.contentPacks)
+	}
+	if restored.ToSaveState().ContentPacks[1] != "reeds@2" {
+		t.Error("Expected content packs written back on the next save")
+	}
+}
+
+// Not parallel: FromSaveState applies the world tuning to global config
+func TestFromSaveState_RestoresAndAppliesTuning(t *testing.T) {
+	t.Cleanup(func() { config.SetWorldTuning(nil) })
+
+	m := createTestModel()
+	m.tuning = map[string]float64{"thirst_increase_rate": 0.5}
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+	if config.ThirstIncreaseRate != 0.5 {
+		t.Errorf("Expected world tuning applied, got thirst rate %v", config.ThirstIncreaseRate)
+	}
+	if restored.ToSaveState().Tuning["thirst_increase_rate"] != 0.5 {
+		t.Error("Expected tuning written back on the next save")
+	}
+
+	// Invalid tuning is dropped instead of blocking the load
+	state.Tuning = map[string]float64{"unknown_knob": 1}
+	restored = FromSaveState(state, "test-world", m.testCfg)
+	if restored.tuning != nil {
+		t.Errorf("Expected invalid tuning dropped, got %v", restored.tuning)
+	}
+}
+
+func TestSoilSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	worn := types.Position{X: 10, Y: 10}
+	rich := types.Position{X: 11, Y: 10}
+	m.gameMap.SetTilled(worn)
+	m.gameMap.SetTilled(rich)
+	m.gameMap.SetSoil(worn, 0.4, "gourd")
+	m.gameMap.CompostSoil(rich, 0.3)
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	if got := restored.gameMap.Fertility(worn); got != 0.4 {
+		t.Errorf("Fertility at (10,10): got %.2f, want 0.4", got)
+	}
+	if got := restored.gameMap.LastCrop(worn); got != "gourd" {
+		t.Errorf("Last crop at (10,10): got %q, want gourd", got)
+	}
+	if got := restored.gameMap.Fertility(rich); got != 1.3 {
+		t.Errorf("Fertility at (11,10): got %.2f, want 1.3", got)
+	}
+}
+
+func TestFireSerialization_RoundTrip(t *testing.T) {
+	m := createTestModel()
+	firePos := types.Position{X: 10, Y: 10}
+	fire := entity.NewCampfire(firePos.X, firePos.Y)
+	fire.AddFuel(90, config.CampfireMaxFuel)
+	m.gameMap.AddConstruct(fire)
+
+	grassPos := types.Position{X: 12, Y: 10}
+	m.gameMap.AddItem(entity.NewGrass(grassPos.X, grassPos.Y))
+	m.gameMap.SetBurning(grassPos, 7)
+
+	berry := entity.NewBerry(13, 10, types.ColorRed, true, false)
+	berry.Cook()
+	m.gameMap.AddItem(berry)
+
+	state := m.ToSaveState()
+	restored := FromSaveState(state, "test-world", m.testCfg)
+
+	rfire := restored.gameMap.ConstructAt(firePos)
+	if rfire == nil || !rfire.IsLit() || rfire.Fuel != 90 || rfire.Durability != nil {
+		t.Errorf("Expected a lit campfire with 90s of fuel and no durability, got %+v", rfire)
+	}
+	if remaining, ok := restored.gameMap.BurnRemaining(grassPos); !ok || remaining != 7 {
+		t.Errorf("Expected the grass to still be burning with 7s left, got %.1f (%v)", remaining, ok)
+	}
+	rberry := restored.gameMap.ItemAt(types.Position{X: 13, Y: 10})
+	if rberry == nil || !rberry.IsCooked() || rberry.IsPoisonous() {
+		t.Errorf("Expected a cooked, harmless berry, got %+v", rberry)
+	}
+}
@@ -0,0 +1,796 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"petri/internal/config"
+	"petri/internal/content"
+	"petri/internal/entity"
+	"petri/internal/game"
+	"petri/internal/save"
+	"petri/internal/system"
+	"petri/internal/types"
+)
+
+// Server runs a world headless and exposes it over a local HTTP JSON API.
+// The model is the same one the TUI drives; POST endpoints go through the same
+// order creation, cancellation, and marking paths as the orders panel.
+type Server struct {
+	mu    sync.Mutex
+	model Model
+}
+
+// NewServer wraps a model that is already in the playing phase.
+// Metrics are always collected in serve mode and exposed at GET /metrics.
+func NewServer(m Model) *Server {
+	m.lastUpdate = time.Now()
+	if m.metrics == nil {
+		m.metrics = NewMetrics()
+	}
+	m.recordMetrics() // Populate gauges before the first tick
+	return &Server{model: m}
+}
+
+// LoadServer loads a saved world for headless serving.
+// An empty worldID creates a new random world instead.
+func LoadServer(worldID string, testCfg TestConfig) (*Server, error) {
+	if worldID == "" {
+		m := NewModel(testCfg).startGameRandom()
+		m.paused = true
+		return NewServer(m), nil
+	}
+	state, err := save.LoadWorld(worldID)
+	if err != nil {
+		return nil, fmt.Errorf("load world %s: %w", worldID, err)
+	}
+	if missing := content.Missing(state.ContentPacks); len(missing) > 0 {
+		return nil, fmt.Errorf("load world %s: missing content packs %v", worldID, missing)
+	}
+	if err := applyWorldTuningFile(worldID, state); err != nil {
+		return nil, fmt.Errorf("load world %s: %w", worldID, err)
+	}
+	return NewServer(FromSaveState(state, worldID, testCfg)), nil
+}
+
+// WorldID returns the ID of the world being served
+func (s *Server) WorldID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.model.worldID
+}
+
+// Run advances the simulation on the TUI tick interval until stop is closed.
+// Paused worlds do not advance; speed changes take effect on the next tick.
+func (s *Server) Run(stop <-chan struct{}) {
+	for {
+		s.mu.Lock()
+		interval := config.UpdateInterval * time.Duration(s.model.speedMultiplier)
+		s.mu.Unlock()
+
+		select {
+		case <-stop:
+			return
+		case now := <-time.After(interval):
+			s.tick(now)
+		}
+	}
+}
+
+// tick runs one real-time game update if the world is not paused
+func (s *Server) tick(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.model.paused {
+		return
+	}
+	s.model, _ = s.model.updateGame(now)
+}
+
+// Save writes the current world state to disk
+func (s *Server) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.model.saveGame()
+}
+
+// Handler returns the HTTP handler for the API
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /world", s.handleWorld)
+	mux.HandleFunc("GET /tiles", s.handleTiles)
+	mux.HandleFunc("GET /characters", s.handleCharacters)
+	mux.HandleFunc("GET /items", s.handleItems)
+	mux.HandleFunc("GET /orders", s.handleOrders)
+	mux.HandleFunc("GET /events", s.handleEvents)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+	mux.HandleFunc("POST /orders", s.handleCreateOrder)
+	mux.HandleFunc("POST /orders/cancel", s.handleCancelOrder)
+	mux.HandleFunc("POST /marks/till", s.handleMarkTill)
+	mux.HandleFunc("POST /marks/fence", s.handleMarkFence)
+	mux.HandleFunc("POST /marks/hut", s.handleMarkHut)
+	mux.HandleFunc("POST /marks/deconstruct", s.handleMarkDeconstruct)
+	mux.HandleFunc("POST /marks/carve", s.handleMarkCarve)
+	mux.HandleFunc("POST /marks/chop", s.handleMarkChop)
+	mux.HandleFunc("POST /marks/channel", s.handleMarkChannel)
+	mux.HandleFunc("POST /marks/campfire", s.handleMarkCampfire)
+	mux.HandleFunc("POST /pause", s.handlePause)
+	mux.HandleFunc("POST /step", s.handleStep)
+	mux.HandleFunc("POST /speed", s.handleSpeed)
+	mux.HandleFunc("GET /systems", s.handleSystems)
+	mux.HandleFunc("POST /systems", s.handleUpdateSystem)
+	return mux
+}
+
+// =============================================================================
+// Response types
+// =============================================================================
+
+// WorldResponse summarizes the world clock and simulation controls
+type WorldResponse struct {
+	WorldID         string  `json:"world_id"`
+	ElapsedGameTime float64 `json:"elapsed_game_time"`
+	Day             int     `json:"day"`
+	Season          string  `json:"season"`      // spring, summer, autumn, or winter
+	Clock           string  `json:"clock"`       // World clock, HH:MM
+	TimeOfDay       string  `json:"time_of_day"` // dawn, day, dusk, or night
+	Weather         string  `json:"weather"`     // clear, rain, storm, or drought
+	Forecast        string  `json:"forecast"`    // Weather after the current spell
+	Paused          bool    `json:"paused"`
+	SpeedMultiplier int     `json:"speed_multiplier"`
+	MapWidth        int     `json:"map_width"`
+	MapHeight       int     `json:"map_height"`
+	CharacterCount  int     `json:"character_count"`
+	AliveCount      int     `json:"alive_count"`
+	ItemCount       int     `json:"item_count"`
+	OrderCount      int     `json:"order_count"`
+}
+
+// TilesResponse lists all non-empty terrain, features, constructs, and marks.
+// Entries use the same shapes as the save file.
+type TilesResponse struct {
+	MapWidth                int                         `json:"map_width"`
+	MapHeight               int                         `json:"map_height"`
+	Water                   []save.WaterTileSave        `json:"water"`
+	Trees                   []save.TreeTileSave         `json:"trees"`
+	Biomes                  []save.BiomeTileSave        `json:"biomes"`
+	Channels                []save.ChannelTileSave      `json:"channels"`
+	DriedPondBeds           []types.Position            `json:"dried_pond_beds"`
+	Clay                    []types.Position            `json:"clay"`
+	Outcrops                []types.Position            `json:"outcrops"`
+	Tilled                  []types.Position            `json:"tilled"`
+	Soil                    []save.SoilTileSave         `json:"soil"`
+	MarkedForTilling        []types.Position            `json:"marked_for_tilling"`
+	MarkedForConstruction   []save.ConstructionMarkSave `json:"marked_for_construction"`
+	MarkedForDeconstruction []types.Position            `json:"marked_for_deconstruction"`
+	MarkedForCarving        []types.Position            `json:"marked_for_carving"`
+	MarkedForChopping       []types.Position            `json:"marked_for_chopping"`
+	MarkedForDigging        []types.Position            `json:"marked_for_digging"`
+	Watered                 []save.WateredTileSave      `json:"watered"`
+	Features                []save.FeatureSave          `json:"features"`
+	Constructs              []save.ConstructSave        `json:"constructs"`
+}
+
+// OrderResponse is an order with its display strings
+type OrderResponse struct {
+	save.OrderSave
+	DisplayName   string `json:"display_name"`
+	StatusDisplay string `json:"status_display"`
+}
+
+// =============================================================================
+// Request types
+// =============================================================================
+
+// CreateOrderRequest creates an order. TargetType must be one of the options
+// the orders panel would offer for the activity.
+type CreateOrderRequest struct {
+	ActivityID string `json:"activity_id"`
+	TargetType string `json:"target_type"`
+}
+
+// CancelOrderRequest cancels an order by ID
+type CancelOrderRequest struct {
+	ID int `json:"id"`
+}
+
+// AreaMarkRequest marks a rectangle (tilling) or line (fence) from Anchor to Cursor
+type AreaMarkRequest struct {
+	Anchor types.Position `json:"anchor"`
+	Cursor types.Position `json:"cursor"`
+	Unmark bool           `json:"unmark"`
+}
+
+// HutMarkRequest marks a 5×5 hut footprint with its top-left corner at Position.
+// With Unmark set, removes the whole footprint whose mark covers Position.
+type HutMarkRequest struct {
+	types.Position
+	Unmark bool `json:"unmark"`
+}
+
+// CampfireMarkRequest marks Position for a campfire, or with Unmark set clears its campfire mark
+type CampfireMarkRequest struct {
+	types.Position
+	Unmark bool `json:"unmark"`
+}
+
+// PauseRequest sets the paused state
+type PauseRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// StepRequest advances a paused world by Ticks ticks (default 1, at most config.MaxStepTicks)
+type StepRequest struct {
+	Ticks int `json:"ticks"`
+}
+
+// SpeedRequest sets the speed multiplier (1 = normal, 2 = half speed, 4 = quarter speed)
+type SpeedRequest struct {
+	Multiplier int `json:"multiplier"`
+}
+
+// SystemResponse describes one per-tick system and its profile, if profiling
+type SystemResponse struct {
+	Name    string  `json:"name"`
+	Phase   string  `json:"phase"`
+	Enabled bool    `json:"enabled"`
+	Calls   int     `json:"calls,omitempty"`
+	TotalMs float64 `json:"total_ms,omitempty"`
+}
+
+// SystemsResponse lists systems in run order
+type SystemsResponse struct {
+	Profiling bool             `json:"profiling"`
+	Systems   []SystemResponse `json:"systems"`
+}
+
+// UpdateSystemRequest enables or disables one system and/or toggles profiling.
+// Omitted fields are left unchanged.
+type UpdateSystemRequest struct {
+	Name      string `json:"name,omitempty"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+	Profiling *bool  `json:"profiling,omitempty"`
+}
+
+// =============================================================================
+// GET handlers
+// =============================================================================
+
+func (s *Server) handleWorld(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, s.model.worldResponse())
+}
+
+// worldResponse builds the world summary
+func (m Model) worldResponse() WorldResponse {
+	chars := m.gameMap.Characters()
+	alive := 0
+	for _, c := range chars {
+		if !c.IsDead {
+			alive++
+		}
+	}
+	return WorldResponse{
+		WorldID:         m.worldID,
+		ElapsedGameTime: m.elapsedGameTime,
+		Day:             system.WorldDay(m.elapsedGameTime),
+		Season:          m.gameMap.Season().ID(),
+		Clock:           system.FormatClock(m.elapsedGameTime),
+		TimeOfDay:       system.TimeOfDayAt(m.elapsedGameTime).ID(),
+		Weather:         m.gameMap.Weather().ID(),
+		Forecast:        m.gameMap.Forecast().ID(),
+		Paused:          m.paused,
+		SpeedMultiplier: m.speedMultiplier,
+		MapWidth:        m.gameMap.Width,
+		MapHeight:       m.gameMap.Height,
+		CharacterCount:  len(chars),
+		AliveCount:      alive,
+		ItemCount:       len(m.gameMap.Items()),
+		OrderCount:      len(m.orders),
+	}
+}
+
+func (s *Server) handleTiles(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	gm := s.model.gameMap
+	writeJSON(w, http.StatusOK, TilesResponse{
+		MapWidth:                gm.Width,
+		MapHeight:               gm.Height,
+		Water:                   waterTilesToSave(gm),
+		Trees:                   treeTilesToSave(gm),
+		Biomes:                  biomeTilesToSave(gm),
+		Channels:                channelTilesToSave(gm),
+		DriedPondBeds:           gm.DriedPondBedPositions(),
+		Clay:                    gm.ClayPositions(),
+		Outcrops:                gm.OutcropPositions(),
+		Tilled:                  gm.TilledPositions(),
+		Soil:                    soilTilesToSave(gm),
+		MarkedForTilling:        gm.MarkedForTillingPositions(),
+		MarkedForConstruction:   constructionMarksToSave(gm),
+		MarkedForDeconstruction: gm.MarkedForDeconstructionPositions(),
+		MarkedForCarving:        gm.MarkedForCarvingPositions(),
+		MarkedForChopping:       gm.MarkedForChoppingPositions(),
+		MarkedForDigging:        gm.MarkedForDiggingPositions(),
+		Watered:                 wateredTilesToSaveManual(gm),
+		Features:                featuresToSave(gm.Features()),
+		Constructs:              constructsToSave(gm.Constructs()),
+	})
+}
+
+func (s *Server) handleCharacters(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, charactersToSave(s.model.gameMap.Characters()))
+}
+
+func (s *Server) handleItems(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, itemsToSave(s.model.gameMap.Items()))
+}
+
+func (s *Server) handleOrders(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, ordersToResponse(s.model.orders))
+}
+
+// ordersToResponse converts orders to API responses
+func ordersToResponse(orders []*entity.Order) []OrderResponse {
+	saved := ordersToSave(orders)
+	result := make([]OrderResponse, len(orders))
+	for i, order := range orders {
+		result[i] = OrderResponse{
+			OrderSave:     saved[i],
+			DisplayName:   order.DisplayName(),
+			StatusDisplay: order.StatusDisplay(),
+		}
+	}
+	return result
+}
+
+// handleEvents returns the combined event log, newest first.
+// Optional query: limit (default 100).
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	limit := 100
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := s.model.actionLog.AllEvents(limit)
+	result := make([]save.EventSave, len(events))
+	for i, e := range events {
+		result[i] = save.EventSave{
+			GameTime: e.GameTime,
+			CharID:   e.CharID,
+			CharName: e.CharName,
+			Type:     e.Type,
+			Message:  e.Message,
+		}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleMetrics serves the Prometheus text exposition
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	mt := s.model.metrics
+	s.mu.Unlock()
+	mt.ServeHTTP(w, r)
+}
+
+func (s *Server) handleSystems(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, s.model.systemsResponse())
+}
+
+// systemsResponse lists the world's systems with their enabled state and profile
+func (m *Model) systemsResponse() SystemsResponse {
+	pipeline := m.worldPipeline()
+	profiles := make(map[string]system.SystemProfile)
+	for _, prof := range pipeline.Profile() {
+		profiles[prof.Name] = prof
+	}
+
+	resp := SystemsResponse{Profiling: pipeline.Profiling(), Systems: []SystemResponse{}}
+	for _, sys := range pipeline.Systems() {
+		prof := profiles[sys.Name()]
+		resp.Systems = append(resp.Systems, SystemResponse{
+			Name:    sys.Name(),
+			Phase:   sys.Phase().String(),
+			Enabled: pipeline.Enabled(sys.Name()),
+			Calls:   prof.Calls,
+			TotalMs: float64(prof.Total.Microseconds()) / 1000,
+		})
+	}
+	return resp
+}
+
+// =============================================================================
+// POST handlers
+// =============================================================================
+
+func (s *Server) handleCreateOrder(w http.ResponseWriter, r *http.Request) {
+	var req CreateOrderRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	order, err := s.model.createOrder(req.ActivityID, req.TargetType)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, ordersToResponse([]*entity.Order{order})[0])
+}
+
+// createOrder validates an order against the options the orders panel offers,
+// then creates it through addOrder.
+func (m *Model) createOrder(activityID, targetType string) (*entity.Order, error) {
+	targets, ok := m.orderTargetOptions(activityID)
+	if !ok {
+		return nil, fmt.Errorf("activity %q is not orderable", activityID)
+	}
+	valid := false
+	for _, t := range targets {
+		if t == targetType {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("target %q is not available for %s", targetType, activityID)
+	}
+
+	// Marked-area orders need marks first, same as confirming step 2 in the panel
+	switch activityID {
+	case "tillSoil":
+		if len(m.gameMap.MarkedForTillingPositions()) == 0 {
+			return nil, fmt.Errorf("no tiles are marked for tilling")
+		}
+	case "buildFence":
+		if !m.gameMap.HasUnbuiltConstructionPositions("fence") {
+			return nil, fmt.Errorf("no tiles are marked for fence construction")
+		}
+	case "buildHut":
+		if !m.gameMap.HasUnbuiltConstructionPositions("hut") {
+			return nil, fmt.Errorf("no tiles are marked for hut construction")
+		}
+	case "deconstruct":
+		if !system.HasMarkedConstructs(m.gameMap) {
+			return nil, fmt.Errorf("no constructs are marked for deconstruction")
+		}
+	case "carveWood":
+		if !system.HasCarvableMarks(m.gameMap) {
+			return nil, fmt.Errorf("no tree tiles are marked for carving")
+		}
+	case "chopWood":
+		if !system.HasChoppableMarks(m.gameMap) {
+			return nil, fmt.Errorf("no tree tiles are marked for chopping")
+		}
+	case "digChannel":
+		if !system.HasChannelWork(m.gameMap) {
+			return nil, fmt.Errorf("no marked tile touches water and no channel needs clearing")
+		}
+	case "compost":
+		if !system.HasCompostWork(m.gameMap) {
+			return nil, fmt.Errorf("no tilled tile can take more compost")
+		}
+	case "buildCampfire":
+		if !system.HasCampfireWork(m.gameMap) {
+			return nil, fmt.Errorf("no campfire is marked or needs feeding")
+		}
+	}
+
+	return m.addOrder(activityID, targetType), nil
+}
+
+// orderTargetOptions returns the target types the orders panel offers for an activity.
+// Returns false if the activity is not currently orderable.
+// Activities without a target type return a single empty target.
+func (m Model) orderTargetOptions(activityID string) ([]string, bool) {
+	for _, activity := range m.getOrderableActivities() {
+		if isSyntheticCategory(activity.ID) {
+			for _, catActivity := range m.getCategoryActivities(syntheticCategoryID(activity.ID)) {
+				if catActivity.ID != activityID {
+					continue
+				}
+				if activityID == "plant" {
+					var targets []string
+					for _, pt := range game.GetPlantableTypes(m.gameMap.Items(), m.gameMap.Characters()) {
+						targets = append(targets, pt.TargetType)
+					}
+					return targets, true
+				}
+				return []string{""}, true
+			}
+			continue
+		}
+		if activity.ID != activityID {
+			continue
+		}
+		switch activityID {
+		case "dig":
+			return []string{"clay"}, true
+		case "gather":
+			var targets []string
+			for _, gt := range game.GetGatherableTypes(m.gameMap.Items()) {
+				targets = append(targets, gt.TargetType)
+			}
+			return targets, true
+		case "extract":
+			var targets []string
+			for _, et := range game.GetExtractableItemTypes(m.gameMap.Items()) {
+				targets = append(targets, et.TargetType)
+			}
+			return targets, true
+		default:
+			return m.getHarvestableItemTypes(), true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) handleCancelOrder(w http.ResponseWriter, r *http.Request) {
+	var req CancelOrderRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.model.cancelOrder(req.ID) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("order %d not found", req.ID))
+		return
+	}
+	writeJSON(w, http.StatusOK, ordersToResponse(s.model.orders))
+}
+
+func (s *Server) handleMarkTill(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markTillingArea(req.Anchor, req.Cursor, req.Unmark)
+	writeJSON(w, http.StatusOK, s.model.gameMap.MarkedForTillingPositions())
+}
+
+func (s *Server) handleMarkFence(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markFenceLine(req.Anchor, req.Cursor, req.Unmark)
+	writeJSON(w, http.StatusOK, constructionMarksToSave(s.model.gameMap))
+}
+
+func (s *Server) handleMarkHut(w http.ResponseWriter, r *http.Request) {
+	var req HutMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.Unmark {
+		if !s.model.unmarkHutAt(req.Position) {
+			writeError(w, http.StatusUnprocessableEntity, "position is not marked for construction")
+			return
+		}
+	} else if !s.model.markHutFootprint(req.X, req.Y) {
+		writeError(w, http.StatusUnprocessableEntity, "invalid hut footprint")
+		return
+	}
+	writeJSON(w, http.StatusOK, constructionMarksToSave(s.model.gameMap))
+}
+
+func (s *Server) handleMarkDeconstruct(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markDeconstructionArea(req.Anchor, req.Cursor, false, req.Unmark)
+	writeJSON(w, http.StatusOK, s.model.gameMap.MarkedForDeconstructionPositions())
+}
+
+func (s *Server) handleMarkCarve(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markCarvingArea(req.Anchor, req.Cursor, req.Unmark)
+	writeJSON(w, http.StatusOK, s.model.gameMap.MarkedForCarvingPositions())
+}
+
+func (s *Server) handleMarkChop(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markChoppingArea(req.Anchor, req.Cursor, req.Unmark)
+	writeJSON(w, http.StatusOK, s.model.gameMap.MarkedForChoppingPositions())
+}
+
+func (s *Server) handleMarkChannel(w http.ResponseWriter, r *http.Request) {
+	var req AreaMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.markDiggingLine(req.Anchor, req.Cursor, req.Unmark)
+	writeJSON(w, http.StatusOK, s.model.gameMap.MarkedForDiggingPositions())
+}
+
+func (s *Server) handleMarkCampfire(w http.ResponseWriter, r *http.Request) {
+	var req CampfireMarkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.model.setCampfireMark(req.Position, req.Unmark) {
+		if req.Unmark {
+			writeError(w, http.StatusUnprocessableEntity, "position is not marked for a campfire")
+		} else {
+			writeError(w, http.StatusUnprocessableEntity, "cannot place a campfire there")
+		}
+		return
+	}
+	writeJSON(w, http.StatusOK, constructionMarksToSave(s.model.gameMap))
+}
+
+func (s *Server) handlePause(w http.ResponseWriter, r *http.Request) {
+	var req PauseRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.setPaused(req.Paused)
+	writeJSON(w, http.StatusOK, s.model.worldResponse())
+}
+
+// setPaused pauses or resumes the simulation, mirroring the SPACE key:
+// saves when pausing and resets lastUpdate when resuming.
+func (m *Model) setPaused(paused bool) {
+	if m.paused == paused {
+		return
+	}
+	m.paused = paused
+	if m.paused {
+		m.saveGame()
+	} else {
+		// Reset lastUpdate when unpausing to prevent accumulated delta
+		m.lastUpdate = time.Now()
+	}
+}
+
+func (s *Server) handleStep(w http.ResponseWriter, r *http.Request) {
+	var req StepRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Ticks == 0 {
+		req.Ticks = 1
+	}
+	if req.Ticks < 0 {
+		writeError(w, http.StatusBadRequest, "ticks must be positive")
+		return
+	}
+	if req.Ticks > config.MaxStepTicks {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("ticks must be at most %d", config.MaxStepTicks))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.model.paused {
+		writeError(w, http.StatusConflict, "world must be paused to step")
+		return
+	}
+	for i := 0; i < req.Ticks; i++ {
+		s.model.stepForward()
+	}
+	writeJSON(w, http.StatusOK, s.model.worldResponse())
+}
+
+func (s *Server) handleSpeed(w http.ResponseWriter, r *http.Request) {
+	var req SpeedRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Multiplier != 1 && req.Multiplier != 2 && req.Multiplier != 4 {
+		writeError(w, http.StatusBadRequest, "multiplier must be 1, 2, or 4")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.speedMultiplier = req.Multiplier
+	writeJSON(w, http.StatusOK, s.model.worldResponse())
+}
+
+// =============================================================================
+// JSON helpers
+// =============================================================================
+
+// readJSON decodes the request body into v. An empty body leaves v at its zero value.
+// Writes a 400 response and returns false on malformed input.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func (s *Server) handleUpdateSystem(w http.ResponseWriter, r *http.Request) {
+	var req UpdateSystemRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pipeline := s.model.worldPipeline()
+	if req.Enabled != nil {
+		if !pipeline.SetEnabled(req.Name, *req.Enabled) {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("unknown system %q", req.Name))
+			return
+		}
+	}
+	if req.Profiling != nil {
+		pipeline.SetProfiling(*req.Profiling)
+	}
+	writeJSON(w, http.StatusOK, s.model.systemsResponse())
+}
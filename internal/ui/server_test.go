@@ -0,0 +1,311 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"petri/internal/config"
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+// newTestServer creates a server over a small paused world with one character
+func newTestServer(t *testing.T) (*Server, *entity.Character) {
+	t.Helper()
+	char := entity.NewCharacter(1, 5, 5, "Alice", "berry", types.ColorRed)
+	m := newTestModel(20, 20, char)
+	m.nextOrderID = 1
+	m.speedMultiplier = 1
+	return NewServer(m), char
+}
+
+// doRequest sends a request to the server handler and returns the recorder
+func doRequest(t *testing.T, s *Server, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatalf("encode body: %v", err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServer_GetWorld(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, "GET", "/world", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp WorldResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.MapWidth != 20 || resp.AliveCount != 1 || !resp.Paused {
+		t.Errorf("Unexpected world response: %+v", resp)
+	}
+}
+
+func TestServer_CreateOrder_UsesOrderFlow(t *testing.T) {
+	t.Parallel()
+
+	s, char := newTestServer(t)
+	char.LearnActivity("harvest")
+	s.model.gameMap.AddItem(entity.NewBerry(8, 8, types.ColorRed, false, false))
+
+	rec := doRequest(t, s, "POST", "/orders", CreateOrderRequest{ActivityID: "harvest", TargetType: "berry"})
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if len(s.model.orders) != 1 {
+		t.Fatalf("Expected 1 order, got %d", len(s.model.orders))
+	}
+	if s.model.orders[0].ID != 1 || s.model.nextOrderID != 2 {
+		t.Errorf("Expected order ID 1 and nextOrderID 2, got %d and %d", s.model.orders[0].ID, s.model.nextOrderID)
+	}
+	if s.model.orderFlashMessage != s.model.orders[0].DisplayName() {
+		t.Errorf("Expected order flash %q, got %q", s.model.orders[0].DisplayName(), s.model.orderFlashMessage)
+	}
+}
+
+func TestServer_CreateOrder_RejectsUnknownActivity(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	// Nobody knows harvest
+	rec := doRequest(t, s, "POST", "/orders", CreateOrderRequest{ActivityID: "harvest", TargetType: "berry"})
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422, got %d", rec.Code)
+	}
+	if len(s.model.orders) != 0 {
+		t.Errorf("Expected no orders, got %d", len(s.model.orders))
+	}
+}
+
+func TestServer_CreateOrder_TillSoilRequiresMarks(t *testing.T) {
+	t.Parallel()
+
+	s, char := newTestServer(t)
+	char.LearnActivity("tillSoil")
+
+	rec := doRequest(t, s, "POST", "/orders", CreateOrderRequest{ActivityID: "tillSoil"})
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422 without marks, got %d", rec.Code)
+	}
+
+	rec = doRequest(t, s, "POST", "/marks/till", AreaMarkRequest{
+		Anchor: types.Position{X: 1, Y: 1},
+		Cursor: types.Position{X: 2, Y: 2},
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 marking, got %d", rec.Code)
+	}
+	if got := len(s.model.gameMap.MarkedForTillingPositions()); got != 4 {
+		t.Errorf("Expected 4 marked tiles, got %d", got)
+	}
+
+	rec = doRequest(t, s, "POST", "/orders", CreateOrderRequest{ActivityID: "tillSoil"})
+	if rec.Code != http.StatusCreated {
+		t.Errorf("Expected 201 with marks, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestServer_CancelOrder_ReleasesCharacter(t *testing.T) {
+	t.Parallel()
+
+	s, char := newTestServer(t)
+	order := s.model.addOrder("harvest", "berry")
+	order.Status = entity.OrderAssigned
+	order.AssignedTo = char.ID
+	char.AssignedOrderID = order.ID
+	char.Intent = &entity.Intent{Action: entity.ActionMove}
+
+	rec := doRequest(t, s, "POST", "/orders/cancel", CancelOrderRequest{ID: order.ID})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if len(s.model.orders) != 0 {
+		t.Errorf("Expected order removed, got %d orders", len(s.model.orders))
+	}
+	if char.AssignedOrderID != 0 || char.Intent != nil {
+		t.Error("Expected character assignment and intent cleared")
+	}
+
+	rec = doRequest(t, s, "POST", "/orders/cancel", CancelOrderRequest{ID: 99})
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown order, got %d", rec.Code)
+	}
+}
+
+func TestServer_MarkHut(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, "POST", "/marks/hut", HutMarkRequest{Position: types.Position{X: 10, Y: 10}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	mark, ok := s.model.gameMap.GetConstructionMark(types.Position{X: 12, Y: 14})
+	if !ok || mark.WallRole != "door" {
+		t.Errorf("Expected door mark at south wall center, got %+v (ok=%v)", mark, ok)
+	}
+
+	rec = doRequest(t, s, "POST", "/marks/hut", HutMarkRequest{Position: types.Position{X: 10, Y: 10}, Unmark: true})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 unmarking, got %d", rec.Code)
+	}
+	if s.model.gameMap.IsMarkedForConstruction(types.Position{X: 12, Y: 14}) {
+		t.Error("Expected hut footprint unmarked")
+	}
+}
+
+func TestServer_MarkCampfire(t *testing.T) {
+	t.Parallel()
+
+	s, char := newTestServer(t)
+	char.LearnActivity("buildCampfire")
+	pos := types.Position{X: 10, Y: 10}
+	rec := doRequest(t, s, "POST", "/marks/campfire", CampfireMarkRequest{Position: pos})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if mark, ok := s.model.gameMap.GetConstructionMark(pos); !ok || mark.ConstructKind != "campfire" {
+		t.Errorf("Expected campfire mark at %v, got %+v (ok=%v)", pos, mark, ok)
+	}
+	if rec = doRequest(t, s, "POST", "/marks/campfire", CampfireMarkRequest{Position: pos}); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422 marking twice, got %d", rec.Code)
+	}
+	if _, err := s.model.createOrder("buildCampfire", ""); err != nil {
+		t.Errorf("Expected a campfire order once a site is marked, got %v", err)
+	}
+
+	rec = doRequest(t, s, "POST", "/marks/campfire", CampfireMarkRequest{Position: pos, Unmark: true})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 unmarking, got %d", rec.Code)
+	}
+	if s.model.gameMap.IsMarkedForConstruction(pos) {
+		t.Error("Expected campfire mark cleared")
+	}
+}
+
+func TestServer_Step_RequiresPause(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, "POST", "/step", StepRequest{Ticks: 3})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if s.model.elapsedGameTime <= 0 {
+		t.Error("Expected game time to advance after stepping")
+	}
+
+	doRequest(t, s, "POST", "/pause", PauseRequest{Paused: false})
+	if s.model.paused {
+		t.Fatal("Expected world unpaused")
+	}
+	rec = doRequest(t, s, "POST", "/step", nil)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected 409 stepping while running, got %d", rec.Code)
+	}
+}
+
+func TestServer_Step_RejectsTooManyTicks(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, "POST", "/step", StepRequest{Ticks: config.MaxStepTicks + 1})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for too many ticks, got %d", rec.Code)
+	}
+	if s.model.elapsedGameTime != 0 {
+		t.Error("Expected a rejected step not to advance the world")
+	}
+}
+
+func TestServer_Speed_Validates(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, "POST", "/speed", SpeedRequest{Multiplier: 3})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for multiplier 3, got %d", rec.Code)
+	}
+	rec = doRequest(t, s, "POST", "/speed", SpeedRequest{Multiplier: 4})
+	if rec.Code != http.StatusOK || s.model.speedMultiplier != 4 {
+		t.Errorf("Expected speed 4, got code %d speed %d", rec.Code, s.model.speedMultiplier)
+	}
+}
+
+func TestServer_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	s, char := newTestServer(t)
+	s.model.actionLog.Add(char.ID, char.Name, "test", "Hello")
+
+	rec := doRequest(t, s, "GET", "/events?limit=10", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	var events []struct {
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&events); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(events) != 1 || events[0].Message != "Hello" {
+		t.Errorf("Expected one Hello event, got %+v", events)
+	}
+
+	rec = doRequest(t, s, "GET", "/events?limit=abc", nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for bad limit, got %d", rec.Code)
+	}
+}
+
+func TestServer_SystemsCanBeDisabledAndProfiled(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestServer(t)
+	rec := doRequest(t, s, http.MethodPost, "/systems", map[string]any{"name": "survival", "enabled": false, "profiling": true})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	doRequest(t, s, http.MethodPost, "/step", map[string]any{"ticks": 2})
+
+	rec = doRequest(t, s, http.MethodGet, "/systems", nil)
+	var resp SystemsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !resp.Profiling {
+		t.Error("Expected profiling on")
+	}
+	for _, sys := range resp.Systems {
+		switch sys.Name {
+		case "survival":
+			if sys.Enabled || sys.Calls != 0 {
+				t.Errorf("Expected survival disabled and never run, got %+v", sys)
+			}
+		case "intents":
+			if !sys.Enabled || sys.Calls != 2 {
+				t.Errorf("Expected intents enabled with 2 calls, got %+v", sys)
+			}
+		}
+	}
+
+	rec = doRequest(t, s, http.MethodPost, "/systems", map[string]any{"name": "nope", "enabled": true})
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown system, got %d", rec.Code)
+	}
+}
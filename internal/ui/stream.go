@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+
+	"petri/internal/save"
+	"petri/internal/system"
+)
+
+// streamBufferSize is how many messages a subscriber can fall behind before
+// new messages are dropped for it
+const streamBufferSize = 16
+
+// StreamMessage is published once per game tick to stream subscribers
+type StreamMessage struct {
+	GameTime   float64           `json:"game_time"`
+	Day        int               `json:"day"`
+	Characters []StreamCharacter `json:"characters"`
+	Events     []save.EventSave  `json:"events"` // Events logged since the previous message
+}
+
+// StreamCharacter is a character's position and current activity
+type StreamCharacter struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	X          int    `json:"x"`
+	Y          int    `json:"y"`
+	Activity   string `json:"activity"`
+	IsSleeping bool   `json:"is_sleeping"`
+	IsDead     bool   `json:"is_dead"`
+}
+
+// Streamer fans out per-tick world updates to live subscribers.
+// Subscribers connect over Server-Sent Events (ServeHTTP) or
+// newline-delimited JSON on a socket (ServeSocket).
+type Streamer struct {
+	mu          sync.Mutex
+	subscribers map[chan []byte]struct{}
+	conns       map[net.Conn]struct{} // Open socket connections, closed by Close
+	events      eventCursor           // Last action log event published
+	closed      bool                  // true once Close has ended every subscription
+}
+
+// NewStreamer creates a streamer with no subscribers
+func NewStreamer() *Streamer {
+	return &Streamer{
+		subscribers: make(map[chan []byte]struct{}),
+		conns:       make(map[net.Conn]struct{}),
+	}
+}
+
+// Close ends every subscription and closes open socket connections.
+// Later subscribers are turned away immediately.
+func (s *Streamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for ch := range s.subscribers {
+		close(ch)
+		delete(s.subscribers, ch)
+	}
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+}
+
+// WithStreamer attaches a streamer; the model publishes to it after every tick
+func (m Model) WithStreamer(s *Streamer) Model {
+	m.streamer = s
+	return m
+}
+
+// SetStreamer attaches a streamer to the served world
+func (s *Server) SetStreamer(st *Streamer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.model.streamer = st
+}
+
+// publishStream sends the current tick to stream subscribers, if streaming is enabled
+func (m *Model) publishStream() {
+	if m.streamer == nil {
+		return
+	}
+	m.streamer.publish(m.streamMessage(m.streamer.nextEvents(m.actionLog)))
+}
+
+// nextEvents returns the events not yet published.
+// The first message only carries events from the current tick, not saved history.
+func (s *Streamer) nextEvents(log *system.ActionLog) []system.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.events.next(log)
+}
+
+// streamMessage builds a stream message carrying the given events
+func (m Model) streamMessage(events []system.Event) StreamMessage {
+	msg := StreamMessage{
+		GameTime: m.elapsedGameTime,
+		Day:      system.WorldDay(m.elapsedGameTime),
+	}
+	for _, char := range m.gameMap.Characters() {
+		pos := char.Pos()
+		msg.Characters = append(msg.Characters, StreamCharacter{
+			ID:         char.ID,
+			Name:       char.Name,
+			X:          pos.X,
+			Y:          pos.Y,
+			Activity:   char.CurrentActivity,
+			IsSleeping: char.IsSleeping,
+			IsDead:     char.IsDead,
+		})
+	}
+	for _, e := range events {
+		msg.Events = append(msg.Events, save.EventSave{
+			GameTime: e.GameTime,
+			CharID:   e.CharID,
+			CharName: e.CharName,
+			Type:     e.Type,
+			Message:  e.Message,
+		})
+	}
+	return msg
+}
+
+// publish encodes msg once and delivers it to every subscriber without blocking.
+// Slow subscribers miss messages rather than stalling the game loop.
+func (s *Streamer) publish(msg StreamMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		save.LogWarning("Failed to encode stream message: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
+// subscribe registers a new subscriber channel. The channel is closed when the streamer is.
+func (s *Streamer) subscribe() chan []byte {
+	ch := make(chan []byte, streamBufferSize)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(ch)
+		return ch
+	}
+	s.subscribers[ch] = struct{}{}
+	return ch
+}
+
+// unsubscribe removes a subscriber channel
+func (s *Streamer) unsubscribe(ch chan []byte) {
+	s.mu.Lock()
+	delete(s.subscribers, ch)
+	s.mu.Unlock()
+}
+
+// ServeHTTP streams messages as Server-Sent Events until the client disconnects or the streamer is closed
+func (s *Streamer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := s.subscribe()
+	defer s.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data, ok := <-ch:
+			if !ok {
+				return
+			}
+			if _, err := w.Write([]byte("data: ")); err != nil {
+				return
+			}
+			if _, err := w.Write(data); err != nil {
+				return
+			}
+			if _, err := w.Write([]byte("\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// ServeSocket accepts connections on l and streams newline-delimited JSON to each.
+// Returns when the listener is closed.
+func (s *Streamer) ServeSocket(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// serveConn writes messages to one socket connection until the client hangs up,
+// a write fails, or the streamer is closed
+func (s *Streamer) serveConn(conn net.Conn) {
+	defer conn.Close()
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.conns[conn] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	ch := s.subscribe()
+	defer s.unsubscribe(ch)
+
+	// Clients only listen, so a read returns once the client disconnects
+	hungUp := make(chan struct{})
+	go func() {
+		io.Copy(io.Discard, conn)
+		close(hungUp)
+	}()
+
+	w := bufio.NewWriter(conn)
+	for {
+		select {
+		case <-hungUp:
+			return
+		case data, ok := <-ch:
+			if !ok {
+				return
+			}
+			if _, err := w.Write(data); err != nil {
+				return
+			}
+			if err := w.WriteByte('\n'); err != nil {
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"petri/internal/entity"
+	"petri/internal/types"
+)
+
+// newStreamTestModel creates a paused model with one character and a streamer attached
+func newStreamTestModel() (Model, *Streamer) {
+	st := NewStreamer()
+	return newTestModel(20, 20, entity.NewCharacter(1, 5, 5, "Alice", "berry", types.ColorRed)).WithStreamer(st), st
+}
+
+// receive waits for one message on a subscriber channel
+func receive(t *testing.T, ch chan []byte) StreamMessage {
+	t.Helper()
+	select {
+	case data := <-ch:
+		var msg StreamMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for stream message")
+	}
+	return StreamMessage{}
+}
+
+func TestStream_StepForwardPublishesCharactersAndNewEvents(t *testing.T) {
+	t.Parallel()
+
+	m, st := newStreamTestModel()
+	// History from before streaming started should not be sent
+	m.actionLog.Add(1, "Alice", "test", "Old event")
+
+	ch := st.subscribe()
+	defer st.unsubscribe(ch)
+
+	m.stepForward()
+	msg := receive(t, ch)
+	if msg.GameTime != m.elapsedGameTime {
+		t.Errorf("Expected game time %.4f, got %.4f", m.elapsedGameTime, msg.GameTime)
+	}
+	if len(msg.Characters) != 1 || msg.Characters[0].Name != "Alice" || msg.Characters[0].X != m.gameMap.Characters()[0].Pos().X {
+		t.Errorf("Unexpected characters: %+v", msg.Characters)
+	}
+	for _, e := range msg.Events {
+		if e.Message == "Old event" {
+			t.Error("First message should not include events from before streaming started")
+		}
+	}
+
+	// An event logged during the next tick is sent exactly once
+	m.actionLog.SetGameTime(m.elapsedGameTime + 1)
+	m.actionLog.Add(1, "Alice", "test", "New event")
+	m.elapsedGameTime += 1
+	m.publishStream()
+	msg = receive(t, ch)
+	if len(msg.Events) != 1 || msg.Events[0].Message != "New event" {
+		t.Fatalf("Expected one New event, got %+v", msg.Events)
+	}
+
+	m.publishStream()
+	msg = receive(t, ch)
+	if len(msg.Events) != 0 {
+		t.Errorf("Expected no repeated events, got %+v", msg.Events)
+	}
+
+	// An event logged while paused is still sent, though game time has not moved
+	m.actionLog.Add(1, "Alice", "test", "Paused event")
+	m.publishStream()
+	msg = receive(t, ch)
+	if len(msg.Events) != 1 || msg.Events[0].Message != "Paused event" {
+		t.Errorf("Expected one Paused event, got %+v", msg.Events)
+	}
+}
+
+func TestStream_SlowSubscriberDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	m, st := newStreamTestModel()
+	ch := st.subscribe()
+	defer st.unsubscribe(ch)
+
+	// Publishing well past the buffer must not block the game loop
+	for i := 0; i < streamBufferSize*2; i++ {
+		m.stepForward()
+	}
+	if len(ch) != streamBufferSize {
+		t.Errorf("Expected buffer full at %d, got %d", streamBufferSize, len(ch))
+	}
+}
+
+func TestStream_ServerSentEvents(t *testing.T) {
+	t.Parallel()
+
+	m, st := newStreamTestModel()
+	srv := httptest.NewServer(st)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected text/event-stream, got %q", ct)
+	}
+
+	// Wait for the subscription to register before publishing
+	waitForSubscribers(t, st, 1)
+	m.stepForward()
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !strings.HasPrefix(line, "data: {") {
+		t.Errorf("Expected SSE data line, got %q", line)
+	}
+}
+
+func TestStream_SocketNewlineDelimitedJSON(t *testing.T) {
+	t.Parallel()
+
+	m, st := newStreamTestModel()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	defer l.Close()
+	go st.ServeSocket(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	waitForSubscribers(t, st, 1)
+	m.stepForward()
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	var msg StreamMessage
+	if err := json.Unmarshal(line, &msg); err != nil {
+		t.Fatalf("Expected one JSON object per line: %v", err)
+	}
+	if len(msg.Characters) != 1 {
+		t.Errorf("Expected 1 character, got %d", len(msg.Characters))
+	}
+}
+
+func TestStream_CloseEndsSocketConnections(t *testing.T) {
+	t.Parallel()
+
+	_, st := newStreamTestModel()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	defer l.Close()
+	go st.ServeSocket(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	waitForSubscribers(t, st, 1)
+	st.Close()
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := bufio.NewReader(conn).ReadBytes('\n'); err != io.EOF {
+		t.Errorf("Expected the connection to be closed, got %v", err)
+	}
+	if ch := st.subscribe(); !isClosed(ch) {
+		t.Error("Expected a closed streamer to turn away new subscribers")
+	}
+}
+
+func TestStream_SocketHangUpUnsubscribes(t *testing.T) {
+	t.Parallel()
+
+	_, st := newStreamTestModel()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	defer l.Close()
+	go st.ServeSocket(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	waitForSubscribers(t, st, 1)
+	conn.Close()
+
+	// No message is published, so only noticing the hang-up frees the subscriber
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		st.mu.Lock()
+		count := len(st.subscribers) + len(st.conns)
+		st.mu.Unlock()
+		if count == 0 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Error("Expected the subscriber to be dropped after the client hung up")
+}
+
+// isClosed reports whether a subscriber channel has been closed
+func isClosed(ch chan []byte) bool {
+	select {
+	case _, ok := <-ch:
+		return !ok
+	default:
+		return false
+	}
+}
+
+// waitForSubscribers blocks until the streamer has n subscribers
+func waitForSubscribers(t *testing.T, st *Streamer, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		st.mu.Lock()
+		count := len(st.subscribers)
+		st.mu.Unlock()
+		if count >= n {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %d subscribers", n)
+}
@@ -1,81 +1,412 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
+
+	"petri/internal/types"
+)
+
+// RenderProfile selects how the map and panels use color
+type RenderProfile string
+
+const (
+	RenderDefault      RenderProfile = "default"
+	RenderColorblind   RenderProfile = "colorblind"    // Okabe-Ito hues; no red/green distinctions
+	RenderHighContrast RenderProfile = "high-contrast" // bright foregrounds and backgrounds, bold text
+	RenderMonochrome   RenderProfile = "mono"          // no color: text attributes and color letters on the map
 )
 
+// RenderProfiles lists the selectable profiles in display order
+var RenderProfiles = []RenderProfile{RenderDefault, RenderColorblind, RenderHighContrast, RenderMonochrome}
+
+// palette holds the 256-color code for each styled role
+type palette struct {
+	border string
+
+	// Character status
+	poisoned, dead, sleeping, frustrated string
+
+	// Stat tiers, log highlights
+	optimal, severe, crisis, woreOff, learned, order string
+
+	// Features, terrain, and plant status
+	water, leaf, growing, sprout, tilled, wetTilled, wetSprout, clay, livewood, heartwood, outcrop string
+
+	// Selection and mark backgrounds
+	highlightBg, highlightFg, areaSelect, markedForTilling, areaUnselect                   string
+	markedForConstruction, constructionSelect, fenceMark, interiorPreview                  string
+	region, markedForDeconstruction, markedForCarving, markedForDigging, markedForChopping string
+
+	// Soil fertility overlay backgrounds
+	soilPoor, soilFair, soilRich string
+
+	// Map tint from dusk until dawn
+	twilight, night string
+
+	// Map tint by weather (by day)
+	rain, storm, drought string
+
+	// Burning tiles
+	fire string
+
+	// Dimmed text, card borders, and the selected field in character creation
+	unfulfillable, hint, cardBorder, selected string
+
+	items map[types.Color]string
+}
+
+var palettes = map[RenderProfile]palette{
+	RenderDefault: {
+		border:   "240",
+		poisoned: "46", dead: "240", sleeping: "141", frustrated: "208", // green, gray, lavender, orange
+		optimal: "34", severe: "226", crisis: "196", // green, yellow, red
+		woreOff: "45", learned: "33", order: "174", // cyan, darker blue, dusty rose
+		water: "39", leaf: "106", // bright blue, olive/leaf green
+		growing: "108", sprout: "107", wetSprout: "29", // sage, muted green, dark teal
+		tilled: "138", wetTilled: "94", clay: "138", // dusky earth, dark brown, dusky earth
+		livewood: "64", heartwood: "130", outcrop: "246", // bark green, warm wood brown, stone grey
+		highlightBg: "23", highlightFg: "255", // dark cyan bg, white text
+		areaSelect: "30", markedForTilling: "108", areaUnselect: "52", // teal, sage, dark red
+		markedForConstruction: "58", constructionSelect: "94", // olive/amber, warm brown
+		fenceMark: "240", interiorPreview: "236", region: "235", markedForDeconstruction: "89", markedForCarving: "95", markedForDigging: "67", markedForChopping: "131", // grey (DD-48), subtle dark, near black, plum, muted mauve, steel blue, rust
+		soilPoor: "52", soilFair: "58", soilRich: "22", // dark red, olive, dark green
+		twilight: "237", night: "17", // dark grey, navy
+		rain: "24", storm: "234", drought: "100", // slate blue, near black, dry olive
+		fire:          "202", // flame orange
+		unfulfillable: "240", hint: "245", cardBorder: "240", selected: "45",
+		items: map[types.Color]string{
+			types.ColorRed: "196", types.ColorBlue: "27", types.ColorBrown: "136", types.ColorWhite: "255",
+			types.ColorOrange: "208", types.ColorYellow: "226", types.ColorPurple: "135", types.ColorTan: "180",
+			types.ColorPink: "213", types.ColorBlack: "240", // dark gray for visibility
+			types.ColorGreen: "34", types.ColorPalePink: "218", types.ColorPaleYellow: "229",
+			types.ColorSilver: "188", types.ColorGray: "250", types.ColorLavender: "183",
+			types.ColorPaleGreen:  "108", // sage
+			types.ColorEarthy:     "138", // dusky earth (clay)
+			types.ColorTerracotta: "166", // warm reddish-brown (bricks)
+		},
+	},
+	RenderColorblind: {
+		border:   "240",
+		poisoned: "36", dead: "240", sleeping: "147", frustrated: "214", // bluish green, gray, lavender, orange
+		optimal: "74", severe: "227", crisis: "166", // sky blue, yellow, vermillion
+		woreOff: "117", learned: "25", order: "175", // light sky blue, blue, reddish purple
+		water: "25", leaf: "142",
+		growing: "73", sprout: "79", wetSprout: "30",
+		tilled: "180", wetTilled: "94", clay: "180",
+		livewood: "65", heartwood: "137", outcrop: "248",
+		highlightBg: "25", highlightFg: "255",
+		areaSelect: "31", markedForTilling: "73", areaUnselect: "130",
+		markedForConstruction: "136", constructionSelect: "94",
+		fenceMark: "240", interiorPreview: "236", region: "235", markedForDeconstruction: "97", markedForCarving: "60", markedForDigging: "31", markedForChopping: "166",
+		soilPoor: "130", soilFair: "60", soilRich: "25",
+		twilight: "237", night: "17",
+		rain: "24", storm: "234", drought: "101",
+		fire:          "214",
+		unfulfillable: "240", hint: "245", cardBorder: "240", selected: "74",
+		// Red/green/brown pairs differ in lightness as well as hue
+		items: map[types.Color]string{
+			types.ColorRed: "166", types.ColorBlue: "25", types.ColorBrown: "94", types.ColorWhite: "255",
+			types.ColorOrange: "214", types.ColorYellow: "227", types.ColorPurple: "97", types.ColorTan: "180",
+			types.ColorPink: "175", types.ColorBlack: "240", types.ColorGreen: "36",
+			types.ColorPalePink: "218", types.ColorPaleYellow: "229", types.ColorSilver: "188",
+			types.ColorGray: "250", types.ColorLavender: "147", types.ColorPaleGreen: "116",
+			types.ColorEarthy: "137", types.ColorTerracotta: "130",
+		},
+	},
+	RenderHighContrast: {
+		border:   "255",
+		poisoned: "46", dead: "250", sleeping: "177", frustrated: "214",
+		optimal: "46", severe: "226", crisis: "196",
+		woreOff: "51", learned: "39", order: "218",
+		water: "51", leaf: "148",
+		growing: "120", sprout: "114", wetSprout: "43",
+		tilled: "180", wetTilled: "172", clay: "180",
+		livewood: "70", heartwood: "172", outcrop: "250",
+		highlightBg: "255", highlightFg: "16", // white bg, black text
+		areaSelect: "33", markedForTilling: "28", areaUnselect: "160",
+		markedForConstruction: "136", constructionSelect: "130",
+		fenceMark: "245", interiorPreview: "238", region: "237", markedForDeconstruction: "162", markedForCarving: "98", markedForDigging: "32", markedForChopping: "202",
+		soilPoor: "160", soilFair: "136", soilRich: "28",
+		twilight: "238", night: "18",
+		rain: "25", storm: "235", drought: "100",
+		fire:          "196",
+		unfulfillable: "245", hint: "252", cardBorder: "252", selected: "51",
+		items: map[types.Color]string{
+			types.ColorRed: "196", types.ColorBlue: "33", types.ColorBrown: "172", types.ColorWhite: "231",
+			types.ColorOrange: "208", types.ColorYellow: "226", types.ColorPurple: "171", types.ColorTan: "223",
+			types.ColorPink: "213", types.ColorBlack: "245", types.ColorGreen: "46",
+			types.ColorPalePink: "225", types.ColorPaleYellow: "230", types.ColorSilver: "253",
+			types.ColorGray: "250", types.ColorLavender: "189", types.ColorPaleGreen: "157",
+			types.ColorEarthy: "180", types.ColorTerracotta: "202",
+		},
+	},
+	// Monochrome has no palette: every code is empty and applyRenderProfile uses attributes instead
+	RenderMonochrome: {},
+}
+
+// colorLetters are the map suffixes that name an item's color when the profile has no color.
+// Pale shades use the capital of their base color.
+var colorLetters = map[types.Color]rune{
+	types.ColorRed: 'r', types.ColorBlue: 'b', types.ColorBrown: 'n', types.ColorWhite: 'w',
+	types.ColorOrange: 'o', types.ColorYellow: 'y', types.ColorPurple: 'p', types.ColorTan: 't',
+	types.ColorPink: 'k', types.ColorBlack: 'x', types.ColorGreen: 'g', types.ColorPalePink: 'K',
+	types.ColorPaleYellow: 'Y', types.ColorSilver: 's', types.ColorGray: 'a', types.ColorLavender: 'l',
+	types.ColorPaleGreen: 'G', types.ColorEarthy: 'e', types.ColorTerracotta: 'c',
+}
+
 var (
+	// renderProfile is the active profile; styles below are rebuilt when it changes
+	renderProfile = RenderDefault
+
 	// Border style for panels
-	borderStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder()).
-			BorderForeground(lipgloss.Color("240"))
+	borderStyle lipgloss.Style
 
 	// Character status colors
-	poisonedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("46"))  // green
-	deadStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("240")) // gray
-	sleepingStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("141")) // purple/lavender
-	frustratedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("208")) // orange
+	poisonedStyle   lipgloss.Style
+	deadStyle       lipgloss.Style
+	sleepingStyle   lipgloss.Style
+	frustratedStyle lipgloss.Style
 
 	// Severity colors for stat tiers
-	optimalStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("34"))  // green (best)
-	severeStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("226")) // yellow
-	crisisStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196")) // red
+	optimalStyle lipgloss.Style
+	severeStyle  lipgloss.Style
+	crisisStyle  lipgloss.Style
 
 	// Effect wore off color
-	woreOffStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("45")) // cyan
+	woreOffStyle lipgloss.Style
 
 	// Learning color
-	learnedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("33")) // darker blue
+	learnedStyle lipgloss.Style
 
 	// Order-related color
-	orderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("174")) // dusty rose
+	orderStyle lipgloss.Style
 
 	// Item colors (Bold helps Unicode symbols render more prominently)
-	redStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
-	blueStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("27")).Bold(true)
-	brownStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("136")).Bold(true)
-	whiteStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Bold(true)
-	orangeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Bold(true)
-	yellowStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true)
-	purpleStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("135")).Bold(true)
-	tanStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("180")).Bold(true)
-	pinkStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("213")).Bold(true)
-	blackStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Bold(true) // dark gray for visibility
-	greenStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("34")).Bold(true)
-	palePinkStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("218")).Bold(true)
-	paleYellowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Bold(true)
-	silverStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("188")).Bold(true)
-	grayStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Bold(true)
-	lavenderStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("183")).Bold(true)
-	paleGreenStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("108")).Bold(true) // sage
+	itemStyles      map[types.Color]lipgloss.Style
+	redStyle        lipgloss.Style
+	orangeStyle     lipgloss.Style
+	earthyStyle     lipgloss.Style
+	terracottaStyle lipgloss.Style
 
 	// Feature colors
-	waterStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true)  // bright blue
-	leafStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("106")).Bold(true) // olive/leaf green
+	waterStyle lipgloss.Style
+	leafStyle  lipgloss.Style
 
 	// Agricultural/plant status
-	growingStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("108")).Bold(true) // sage (gardening labels, status text)
-	sproutStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("107")).Bold(true) // muted green (sprouts on dry ground)
-	tilledStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("138")).Bold(true) // dusky earth (dry tilled soil)
-	wetTilledStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("94")).Bold(true)  // dark brown (wet tilled soil)
-	wetSproutStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("29")).Bold(true)  // dark teal (sprouts on wet ground)
-	clayStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("138")).Bold(true) // dusky earth (clay terrain + clay items)
-	earthyStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("138")).Bold(true) // dusky earth (item color for clay)
-	terracottaStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("166")).Bold(true) // warm reddish-brown (bricks)
+	growingStyle   lipgloss.Style // gardening labels, status text
+	sproutStyle    lipgloss.Style // sprouts on dry ground
+	tilledStyle    lipgloss.Style // dry tilled soil
+	wetTilledStyle lipgloss.Style // wet tilled soil
+	wetSproutStyle lipgloss.Style // sprouts on wet ground
+	clayStyle      lipgloss.Style // clay terrain + clay items
+	livewoodStyle  lipgloss.Style // living outer wood of trees and cut openings
+	heartwoodStyle lipgloss.Style // solid inner wood of trees and carved hollows
+	outcropStyle   lipgloss.Style // rock outcrop terrain
 
 	// UI highlight (background)
-	highlightStyle             = lipgloss.NewStyle().Background(lipgloss.Color("23")).Foreground(lipgloss.Color("255")) // dark cyan bg, white text
-	areaSelectStyle            = lipgloss.NewStyle().Background(lipgloss.Color("30"))                                   // teal bg for active area selection
-	markedForTillingStyle      = lipgloss.NewStyle().Background(lipgloss.Color("108"))                                  // sage bg for confirmed marked-for-tilling tiles
-	areaUnselectStyle          = lipgloss.NewStyle().Background(lipgloss.Color("52"))                                   // dark red bg for unmark selection
-	markedForConstructionStyle = lipgloss.NewStyle().Background(lipgloss.Color("58"))                                   // olive/amber bg for confirmed marked-for-construction tiles
-	constructionSelectStyle    = lipgloss.NewStyle().Background(lipgloss.Color("94"))                                   // warm brown bg for active construction line preview
-	fenceMarkStyle             = lipgloss.NewStyle().Background(lipgloss.Color("240"))                                  // grey bg for fence marks during hut placement (DD-48)
-	interiorPreviewStyle       = lipgloss.NewStyle().Background(lipgloss.Color("236"))                                  // subtle dark bg for hut interior preview
+	highlightStyle             lipgloss.Style
+	areaSelectStyle            lipgloss.Style // active area selection
+	markedForTillingStyle      lipgloss.Style // confirmed marked-for-tilling tiles
+	areaUnselectStyle          lipgloss.Style // unmark selection
+	markedForConstructionStyle lipgloss.Style // confirmed marked-for-construction tiles
+	constructionSelectStyle    lipgloss.Style // active construction line preview
+	fenceMarkStyle             lipgloss.Style // fence marks during hut placement (DD-48)
+	interiorPreviewStyle       lipgloss.Style // hut interior preview
+	regionStyle                lipgloss.Style // enclosed region under the cursor
+	markedForDeconstructStyle  lipgloss.Style // constructs marked for deconstruction
+	markedForCarvingStyle      lipgloss.Style // tree tiles marked for carving
+	markedForChoppingStyle     lipgloss.Style // tree tiles marked for chopping
+	markedForDiggingStyle      lipgloss.Style // tiles marked for irrigation channels
+	soilPoorStyle              lipgloss.Style // soil overlay: worn-out tilled soil
+	soilFairStyle              lipgloss.Style // soil overlay: ordinary tilled soil
+	soilRichStyle              lipgloss.Style // soil overlay: composted tilled soil
+	twilightStyle              lipgloss.Style // map tint at dawn and dusk
+	nightStyle                 lipgloss.Style // map tint at night
+	rainStyle                  lipgloss.Style // map tint in rain
+	stormStyle                 lipgloss.Style // map tint in a storm
+	droughtStyle               lipgloss.Style // map tint in a drought
+	fireStyle                  lipgloss.Style // burning tiles
 
 	// Unfulfillable order style (dimmed)
-	unfulfillableStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240")) // gray
+	unfulfillableStyle lipgloss.Style
+
+	// Key hints and character creation cards
+	hintStyle          lipgloss.Style
+	cardStyle          lipgloss.Style
+	selectedCardStyle  lipgloss.Style
+	fieldLabelStyle    lipgloss.Style
+	selectedFieldStyle lipgloss.Style
 
 	// Title style
 	titleStyle = lipgloss.NewStyle().Bold(true)
 )
+
+func init() {
+	applyRenderProfile(RenderDefault)
+}
+
+// ParseRenderProfile returns the profile named by s
+func ParseRenderProfile(s string) (RenderProfile, error) {
+	for _, p := range RenderProfiles {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	names := make([]string, len(RenderProfiles))
+	for i, p := range RenderProfiles {
+		names[i] = string(p)
+	}
+	return "", fmt.Errorf("unknown render profile %q (available: %s)", s, strings.Join(names, ", "))
+}
+
+// SetRenderProfile switches every style to the given profile
+func SetRenderProfile(p RenderProfile) {
+	applyRenderProfile(p)
+}
+
+// nextRenderProfile returns the profile after p, wrapping around
+func nextRenderProfile(p RenderProfile) RenderProfile {
+	for i, candidate := range RenderProfiles {
+		if candidate == p {
+			return RenderProfiles[(i+1)%len(RenderProfiles)]
+		}
+	}
+	return RenderDefault
+}
+
+// applyRenderProfile rebuilds the package styles from the profile's palette
+func applyRenderProfile(p RenderProfile) {
+	pal := palettes[p]
+	renderProfile = p
+
+	fg := func(code string) lipgloss.Style {
+		if code == "" {
+			return lipgloss.NewStyle()
+		}
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(code))
+	}
+	bg := func(code string) lipgloss.Style {
+		if code == "" {
+			return lipgloss.NewStyle()
+		}
+		return lipgloss.NewStyle().Background(lipgloss.Color(code))
+	}
+
+	borderStyle = lipgloss.NewStyle().Border(lipgloss.NormalBorder())
+	if pal.border != "" {
+		borderStyle = borderStyle.BorderForeground(lipgloss.Color(pal.border))
+	}
+
+	poisonedStyle = fg(pal.poisoned)
+	deadStyle = fg(pal.dead)
+	sleepingStyle = fg(pal.sleeping)
+	frustratedStyle = fg(pal.frustrated)
+	optimalStyle = fg(pal.optimal)
+	severeStyle = fg(pal.severe)
+	crisisStyle = fg(pal.crisis)
+	woreOffStyle = fg(pal.woreOff)
+	learnedStyle = fg(pal.learned)
+	orderStyle = fg(pal.order)
+
+	itemStyles = make(map[types.Color]lipgloss.Style, len(colorLetters))
+	for c := range colorLetters {
+		itemStyles[c] = fg(pal.items[c]).Bold(true)
+	}
+	redStyle = itemStyles[types.ColorRed]
+	orangeStyle = itemStyles[types.ColorOrange]
+	earthyStyle = itemStyles[types.ColorEarthy]
+	terracottaStyle = itemStyles[types.ColorTerracotta]
+
+	waterStyle = fg(pal.water).Bold(true)
+	leafStyle = fg(pal.leaf).Bold(true)
+	growingStyle = fg(pal.growing).Bold(true)
+	sproutStyle = fg(pal.sprout).Bold(true)
+	tilledStyle = fg(pal.tilled).Bold(true)
+	wetTilledStyle = fg(pal.wetTilled).Bold(true)
+	wetSproutStyle = fg(pal.wetSprout).Bold(true)
+	clayStyle = fg(pal.clay).Bold(true)
+	livewoodStyle = fg(pal.livewood).Bold(true)
+	heartwoodStyle = fg(pal.heartwood)
+	outcropStyle = fg(pal.outcrop).Bold(true)
+
+	highlightStyle = bg(pal.highlightBg)
+	if pal.highlightFg != "" {
+		highlightStyle = highlightStyle.Foreground(lipgloss.Color(pal.highlightFg))
+	}
+	areaSelectStyle = bg(pal.areaSelect)
+	markedForTillingStyle = bg(pal.markedForTilling)
+	areaUnselectStyle = bg(pal.areaUnselect)
+	markedForConstructionStyle = bg(pal.markedForConstruction)
+	constructionSelectStyle = bg(pal.constructionSelect)
+	fenceMarkStyle = bg(pal.fenceMark)
+	interiorPreviewStyle = bg(pal.interiorPreview)
+	regionStyle = bg(pal.region)
+	markedForDeconstructStyle = bg(pal.markedForDeconstruction)
+	markedForCarvingStyle = bg(pal.markedForCarving)
+	markedForChoppingStyle = bg(pal.markedForChopping)
+	markedForDiggingStyle = bg(pal.markedForDigging)
+	soilPoorStyle = bg(pal.soilPoor)
+	soilFairStyle = bg(pal.soilFair)
+	soilRichStyle = bg(pal.soilRich)
+	twilightStyle = bg(pal.twilight)
+	nightStyle = bg(pal.night)
+	rainStyle = bg(pal.rain)
+	stormStyle = bg(pal.storm)
+	droughtStyle = bg(pal.drought)
+	fireStyle = bg(pal.fire)
+
+	unfulfillableStyle = fg(pal.unfulfillable)
+	hintStyle = fg(pal.hint)
+	fieldLabelStyle = fg(pal.hint)
+	selectedFieldStyle = fg(pal.selected).Bold(true)
+	cardStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1).Width(26)
+	if pal.cardBorder != "" {
+		cardStyle = cardStyle.BorderForeground(lipgloss.Color(pal.cardBorder))
+	}
+	selectedCardStyle = cardStyle
+	if pal.selected != "" {
+		selectedCardStyle = selectedCardStyle.BorderForeground(lipgloss.Color(pal.selected))
+	}
+
+	if p == RenderMonochrome {
+		// Without color, tiers and selections are told apart by text attributes
+		deadStyle = deadStyle.Faint(true)
+		poisonedStyle = poisonedStyle.Underline(true)
+		severeStyle = severeStyle.Underline(true)
+		crisisStyle = crisisStyle.Reverse(true)
+		woreOffStyle = woreOffStyle.Italic(true)
+		learnedStyle = learnedStyle.Bold(true)
+		unfulfillableStyle = unfulfillableStyle.Faint(true)
+		hintStyle = hintStyle.Faint(true)
+		highlightStyle = highlightStyle.Reverse(true)
+		areaSelectStyle = areaSelectStyle.Reverse(true)
+		areaUnselectStyle = areaUnselectStyle.Reverse(true).Strikethrough(true)
+		markedForTillingStyle = markedForTillingStyle.Underline(true)
+		markedForConstructionStyle = markedForConstructionStyle.Underline(true)
+		constructionSelectStyle = constructionSelectStyle.Reverse(true)
+		fenceMarkStyle = fenceMarkStyle.Faint(true).Underline(true)
+		interiorPreviewStyle = interiorPreviewStyle.Underline(true)
+		regionStyle = regionStyle.Faint(true)
+		markedForDeconstructStyle = markedForDeconstructStyle.Strikethrough(true)
+		markedForCarvingStyle = markedForCarvingStyle.Underline(true)
+		markedForChoppingStyle = markedForChoppingStyle.Strikethrough(true)
+		markedForDiggingStyle = markedForDiggingStyle.Underline(true)
+		soilPoorStyle = soilPoorStyle.Faint(true)
+		soilRichStyle = soilRichStyle.Underline(true)
+		twilightStyle = twilightStyle.Faint(true)
+		nightStyle = nightStyle.Faint(true)
+		rainStyle = rainStyle.Faint(true)
+		stormStyle = stormStyle.Faint(true)
+		droughtStyle = droughtStyle.Faint(true)
+		fireStyle = fireStyle.Reverse(true)
+		selectedCardStyle = selectedCardStyle.BorderStyle(lipgloss.ThickBorder())
+	}
+}
+
+// showsColorLetters reports whether the map names item colors with a letter after the symbol
+func showsColorLetters() bool {
+	return renderProfile == RenderMonochrome
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"petri/internal/config"
+	"petri/internal/save"
+)
+
+// applyWorldTuningFile replaces the saved per-world tuning with the world's tuning.json, if present.
+// The file's values are then stored in the save on the next write.
+func applyWorldTuningFile(worldID string, state *save.SaveState) error {
+	path, err := save.WorldTuningPath(worldID)
+	if err != nil {
+		return err
+	}
+	values, err := config.LoadTuningFile(path)
+	if err != nil {
+		return err
+	}
+	if values != nil {
+		state.Tuning = values
+	}
+	return nil
+}
+
+// renderTuningPanel lists every tunable with its effective value and source.
+// Scrolls from the top: logScrollOffset is the number of lines skipped.
+func (m Model) renderTuningPanel(panelHeight int) string {
+	var lines []string
+	lines = append(lines, titleStyle.Render("        TUNING"), "")
+
+	var body []string
+	group := ""
+	for _, tv := range config.EffectiveTuning() {
+		if tv.Group != group {
+			group = tv.Group
+			if len(body) > 0 {
+				body = append(body, "")
+			}
+			body = append(body, " "+strings.ToUpper(strings.ReplaceAll(group, "_", " ")))
+		}
+		line := fmt.Sprintf("   %-31s %7g %s", tv.Key, tv.Value, tv.Source)
+		switch tv.Source {
+		case config.SourceWorld:
+			body = append(body, orangeStyle.Render(line))
+		case config.SourceGlobal:
+			body = append(body, optimalStyle.Render(line))
+		default:
+			body = append(body, line)
+		}
+	}
+
+	maxDisplay := panelHeight - 5 // Account for header, footer, borders
+	start := m.logScrollOffset
+	if start > len(body)-maxDisplay {
+		start = len(body) - maxDisplay
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + maxDisplay
+	if end > len(body) {
+		end = len(body)
+	}
+	lines = append(lines, body[start:end]...)
+
+	lines = append(lines, "", " PgUp/PgDn to scroll, T or Esc to close")
+	return strings.Join(lines, "\n")
+}
@@ -1,14 +1,16 @@
 package ui
 
 import (
-	"math/rand"
+	"sort"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"petri/internal/config"
+	"petri/internal/content"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/rng"
 	"petri/internal/save"
 	"petri/internal/system"
 	"petri/internal/types"
@@ -49,6 +51,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "c", "C":
 			m.creationState = NewCharacterCreationState()
 			m.phase = phaseCharacterCreate
+		case "t", "T":
+			m.terrainPreset = nextTerrainPreset(m.terrainPreset)
 		case "esc":
 			m.phase = phaseWorldSelect
 		case "q", "ctrl+c":
@@ -63,6 +67,10 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.editingCharacterName {
 			return m.handleNameEditKey(msg)
 		}
+		// Debug console captures all keys while open
+		if m.consoleOpen {
+			return m.handleConsoleKey(msg)
+		}
 
 		switch msg.String() {
 		case "ctrl+c":
@@ -85,6 +93,7 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.showInventoryPanel = false
 			m.showPreferencesPanel = false
 			m.showOrdersPanel = false
+			m.showTuningPanel = false
 			m.logScrollOffset = 0
 			// Clear world state so new worlds get fresh IDs and logs
 			m.worldID = ""
@@ -103,12 +112,23 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.activityFullScreen = false
 				return m, nil
 			}
+			// Tuning panel: close it
+			if m.showTuningPanel {
+				m.showTuningPanel = false
+				m.logScrollOffset = 0
+				return m, nil
+			}
 			// Orders add mode: back one level
 			if m.showOrdersPanel && m.ordersAddMode {
 				if m.ordersAddStep == 2 {
-					if (m.step2ActivityID == "tillSoil" || m.step2ActivityID == "buildFence") && m.areaSelectAnchor != nil {
+					if (m.step2ActivityID == "tillSoil" || m.step2ActivityID == "buildFence" || m.step2ActivityID == "digChannel" || m.step2ActivityID == "deconstruct" || m.step2ActivityID == "carveWood" || m.step2ActivityID == "chopWood") && m.areaSelectAnchor != nil {
 						// Clear anchor first, then back to step 1 on next esc
 						m.areaSelectAnchor = nil
+					} else if m.step2ActivityID == "deconstruct" {
+						// Deconstruct has no sub-menu: back to step 0
+						m.ordersAddStep = 0
+						m.areaSelectUnmarkMode = false
+						m.areaSelectLineMode = false
 					} else {
 						// Back to step 1 (buildHut has no anchor, goes directly)
 						m.ordersAddStep = 1
@@ -164,6 +184,9 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		case "f", "F":
 			m.toggleFollow()
+		case "g", "G":
+			// Toggle soil fertility overlay
+			m.showSoilOverlay = !m.showSoilOverlay
 		case "a":
 			// Switch to All Activity mode
 			m.viewMode = viewModeAllActivity
@@ -192,6 +215,19 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.activityFullScreen = !m.activityFullScreen
 				m.logScrollOffset = 0
 			}
+		case ":":
+			// Open debug console (debug only)
+			if m.testCfg.Debug {
+				m.consoleOpen = true
+				m.consoleInput = ""
+				m.consoleMessage = ""
+			}
+		case "t", "T":
+			// Toggle tuning panel (debug only)
+			if m.testCfg.Debug {
+				m.showTuningPanel = !m.showTuningPanel
+				m.logScrollOffset = 0
+			}
 		case "<":
 			// Slow down: 1 -> 2 -> 4
 			if m.speedMultiplier < 4 {
@@ -222,9 +258,9 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				}
 			}
 		case "tab":
-			// Toggle mark/unmark mode during area selection (tillSoil and buildFence)
+			// Toggle mark/unmark mode during area selection (tillSoil, buildFence, digChannel, buildHut, deconstruct, carveWood, chopWood)
 			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 &&
-				(m.step2ActivityID == "tillSoil" || m.step2ActivityID == "buildFence" || m.step2ActivityID == "buildHut") {
+				(m.step2ActivityID == "tillSoil" || m.step2ActivityID == "buildFence" || m.step2ActivityID == "digChannel" || m.step2ActivityID == "buildHut" || m.step2ActivityID == "deconstruct" || m.step2ActivityID == "carveWood" || m.step2ActivityID == "chopWood") {
 				m.areaSelectUnmarkMode = !m.areaSelectUnmarkMode
 				m.areaSelectAnchor = nil // Reset anchor when toggling mode
 				return m, nil
@@ -279,6 +315,11 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				}
 			}
 		case "l", "L":
+			// Toggle line/rectangle tool during deconstruct area selection
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "deconstruct" {
+				m.areaSelectLineMode = !m.areaSelectLineMode
+				return m, nil
+			}
 			// Return to action log from any details subpanel (select mode only)
 			if m.viewMode == viewModeSelect && (m.showKnowledgePanel || m.showInventoryPanel || m.showPreferencesPanel) {
 				m.showKnowledgePanel = false
@@ -294,17 +335,7 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.areaSelectAnchor = &anchor
 				} else {
 					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
-					if m.areaSelectUnmarkMode {
-						positions := getValidPositions(*m.areaSelectAnchor, cursor, m.gameMap, isValidUnmarkTarget)
-						for _, pos := range positions {
-							m.gameMap.UnmarkForTilling(pos)
-						}
-					} else {
-						positions := getValidPositions(*m.areaSelectAnchor, cursor, m.gameMap, isValidTillTarget)
-						for _, pos := range positions {
-							m.gameMap.MarkForTilling(pos)
-						}
-					}
+					m.markTillingArea(*m.areaSelectAnchor, cursor, m.areaSelectUnmarkMode)
 					m.areaSelectAnchor = nil // Clear anchor, stay in step 2
 				}
 				return m, nil
@@ -315,55 +346,64 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.areaSelectAnchor = &anchor
 				} else {
 					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
-					if m.areaSelectUnmarkMode {
-						positions := getValidLinePositions(*m.areaSelectAnchor, cursor, m.gameMap, isValidUnmarkFenceTarget)
-						for _, pos := range positions {
-							m.gameMap.UnmarkForConstruction(pos)
-						}
-					} else {
-						lineID := m.gameMap.NextConstructionLineID()
-						positions := getValidLinePositions(*m.areaSelectAnchor, cursor, m.gameMap, isValidFenceTarget)
-						for _, pos := range positions {
-							m.gameMap.MarkForConstruction(pos, lineID, "fence", "")
-						}
-					}
+					m.markFenceLine(*m.areaSelectAnchor, cursor, m.areaSelectUnmarkMode)
+					m.areaSelectAnchor = nil // Clear anchor, stay in step 2 for next line
+				}
+				return m, nil
+			}
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "digChannel" {
+				if m.areaSelectAnchor == nil {
+					anchor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.areaSelectAnchor = &anchor
+				} else {
+					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.markDiggingLine(*m.areaSelectAnchor, cursor, m.areaSelectUnmarkMode)
 					m.areaSelectAnchor = nil // Clear anchor, stay in step 2 for next line
 				}
 				return m, nil
 			}
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "deconstruct" {
+				if m.areaSelectAnchor == nil {
+					anchor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.areaSelectAnchor = &anchor
+				} else {
+					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.markDeconstructionArea(*m.areaSelectAnchor, cursor, m.areaSelectLineMode, m.areaSelectUnmarkMode)
+					m.areaSelectAnchor = nil // Clear anchor, stay in step 2
+				}
+				return m, nil
+			}
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "carveWood" {
+				if m.areaSelectAnchor == nil {
+					anchor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.areaSelectAnchor = &anchor
+				} else {
+					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.markCarvingArea(*m.areaSelectAnchor, cursor, m.areaSelectUnmarkMode)
+					m.areaSelectAnchor = nil // Clear anchor, stay in step 2
+				}
+				return m, nil
+			}
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "chopWood" {
+				if m.areaSelectAnchor == nil {
+					anchor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.areaSelectAnchor = &anchor
+				} else {
+					cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+					m.markChoppingArea(*m.areaSelectAnchor, cursor, m.areaSelectUnmarkMode)
+					m.areaSelectAnchor = nil // Clear anchor, stay in step 2
+				}
+				return m, nil
+			}
+			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildCampfire" {
+				m.toggleCampfireMark(types.Position{X: m.cursorX, Y: m.cursorY})
+				return m, nil
+			}
 			if m.showOrdersPanel && m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildHut" {
 				if m.areaSelectUnmarkMode {
-					// Unmark mode: remove entire footprint by LineID
-					pos := types.Position{X: m.cursorX, Y: m.cursorY}
-					if mark, ok := m.gameMap.GetConstructionMark(pos); ok {
-						m.gameMap.UnmarkByLineID(mark.LineID)
-					}
+					m.unmarkHutAt(types.Position{X: m.cursorX, Y: m.cursorY})
 				} else {
-					// Mark mode: place 5×5 hut footprint
-					if isValidHutFootprint(m.cursorX, m.cursorY, m.gameMap) {
-						lineID := m.gameMap.NextConstructionLineID()
-						doorPos := types.Position{X: m.cursorX + 2, Y: m.cursorY + 4} // center of south wall (DD-42)
-						for _, pos := range getHutPerimeterPositions(m.cursorX, m.cursorY) {
-							if mark, ok := m.gameMap.GetConstructionMark(pos); ok {
-								if mark.ConstructKind == "hut" {
-									continue // First-wins for shared walls (DD-46)
-								}
-								// Fence marks get overwritten by hut marks (DD-46)
-								m.gameMap.UnmarkForConstruction(pos)
-							}
-							wallRole := "wall"
-							if pos == doorPos {
-								wallRole = "door"
-							}
-							m.gameMap.MarkForConstruction(pos, lineID, "hut", wallRole)
-						}
-						// Clear any interior marks (shouldn't exist if validator passed, but defensive)
-						for _, pos := range getHutInteriorPositions(m.cursorX, m.cursorY) {
-							if m.gameMap.IsMarkedForConstruction(pos) {
-								m.gameMap.UnmarkForConstruction(pos)
-							}
-						}
-					}
+					m.markHutFootprint(m.cursorX, m.cursorY)
 				}
 				return m, nil
 			}
@@ -482,9 +522,16 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.moveCursor(1, 0)
 			}
 		case "pgup":
-			m.logScrollOffset += 5
+			if m.showTuningPanel {
+				// Tuning panel scrolls from the top
+				m.logScrollOffset = max(m.logScrollOffset-5, 0)
+			} else {
+				m.logScrollOffset += 5
+			}
 		case "pgdown":
-			if m.logScrollOffset >= 5 {
+			if m.showTuningPanel {
+				m.logScrollOffset += 5
+			} else if m.logScrollOffset >= 5 {
 				m.logScrollOffset -= 5
 			} else {
 				m.logScrollOffset = 0
@@ -512,9 +559,39 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// nextTerrainPreset returns the ID of the terrain preset after id, wrapping around
+func nextTerrainPreset(id string) string {
+	presets := game.TerrainPresets
+	for i, p := range presets {
+		if p.ID == id {
+			return presets[(i+1)%len(presets)].ID
+		}
+	}
+	return presets[1%len(presets)].ID // Unset means the default, the first preset
+}
+
 // startGameRandom initializes the game world with 4 random characters
 func (m Model) startGameRandom() Model {
+	m = m.generateRandomWorld()
+
+	// Create world for saving
+	if m.worldID == "" {
+		worldID, err := save.CreateWorld()
+		if err == nil {
+			m.worldID = worldID
+		}
+	}
+
+	return m
+}
+
+// generateRandomWorld builds a new map with 4 random characters and spawned terrain,
+// features, and items. Does not create a save; all randomness comes from rng.
+func (m Model) generateRandomWorld() Model {
 	m.gameMap = game.NewMap(config.MapWidth, config.MapHeight)
+	m.contentPacks = content.Loaded()
+	m.tuning = nil
+	config.SetWorldTuning(nil) // New worlds start with global tuning only
 	m.phase = phasePlaying
 	m.lastUpdate = time.Now()
 
@@ -533,25 +610,22 @@ func (m Model) startGameRandom() Model {
 		for i, name := range names {
 			x := cx + offsets[i][0]
 			y := cy + offsets[i][1]
-			food := foods[rand.Intn(len(foods))]
-			color := colors[rand.Intn(len(colors))]
+			food := foods[rng.Intn(len(foods))]
+			color := colors[rng.Intn(len(colors))]
 			char := entity.NewCharacter(i+1, x, y, name, food, color)
 			m.gameMap.AddCharacter(char)
 			chars = append(chars, char)
 		}
 
 		// Randomly select one character to follow
-		followIdx := rand.Intn(len(chars))
+		followIdx := rng.Intn(len(chars))
 		m.following = chars[followIdx]
 		pos := chars[followIdx].Pos()
 		m.cursorX, m.cursorY = pos.X, pos.Y
 	}
 
-	// Spawn world: ponds first (before items/features), then clay, then features, then items
-	if !m.testCfg.NoWater {
-		game.SpawnPonds(m.gameMap)
-		game.SpawnClay(m.gameMap)
-	}
+	// Spawn world: terrain first (biomes, water, clay and trees), then features, then items
+	game.GenerateTerrain(m.gameMap, game.TerrainPreset(m.terrainPreset), m.testCfg.NoWater)
 	game.SpawnFeatures(m.gameMap, m.testCfg.NoWater, m.testCfg.NoBeds)
 	if !m.testCfg.NoFood {
 		game.SpawnItems(m.gameMap, m.testCfg.MushroomsOnly)
@@ -561,15 +635,9 @@ func (m Model) startGameRandom() Model {
 		Stick: system.RandomGroundSpawnInterval(),
 		Nut:   system.RandomGroundSpawnInterval(),
 		Shell: system.RandomGroundSpawnInterval(),
+		Stone: system.RandomGroundSpawnInterval(),
 	}
-
-	// Create world for saving
-	if m.worldID == "" {
-		worldID, err := save.CreateWorld()
-		if err == nil {
-			m.worldID = worldID
-		}
-	}
+	m.creatureSpawnTimer = system.RandomCreatureSpawnInterval()
 
 	return m
 }
@@ -601,60 +669,8 @@ func (m Model) updateGame(now time.Time) (Model, tea.Cmd) {
 		m.flashIndex++
 	}
 
-	// Update survival mechanics for all characters
-	for _, char := range m.gameMap.Characters() {
-		system.UpdateSurvival(char, delta, m.actionLog)
-	}
-
-	// Update item spawning (unless no food mode)
-	if !m.testCfg.NoFood {
-		initialItemCount := config.ItemSpawnCount*2 + config.FlowerSpawnCount // berries + mushrooms + flowers
-		system.UpdateSpawnTimers(m.gameMap, initialItemCount, delta)
-		system.UpdateSproutTimers(m.gameMap, initialItemCount, delta)
-	}
-
-	// Update item death timers (flowers die regardless of no-food mode)
-	system.UpdateDeathTimers(m.gameMap, delta)
-
-	// Update seed extraction cooldown timers
-	system.UpdateSeedTimers(m.gameMap, delta)
-
-	// Update manually watered tile timers
-	m.gameMap.UpdateWateredTimers(delta)
-
-	// Update ground spawning (sticks, nuts, shells)
-	system.UpdateGroundSpawning(m.gameMap, delta, &m.groundSpawnTimers)
-
-	// Tick down abandoned order cooldowns
-	for _, order := range m.orders {
-		if order.Status == entity.OrderAbandoned && order.AbandonCooldown > 0 {
-			order.AbandonCooldown -= delta
-			if order.AbandonCooldown <= 0 {
-				order.AbandonCooldown = 0
-				order.Status = entity.OrderOpen
-			}
-		}
-	}
-
-	// Calculate intents (Phase II ready: can parallelize this)
-	items := m.gameMap.Items()
-	for _, char := range m.gameMap.Characters() {
-		oldIntent := char.Intent
-		char.Intent = system.CalculateIntent(char, items, m.gameMap, m.actionLog, m.orders)
-
-		// Reset action progress if intent action changed
-		if oldIntent == nil || char.Intent == nil || oldIntent.Action != char.Intent.Action {
-			char.ActionProgress = 0
-		}
-	}
-
-	// Apply intents atomically
-	for _, char := range m.gameMap.Characters() {
-		m.applyIntent(char, delta)
-	}
-
-	// Remove completed orders
-	m.sweepCompletedOrders()
+	// Run the world's systems (survival, lifecycle, intents, apply)
+	m.runSystems(delta)
 
 	// Update cursor if following
 	if m.following != nil {
@@ -662,6 +678,9 @@ func (m Model) updateGame(now time.Time) (Model, tea.Cmd) {
 		m.cursorX, m.cursorY = fpos.X, fpos.Y
 	}
 
+	m.publishStream()
+	m.recordMetrics()
+
 	return m, nil
 }
 
@@ -713,13 +732,7 @@ func (m Model) handleNameEditKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Don't allow empty names, stay in edit mode
 			return m, nil
 		}
-		// Find and update the character
-		for _, char := range m.gameMap.Characters() {
-			if char.ID == m.editingCharacterID {
-				char.Name = m.editingNameBuffer
-				break
-			}
-		}
+		m.renameCharacter(m.editingCharacterID, m.editingNameBuffer)
 		m.editingCharacterName = false
 		m.editingCharacterID = 0
 		m.editingNameBuffer = ""
@@ -747,6 +760,21 @@ func (m Model) handleNameEditKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// renameCharacter sets a character's name. Names must be non-empty and at most
+// MaxNameLength characters. Returns false if the name or character is invalid.
+func (m *Model) renameCharacter(charID int, name string) bool {
+	if name == "" || len(name) > MaxNameLength {
+		return false
+	}
+	for _, char := range m.gameMap.Characters() {
+		if char.ID == charID {
+			char.Name = name
+			return true
+		}
+	}
+	return false
+}
+
 // cycleToNextCharacter moves cursor and follow to the next alive character
 func (m *Model) cycleToNextCharacter() {
 	chars := m.gameMap.Characters()
@@ -838,52 +866,49 @@ func (m *Model) stepForward() {
 		m.flashIndex++
 	}
 
-	// Update survival mechanics
-	for _, char := range m.gameMap.Characters() {
-		system.UpdateSurvival(char, delta, m.actionLog)
-	}
+	// Run the world's systems (survival, lifecycle, intents, apply)
+	m.runSystems(delta)
 
-	// Update item spawning (unless no food mode)
-	if !m.testCfg.NoFood {
-		initialItemCount := config.ItemSpawnCount*2 + config.FlowerSpawnCount // berries + mushrooms + flowers
-		system.UpdateSpawnTimers(m.gameMap, initialItemCount, delta)
-		system.UpdateSproutTimers(m.gameMap, initialItemCount, delta)
+	// Update cursor if following
+	if m.following != nil {
+		fpos := m.following.Pos()
+		m.cursorX, m.cursorY = fpos.X, fpos.Y
 	}
 
-	// Update item death timers (flowers die regardless of no-food mode)
-	system.UpdateDeathTimers(m.gameMap, delta)
-
-	// Update seed extraction cooldown timers
-	system.UpdateSeedTimers(m.gameMap, delta)
-
-	// Update manually watered tile timers
-	m.gameMap.UpdateWateredTimers(delta)
-
-	// Update ground spawning (sticks, nuts, shells)
-	system.UpdateGroundSpawning(m.gameMap, delta, &m.groundSpawnTimers)
+	m.publishStream()
+	m.recordMetrics()
+}
 
-	// Calculate and apply intents
-	items := m.gameMap.Items()
-	for _, char := range m.gameMap.Characters() {
-		oldIntent := char.Intent
-		char.Intent = system.CalculateIntent(char, items, m.gameMap, m.actionLog, m.orders)
-		if oldIntent == nil || char.Intent == nil || oldIntent.Action != char.Intent.Action {
-			char.ActionProgress = 0
-		}
+// runSystems runs one tick of the world's system pipeline, then removes completed orders
+func (m *Model) runSystems(delta float64) {
+	ctx := &system.TickContext{
+		GameMap:            m.gameMap,
+		ActionLog:          m.actionLog,
+		Orders:             m.orders,
+		Delta:              delta,
+		GameTime:           m.elapsedGameTime,
+		NoFood:             m.testCfg.NoFood,
+		GroundSpawnTimers:  &m.groundSpawnTimers,
+		CreatureSpawnTimer: &m.creatureSpawnTimer,
+		ApplyIntent:        m.applyIntent,
 	}
-
-	for _, char := range m.gameMap.Characters() {
-		m.applyIntent(char, delta)
+	if m.metrics != nil {
+		ctx.OnPhase = m.metrics.observePhase
 	}
+	m.worldPipeline().Run(ctx)
 
 	// Remove completed orders
 	m.sweepCompletedOrders()
+}
 
-	// Update cursor if following
-	if m.following != nil {
-		fpos := m.following.Pos()
-		m.cursorX, m.cursorY = fpos.X, fpos.Y
+// worldPipeline returns the world's system pipeline, creating the default one on first use
+func (m *Model) worldPipeline() *system.Pipeline {
+	if m.pipeline == nil {
+		m.pipeline = system.DefaultPipeline()
+		// Debug mode profiles systems so the debug line can show the slowest
+		m.pipeline.SetProfiling(m.testCfg.Debug)
 	}
+	return m.pipeline
 }
 
 // getEdibleItemTypes returns item types that are edible (for character preferences)
@@ -895,6 +920,8 @@ func getEdibleItemTypes() []string {
 			types = append(types, itemType)
 		}
 	}
+	// Sort for consistent ordering (maps iterate randomly)
+	sort.Strings(types)
 	return types
 }
 
@@ -943,6 +970,8 @@ func (m Model) handleWorldSelectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		// New World selected - go to character creation
 		m.phase = phaseSelectMode
+	case "v", "V":
+		SetRenderProfile(nextRenderProfile(renderProfile))
 	case "d", "x":
 		// Start delete confirmation (only for saved worlds, not "New World")
 		if m.selectedWorld < len(m.worlds) {
@@ -964,8 +993,20 @@ func (m Model) loadWorld(worldID string) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Worlds need the content packs they were created with
+	if missing := content.Missing(state.ContentPacks); len(missing) > 0 {
+		save.LogWarning("Cannot load world %s: missing content packs %v", worldID, missing)
+		return m, nil
+	}
+
+	// A tuning.json in the world directory replaces the saved per-world tuning
+	if err := applyWorldTuningFile(worldID, state); err != nil {
+		save.LogWarning("Cannot load world %s: %v", worldID, err)
+		return m, nil
+	}
+
 	// Restore model from save state
-	m = FromSaveState(state, worldID, m.testCfg)
+	m = FromSaveState(state, worldID, m.testCfg).WithStreamer(m.streamer).WithMetrics(m.metrics)
 	m.paused = true // Start paused
 
 	return m, tickCmd(m.speedMultiplier)
@@ -1037,6 +1078,9 @@ func (m Model) handleCharacterCreationKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // startGameFromCreation initializes the game from character creation settings
 func (m Model) startGameFromCreation() Model {
 	m.gameMap = game.NewMap(config.MapWidth, config.MapHeight)
+	m.contentPacks = content.Loaded()
+	m.tuning = nil
+	config.SetWorldTuning(nil) // New worlds start with global tuning only
 	m.phase = phasePlaying
 	m.lastUpdate = time.Now()
 
@@ -1058,7 +1102,7 @@ func (m Model) startGameFromCreation() Model {
 	}
 
 	// Randomly select one character to follow
-	followIdx := rand.Intn(len(chars))
+	followIdx := rng.Intn(len(chars))
 	m.following = chars[followIdx]
 	fpos := chars[followIdx].Pos()
 	m.cursorX, m.cursorY = fpos.X, fpos.Y
@@ -1066,11 +1110,8 @@ func (m Model) startGameFromCreation() Model {
 	// Clear creation state
 	m.creationState = nil
 
-	// Spawn world: ponds first (before items/features), then clay, then features, then items
-	if !m.testCfg.NoWater {
-		game.SpawnPonds(m.gameMap)
-		game.SpawnClay(m.gameMap)
-	}
+	// Spawn world: terrain first (biomes, water, clay and trees), then features, then items
+	game.GenerateTerrain(m.gameMap, game.TerrainPreset(m.terrainPreset), m.testCfg.NoWater)
 	game.SpawnFeatures(m.gameMap, m.testCfg.NoWater, m.testCfg.NoBeds)
 	if !m.testCfg.NoFood {
 		game.SpawnItems(m.gameMap, m.testCfg.MushroomsOnly)
@@ -1080,7 +1121,9 @@ func (m Model) startGameFromCreation() Model {
 		Stick: system.RandomGroundSpawnInterval(),
 		Nut:   system.RandomGroundSpawnInterval(),
 		Shell: system.RandomGroundSpawnInterval(),
+		Stone: system.RandomGroundSpawnInterval(),
 	}
+	m.creatureSpawnTimer = system.RandomCreatureSpawnInterval()
 
 	// Create world for saving if not already set
 	if m.worldID == "" {
@@ -1141,12 +1184,16 @@ func (m *Model) applyOrdersConfirm() {
 				selectedActivity := activities[m.selectedActivityIndex]
 				// Dig has no sub-menu — create order immediately
 				if selectedActivity.ID == "dig" {
-					order := entity.NewOrder(m.nextOrderID, "dig", "clay")
-					m.nextOrderID++
-					m.orders = append(m.orders, order)
-					m.setOrderFlash(order.DisplayName())
+					m.addOrder("dig", "clay")
 					m.ordersAddStep = 0
 					m.selectedActivityIndex = 0
+				} else if selectedActivity.ID == "deconstruct" {
+					// Deconstruct has no sub-menu — go straight to area selection
+					m.ordersAddStep = 2
+					m.step2ActivityID = "deconstruct"
+					m.areaSelectAnchor = nil
+					m.areaSelectUnmarkMode = false
+					m.areaSelectLineMode = false
 				} else {
 					m.ordersAddStep = 1
 					m.selectedTargetIndex = 0
@@ -1177,16 +1224,28 @@ func (m *Model) applyOrdersConfirm() {
 							m.step2ActivityID = "buildFence"
 							m.areaSelectAnchor = nil
 							m.areaSelectUnmarkMode = false
+						} else if catActivity.ID == "digChannel" {
+							m.ordersAddStep = 2
+							m.step2ActivityID = "digChannel"
+							m.areaSelectAnchor = nil
+							m.areaSelectUnmarkMode = false
 						} else if catActivity.ID == "buildHut" {
 							m.ordersAddStep = 2
 							m.step2ActivityID = "buildHut"
 							m.areaSelectAnchor = nil
 							m.areaSelectUnmarkMode = false
+						} else if catActivity.ID == "buildCampfire" {
+							m.ordersAddStep = 2
+							m.step2ActivityID = "buildCampfire"
+							m.areaSelectAnchor = nil
+							m.areaSelectUnmarkMode = false
+						} else if catActivity.ID == "carveWood" || catActivity.ID == "chopWood" {
+							m.ordersAddStep = 2
+							m.step2ActivityID = catActivity.ID
+							m.areaSelectAnchor = nil
+							m.areaSelectUnmarkMode = false
 						} else {
-							order := entity.NewOrder(m.nextOrderID, catActivity.ID, "")
-							m.nextOrderID++
-							m.orders = append(m.orders, order)
-							m.setOrderFlash(order.DisplayName())
+							m.addOrder(catActivity.ID, "")
 							m.ordersAddStep = 0
 							m.selectedActivityIndex = 0
 						}
@@ -1195,10 +1254,7 @@ func (m *Model) applyOrdersConfirm() {
 					gatherTypes := game.GetGatherableTypes(m.gameMap.Items())
 					if m.selectedTargetIndex < len(gatherTypes) {
 						targetType := gatherTypes[m.selectedTargetIndex].TargetType
-						order := entity.NewOrder(m.nextOrderID, "gather", targetType)
-						m.nextOrderID++
-						m.orders = append(m.orders, order)
-						m.setOrderFlash(order.DisplayName())
+						m.addOrder("gather", targetType)
 						m.ordersAddStep = 0
 						m.selectedActivityIndex = 0
 					}
@@ -1206,10 +1262,7 @@ func (m *Model) applyOrdersConfirm() {
 					extractTypes := game.GetExtractableItemTypes(m.gameMap.Items())
 					if m.selectedTargetIndex < len(extractTypes) {
 						targetType := extractTypes[m.selectedTargetIndex].TargetType
-						order := entity.NewOrder(m.nextOrderID, "extract", targetType)
-						m.nextOrderID++
-						m.orders = append(m.orders, order)
-						m.setOrderFlash(order.DisplayName())
+						m.addOrder("extract", targetType)
 						m.ordersAddStep = 0
 						m.selectedActivityIndex = 0
 					}
@@ -1217,10 +1270,7 @@ func (m *Model) applyOrdersConfirm() {
 					types := m.getHarvestableItemTypes()
 					if m.selectedTargetIndex < len(types) {
 						targetType := types[m.selectedTargetIndex]
-						order := entity.NewOrder(m.nextOrderID, selectedActivity.ID, targetType)
-						m.nextOrderID++
-						m.orders = append(m.orders, order)
-						m.setOrderFlash(order.DisplayName())
+						m.addOrder(selectedActivity.ID, targetType)
 						m.ordersAddStep = 0
 						m.selectedActivityIndex = 0
 					}
@@ -1230,10 +1280,7 @@ func (m *Model) applyOrdersConfirm() {
 			if m.step2ActivityID == "plant" {
 				plantTypes := game.GetPlantableTypes(m.gameMap.Items(), m.gameMap.Characters())
 				if m.selectedPlantTypeIndex < len(plantTypes) {
-					order := entity.NewOrder(m.nextOrderID, "plant", plantTypes[m.selectedPlantTypeIndex].TargetType)
-					m.nextOrderID++
-					m.orders = append(m.orders, order)
-					m.setOrderFlash(order.DisplayName())
+					m.addOrder("plant", plantTypes[m.selectedPlantTypeIndex].TargetType)
 					// Go back to step 1 (Gardening sub-category) so player can immediately create another order
 					m.ordersAddStep = 1
 					m.selectedTargetIndex = 0
@@ -1241,10 +1288,53 @@ func (m *Model) applyOrdersConfirm() {
 			} else if m.step2ActivityID == "buildFence" {
 				// buildFence: Enter = done, create order if unbuilt fence marks exist
 				if m.gameMap.HasUnbuiltConstructionPositions("fence") {
-					order := entity.NewOrder(m.nextOrderID, "buildFence", "")
-					m.nextOrderID++
-					m.orders = append(m.orders, order)
-					m.setOrderFlash(order.DisplayName())
+					m.addOrder("buildFence", "")
+				}
+				m.ordersAddStep = 1
+				m.selectedTargetIndex = 0
+				m.areaSelectAnchor = nil
+				m.areaSelectUnmarkMode = false
+			} else if m.step2ActivityID == "digChannel" {
+				// digChannel: Enter = done, create order if a marked tile can be dug or a channel needs clearing
+				if system.HasChannelWork(m.gameMap) {
+					m.addOrder("digChannel", "")
+				}
+				m.ordersAddStep = 1
+				m.selectedTargetIndex = 0
+				m.areaSelectAnchor = nil
+				m.areaSelectUnmarkMode = false
+			} else if m.step2ActivityID == "deconstruct" {
+				// deconstruct: Enter = done, create order if marked constructs exist, back to step 0
+				if system.HasMarkedConstructs(m.gameMap) {
+					m.addOrder("deconstruct", "")
+				}
+				m.ordersAddStep = 0
+				m.selectedActivityIndex = 0
+				m.areaSelectAnchor = nil
+				m.areaSelectUnmarkMode = false
+				m.areaSelectLineMode = false
+			} else if m.step2ActivityID == "carveWood" {
+				// carveWood: Enter = done, create order if carvable marks exist
+				if system.HasCarvableMarks(m.gameMap) {
+					m.addOrder("carveWood", "")
+				}
+				m.ordersAddStep = 1
+				m.selectedTargetIndex = 0
+				m.areaSelectAnchor = nil
+				m.areaSelectUnmarkMode = false
+			} else if m.step2ActivityID == "chopWood" {
+				// chopWood: Enter = done, create order if choppable marks exist
+				if system.HasChoppableMarks(m.gameMap) {
+					m.addOrder("chopWood", "")
+				}
+				m.ordersAddStep = 1
+				m.selectedTargetIndex = 0
+				m.areaSelectAnchor = nil
+				m.areaSelectUnmarkMode = false
+			} else if m.step2ActivityID == "buildCampfire" {
+				// buildCampfire: Enter = done, create order if a campfire is marked or needs feeding
+				if system.HasCampfireWork(m.gameMap) {
+					m.addOrder("buildCampfire", "")
 				}
 				m.ordersAddStep = 1
 				m.selectedTargetIndex = 0
@@ -1253,10 +1343,7 @@ func (m *Model) applyOrdersConfirm() {
 			} else if m.step2ActivityID == "buildHut" {
 				// buildHut: Enter = done, create order if unbuilt hut marks exist
 				if m.gameMap.HasUnbuiltConstructionPositions("hut") {
-					order := entity.NewOrder(m.nextOrderID, "buildHut", "")
-					m.nextOrderID++
-					m.orders = append(m.orders, order)
-					m.setOrderFlash(order.DisplayName())
+					m.addOrder("buildHut", "")
 				}
 				m.ordersAddStep = 1
 				m.selectedTargetIndex = 0
@@ -1265,10 +1352,7 @@ func (m *Model) applyOrdersConfirm() {
 			} else {
 				// tillSoil: Enter = done, create order if tiles marked
 				if len(m.gameMap.MarkedForTillingPositions()) > 0 {
-					order := entity.NewOrder(m.nextOrderID, "tillSoil", "")
-					m.nextOrderID++
-					m.orders = append(m.orders, order)
-					m.setOrderFlash(order.DisplayName())
+					m.addOrder("tillSoil", "")
 				}
 				m.ordersAddStep = 1
 				m.selectedTargetIndex = 0
@@ -1278,20 +1362,7 @@ func (m *Model) applyOrdersConfirm() {
 		}
 	} else if m.ordersCancelMode {
 		if m.selectedOrderIndex < len(m.orders) {
-			order := m.orders[m.selectedOrderIndex]
-
-			// Clear assignment from character if order was assigned
-			if order.AssignedTo != 0 {
-				for _, char := range m.gameMap.Characters() {
-					if char.ID == order.AssignedTo {
-						char.AssignedOrderID = 0
-						char.Intent = nil
-						break
-					}
-				}
-			}
-
-			m.orders = append(m.orders[:m.selectedOrderIndex], m.orders[m.selectedOrderIndex+1:]...)
+			m.cancelOrder(m.orders[m.selectedOrderIndex].ID)
 
 			if m.selectedOrderIndex >= len(m.orders) && m.selectedOrderIndex > 0 {
 				m.selectedOrderIndex--
@@ -1304,6 +1375,39 @@ func (m *Model) applyOrdersConfirm() {
 	}
 }
 
+// addOrder creates a new order, appends it to the order list, and flashes its name.
+// All order creation paths (orders panel, API server) go through here.
+func (m *Model) addOrder(activityID, targetType string) *entity.Order {
+	order := entity.NewOrder(m.nextOrderID, activityID, targetType)
+	m.nextOrderID++
+	m.orders = append(m.orders, order)
+	m.setOrderFlash(order.DisplayName())
+	return order
+}
+
+// cancelOrder removes the order with the given ID and releases the assigned character.
+// Returns false if no order has that ID.
+func (m *Model) cancelOrder(id int) bool {
+	order := m.findOrderByID(id)
+	if order == nil {
+		return false
+	}
+
+	// Clear assignment from character if order was assigned
+	if order.AssignedTo != 0 {
+		for _, char := range m.gameMap.Characters() {
+			if char.ID == order.AssignedTo {
+				char.AssignedOrderID = 0
+				char.Intent = nil
+				break
+			}
+		}
+	}
+
+	m.removeOrder(id)
+	return true
+}
+
 // setOrderFlash sets or updates the order creation flash confirmation.
 // If the same order type is created consecutively within the flash duration,
 // the count increments. Otherwise, it resets to 1.
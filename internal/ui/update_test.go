@@ -474,7 +474,7 @@ func TestApplyIntent_CraftConsumesGourdFromVessel(t *testing.T) {
 	if recipe == nil {
 		t.Fatal("hollow-gourd recipe not found")
 	}
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -850,7 +850,7 @@ func TestApplyIntent_CraftHoe_CreatesHoeFromStickAndShell(t *testing.T) {
 	if recipe == nil {
 		t.Fatal("shell-hoe recipe not found")
 	}
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -929,7 +929,7 @@ func TestApplyIntent_CraftVessel_Regression_StillWorksViaGeneralizedPath(t *test
 
 	// Apply intent
 	recipe := entity.RecipeRegistry["hollow-gourd"]
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -4298,7 +4298,7 @@ func TestApplyCraft_CraftBrick_CreatesBrick(t *testing.T) {
 	if recipe == nil {
 		t.Fatal("clay-brick recipe not found")
 	}
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -4353,7 +4353,7 @@ func TestApplyCraft_CraftBrick_DoesNotCompleteOrder(t *testing.T) {
 	}
 
 	recipe := entity.RecipeRegistry["clay-brick"]
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -4399,7 +4399,7 @@ func TestApplyCraft_NonRepeatable_CompletesOrder(t *testing.T) {
 	}
 
 	recipe := entity.RecipeRegistry["hollow-gourd"]
-	iterations := int(recipe.Duration/0.1) + 5
+	iterations := int(recipe.CraftDuration()/0.1) + 5
 	for i := 0; i < iterations; i++ {
 		m.applyIntent(char, 0.1)
 	}
@@ -4687,6 +4687,59 @@ func TestBrickSupplyDrop_IntegrationLoop(t *testing.T) {
 		deliveredBricks, countBricksInInventory(char), char.Pos())
 }
 
+func TestDeconstructOrder_IntegrationLoop(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(20, 20)
+	char := entity.NewCharacter(1, 5, 8, "TestChar", "berry", types.ColorRed)
+	gameMap.AddCharacter(char)
+
+	// A run of hut wall; the two left segments are marked for deconstruction
+	var walls []*entity.Construct
+	for x := 8; x <= 10; x++ {
+		wall := entity.NewHutConstruct(x, 5, "stick", types.ColorBrown, "wall")
+		gameMap.AddConstruct(wall)
+		walls = append(walls, wall)
+	}
+	gameMap.MarkForDeconstruction(walls[0].Pos())
+	gameMap.MarkForDeconstruction(walls[1].Pos())
+
+	order := entity.NewOrder(1, "deconstruct", "")
+	orders := []*entity.Order{order}
+	actionLog := system.NewActionLog(100)
+	m := Model{gameMap: gameMap, actionLog: actionLog, orders: orders}
+
+	for tick := 0; tick < 200 && order.Status != entity.OrderCompleted; tick++ {
+		oldIntent := char.Intent
+		char.Intent = system.CalculateIntent(char, gameMap.Items(), gameMap, actionLog, orders)
+		if oldIntent == nil || char.Intent == nil || (oldIntent.Action != char.Intent.Action) {
+			char.ActionProgress = 0
+		}
+		if char.Intent != nil {
+			m.applyIntent(char, 0.5)
+		}
+	}
+
+	if order.Status != entity.OrderCompleted {
+		t.Fatalf("Expected the deconstruct order to complete, got status %v", order.Status)
+	}
+	if gameMap.ConstructAt(walls[0].Pos()) != nil || gameMap.ConstructAt(walls[1].Pos()) != nil {
+		t.Error("Expected both marked walls to be taken down")
+	}
+	if gameMap.ConstructAt(walls[2].Pos()) != walls[2] {
+		t.Error("Expected the unmarked wall to stay standing")
+	}
+	sticks := 0
+	for _, item := range gameMap.Items() {
+		if item.ItemType == "stick" {
+			sticks += item.BundleCount
+		}
+	}
+	if sticks != 24 {
+		t.Errorf("Expected 24 sticks recovered from two walls, got %d", sticks)
+	}
+}
+
 func countBricksInInventory(char *entity.Character) int {
 	count := 0
 	for _, inv := range char.Inventory {
@@ -4738,3 +4791,26 @@ func TestApplyPickup_BuildFence_BrickPickup_ClearsIntent(t *testing.T) {
 		t.Error("Expected intent cleared after brick pickup for buildFence order")
 	}
 }
+
+// =============================================================================
+// Terrain preset Tests
+// =============================================================================
+
+func TestModeSelect_TCyclesTerrainPresets(t *testing.T) {
+	t.Parallel()
+
+	m := Model{phase: phaseSelectMode}
+	var seen []string
+	for range game.TerrainPresets {
+		next, _ := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+		m = next.(Model)
+		seen = append(seen, game.TerrainPreset(m.terrainPreset).ID)
+	}
+
+	for i, id := range seen {
+		want := game.TerrainPresets[(i+1)%len(game.TerrainPresets)].ID
+		if id != want {
+			t.Errorf("Press %d: expected preset %s, got %s", i+1, want, id)
+		}
+	}
+}
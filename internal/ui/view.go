@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"sort"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/i18n"
 	"petri/internal/system"
 	"petri/internal/types"
 )
@@ -38,22 +40,94 @@ func colorByTier(level string, tier int) string {
 
 // constructionMarkLabel builds the details panel label for a construction mark (DD-45).
 func constructionMarkLabel(mark game.ConstructionMark) string {
-	label := "Marked for construction"
 	kind := strings.ToUpper(mark.ConstructKind[:1]) + mark.ConstructKind[1:]
 	if mark.WallRole != "" {
 		kind += " " + strings.ToUpper(mark.WallRole[:1]) + mark.WallRole[1:]
 	}
 	if mark.Material != "" {
 		mat := strings.ToUpper(mark.Material[:1]) + mark.Material[1:]
-		label += " (" + mat + " " + kind + ")"
-	} else {
-		label += " (" + kind + ")"
+		return i18n.T("ui.marked_for_construction", mat+" "+kind)
 	}
-	return label
+	return i18n.T("ui.marked_for_construction", kind)
+}
+
+// debugOnlyKeys are action log messages shown only in debug mode
+var debugOnlyKeys = map[string]bool{
+	"log.mood.eat_improved":  true,
+	"log.mood.eat_worsened":  true,
+	"log.mood.look_improved": true,
+	"log.mood.look_worsened": true,
+	"log.health.impacted":    true,
+	"log.energy.crisis":      true,
+}
+
+// isDebugOnlyEvent reports whether an event is hidden outside debug mode
+func isDebugOnlyEvent(event system.Event) bool {
+	if event.Key != "" {
+		return debugOnlyKeys[event.Key]
+	}
+	// Events saved before localization carry only their English text
+	return strings.Contains(event.Message, "Improved Mood") ||
+		strings.Contains(event.Message, "Worsened Mood") ||
+		strings.Contains(event.Message, "impacted health") ||
+		event.Message == "Collapsed from exhaustion!"
+}
+
+// logKeyStyles colors action log messages by catalog key, so coloring doesn't depend on the language
+var logKeyStyles = map[string]lipgloss.Style{
+	"log.learned_something":             learnedStyle,
+	"log.knowledge.learned":             learnedStyle,
+	"log.calmed_down":                   woreOffStyle,
+	"log.sleep.woke_hungry":             woreOffStyle,
+	"log.sleep.woke_thirsty":            woreOffStyle,
+	"log.sleep.woke_rested":             woreOffStyle,
+	"log.sleep.woke_partially_rested":   woreOffStyle,
+	"log.poison.wore_off":               woreOffStyle,
+	"log.preference.no_longer_likes":    woreOffStyle,
+	"log.preference.no_longer_dislikes": woreOffStyle,
+	"log.hunger.crisis":                 crisisStyle,
+	"log.thirst.crisis":                 crisisStyle,
+	"log.energy.crisis":                 crisisStyle,
+	"log.health.crisis":                 crisisStyle,
+	"log.health.starving":               crisisStyle,
+	"log.health.dehydrated":             crisisStyle,
+	"log.mood.crisis":                   crisisStyle,
+	"log.sleep.collapsed":               crisisStyle,
+	"log.died":                          crisisStyle,
+	"log.hunger.severe":                 severeStyle,
+	"log.thirst.severe":                 severeStyle,
+	"log.energy.severe":                 severeStyle,
+	"log.health.severe":                 severeStyle,
+	"log.mood.severe":                   severeStyle,
+	"log.preference.dislikes":           severeStyle,
+	"log.frustrated":                    frustratedStyle,
+	"log.sleep.leaf_pile":               sleepingStyle,
+	"log.sleep.ground":                  sleepingStyle,
+	"log.order.resuming":                orderStyle,
+	"log.order.taking":                  orderStyle,
+	"log.order.abandoning":              orderStyle,
+	"log.order.completed":               orderStyle,
+	"log.order.pausing":                 orderStyle,
+	"log.poison.became":                 poisonedStyle,
+	"log.mood.none":                     optimalStyle,
+	"log.health.impacted":               optimalStyle,
+	"log.preference.likes":              optimalStyle,
 }
 
-// colorLogMessage colors action log messages based on content
-func colorLogMessage(line, message string) string {
+// colorLogMessage colors action log messages based on their catalog key,
+// or on content for events saved before localization
+func colorLogMessage(line string, event system.Event) string {
+	if event.Type == "discovery" {
+		return learnedStyle.Render(line)
+	}
+	if event.Key != "" {
+		if style, ok := logKeyStyles[event.Key]; ok {
+			return style.Render(line)
+		}
+		return line
+	}
+	message := event.Message
+
 	// Learning/discovery messages (darker blue) - check first as these are important
 	if strings.Contains(message, "Learned") || strings.Contains(message, "Discovered") {
 		return learnedStyle.Render(line)
@@ -156,16 +230,16 @@ func (m Model) viewWorldSelect() string {
 	}
 
 	var lines []string
-	lines = append(lines, titleStyle.Render("=== PETRI PROJECT ==="))
+	lines = append(lines, titleStyle.Render(i18n.T("ui.world_select_title")))
 	lines = append(lines, "")
 
 	// Check if we're confirming a delete
 	if m.confirmingDelete >= 0 && m.confirmingDelete < len(m.worlds) {
 		worldName := m.worlds[m.confirmingDelete].Name
-		lines = append(lines, fmt.Sprintf("Delete \"%s\"? This cannot be undone.", worldName))
+		lines = append(lines, i18n.T("ui.delete_this_cannot_be_undone", worldName))
 		lines = append(lines, "")
-		lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(
-			"Y: Confirm   N: Cancel"))
+		lines = append(lines, hintStyle.Render(
+			i18n.T("ui.y_confirm_n_cancel")))
 
 		content := lipgloss.JoinVertical(lipgloss.Center, lines...)
 		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
@@ -173,18 +247,18 @@ func (m Model) viewWorldSelect() string {
 
 	if len(m.worlds) == 0 {
 		// No existing worlds
-		lines = append(lines, "No saved worlds found.")
+		lines = append(lines, i18n.T("ui.no_saved_worlds_found"))
 		lines = append(lines, "")
 		if m.selectedWorld == 0 {
-			lines = append(lines, highlightStyle.Render("> New World"))
+			lines = append(lines, highlightStyle.Render(i18n.T("ui.new_world_selected")))
 		} else {
-			lines = append(lines, "  New World")
+			lines = append(lines, i18n.T("ui.new_world"))
 		}
 	} else {
 		// List existing worlds
 		for i, world := range m.worlds {
 			lastPlayed := formatTimeAgo(world.LastPlayedAt)
-			entry := fmt.Sprintf("Continue \"%s\" (%d alive, %s)", world.Name, world.AliveCount, lastPlayed)
+			entry := i18n.T("ui.continue_alive", world.Name, world.AliveCount, lastPlayed)
 			if i == m.selectedWorld {
 				lines = append(lines, highlightStyle.Render("> "+entry))
 			} else {
@@ -194,21 +268,23 @@ func (m Model) viewWorldSelect() string {
 		// "New World" option at the end
 		newWorldIdx := len(m.worlds)
 		if m.selectedWorld == newWorldIdx {
-			lines = append(lines, highlightStyle.Render("> New World"))
+			lines = append(lines, highlightStyle.Render(i18n.T("ui.new_world_selected")))
 		} else {
-			lines = append(lines, "  New World")
+			lines = append(lines, i18n.T("ui.new_world"))
 		}
 	}
 
 	lines = append(lines, "")
 	// Show D: Delete hint only when a saved world is selected (not "New World")
 	if m.selectedWorld < len(m.worlds) {
-		lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(
-			"↑/↓ Select   Enter: Continue   D: Delete   Q: Quit"))
+		lines = append(lines, hintStyle.Render(
+			i18n.T("ui.world_select_hints_delete")))
 	} else {
-		lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(
-			"↑/↓ Select   Enter: Continue   Q: Quit"))
+		lines = append(lines, hintStyle.Render(
+			i18n.T("ui.world_select_hints")))
 	}
+	lines = append(lines, hintStyle.Render(
+		i18n.T("ui.render_profile", i18n.T("render."+string(renderProfile)))))
 
 	content := lipgloss.JoinVertical(lipgloss.Center, lines...)
 	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
@@ -219,25 +295,16 @@ func formatTimeAgo(t time.Time) string {
 	d := time.Since(t)
 	switch {
 	case d < time.Minute:
-		return "just now"
+		return i18n.T("ui.just_now")
 	case d < time.Hour:
 		mins := int(d.Minutes())
-		if mins == 1 {
-			return "1 min ago"
-		}
-		return fmt.Sprintf("%d mins ago", mins)
+		return i18n.N("ui.mins_ago", mins, mins)
 	case d < 24*time.Hour:
 		hours := int(d.Hours())
-		if hours == 1 {
-			return "1 hour ago"
-		}
-		return fmt.Sprintf("%d hours ago", hours)
+		return i18n.N("ui.hours_ago", hours, hours)
 	default:
 		days := int(d.Hours() / 24)
-		if days == 1 {
-			return "1 day ago"
-		}
-		return fmt.Sprintf("%d days ago", days)
+		return i18n.N("ui.days_ago", days, days)
 	}
 }
 
@@ -245,12 +312,14 @@ func formatTimeAgo(t time.Time) string {
 func (m Model) viewModeSelect() string {
 	content := lipgloss.JoinVertical(lipgloss.Center,
 		"",
-		titleStyle.Render("=== Petri ==="),
+		titleStyle.Render(i18n.T("ui.title")),
+		"",
+		i18n.T("ui.r_random_characters"),
+		i18n.T("ui.c_create_characters"),
 		"",
-		"R  Random Characters",
-		"C  Create Characters",
+		i18n.T("ui.t_terrain", terrainPresetLabel(game.TerrainPreset(m.terrainPreset).ID)),
 		"",
-		"Esc: Back",
+		i18n.T("ui.esc_back"),
 	)
 
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
@@ -259,35 +328,18 @@ func (m Model) viewModeSelect() string {
 // viewCharacterCreate renders the character creation screen
 func (m Model) viewCharacterCreate() string {
 	if m.creationState == nil {
-		return "Loading..."
+		return i18n.T("ui.loading")
 	}
 
-	// Styles for character cards
-	cardStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		Padding(0, 1).
-		Width(26)
-
-	selectedCardStyle := cardStyle.Copy().
-		BorderForeground(lipgloss.Color("45")) // cyan highlight
-
-	fieldLabelStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
-
-	selectedFieldStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("45")).
-		Bold(true)
-
 	// Build character cards
 	var cards []string
 	for i, charData := range m.creationState.Characters {
 		isSelectedChar := i == m.creationState.SelectedChar
 
 		// Build field displays
-		nameLabel := "Name:"
-		foodLabel := "Fav Food:"
-		colorLabel := "Fav Color:"
+		nameLabel := i18n.T("ui.name_label")
+		foodLabel := i18n.T("ui.fav_food")
+		colorLabel := i18n.T("ui.fav_color")
 
 		nameValue := charData.Name
 		foodValue := charData.Food
@@ -297,13 +349,13 @@ func (m Model) viewCharacterCreate() string {
 		if isSelectedChar {
 			switch m.creationState.SelectedField {
 			case FieldName:
-				nameLabel = selectedFieldStyle.Render("Name:")
+				nameLabel = selectedFieldStyle.Render(i18n.T("ui.name_label"))
 				nameValue = selectedFieldStyle.Render(charData.Name + "_")
 			case FieldFood:
-				foodLabel = selectedFieldStyle.Render("Fav Food:")
+				foodLabel = selectedFieldStyle.Render(i18n.T("ui.fav_food"))
 				foodValue = selectedFieldStyle.Render("[" + charData.Food + "]")
 			case FieldColor:
-				colorLabel = selectedFieldStyle.Render("Fav Color:")
+				colorLabel = selectedFieldStyle.Render(i18n.T("ui.fav_color"))
 				colorValue = selectedFieldStyle.Render("[" + charData.Color + "]")
 			}
 		} else {
@@ -339,11 +391,9 @@ func (m Model) viewCharacterCreate() string {
 	cardGrid := lipgloss.JoinVertical(lipgloss.Left, cardRows...)
 
 	// Key hints
-	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-
-	hintLines := "← → Navigate characters   ↑ ↓ Navigate fields   Tab: Next field\n+/-: Add/remove character   Ctrl+R: Randomize   Enter: Start game   Esc: Back"
+	hintLines := i18n.T("ui.creation_hints")
 	if !m.creationState.IsNameFieldSelected() {
-		hintLines += "\nSpace: Change option"
+		hintLines += i18n.T("ui.creation_hint_option")
 	}
 
 	hints := hintStyle.Render(hintLines)
@@ -353,9 +403,9 @@ func (m Model) viewCharacterCreate() string {
 	// Build full content
 	content := lipgloss.JoinVertical(lipgloss.Center,
 		"",
-		titleStyle.Render("=== CHARACTER CREATION ==="),
+		titleStyle.Render(i18n.T("ui.character_creation")),
 		"",
-		fmt.Sprintf("Customize your characters: %d", count),
+		i18n.T("ui.customize_your_characters", count),
 		"",
 		cardGrid,
 		"",
@@ -396,7 +446,11 @@ func (m Model) viewGame() string {
 	totalContentHeight := m.gameMap.Height - 2 // Account for extra borders on right panel
 
 	var rightPanel string
-	if m.showOrdersPanel {
+	if m.showTuningPanel {
+		// Tuning panel (debug): full-height like All Activity mode
+		tuningHeight := totalContentHeight + 2
+		rightPanel = borderStyle.Width(panelWidth).Height(tuningHeight).Render(m.renderTuningPanel(tuningHeight))
+	} else if m.showOrdersPanel {
 		// Orders panel: full-height like All Activity mode
 		allActivityHeight := totalContentHeight + 2
 		ordersView := borderStyle.Width(panelWidth).Height(allActivityHeight).Render(m.renderOrdersPanel())
@@ -431,14 +485,16 @@ func (m Model) viewGame() string {
 	gameArea := lipgloss.JoinHorizontal(lipgloss.Top, mapView, " ", rightPanel)
 
 	// World time display (120 game seconds = 1 world day)
-	worldDay := int(m.elapsedGameTime/120) + 1
+	worldDay := system.WorldDay(m.elapsedGameTime)
+	season := i18n.T("season." + m.gameMap.Season().ID())
+	clock := system.FormatClock(m.elapsedGameTime) + " " + i18n.T("time."+system.TimeOfDayAt(m.elapsedGameTime).ID())
 
 	// Status bar with mode-specific hints
-	status := "RUNNING"
+	status := i18n.T("ui.running")
 	stepHint := ""
 	if m.paused {
-		status = "PAUSED"
-		stepHint = " | .=step"
+		status = i18n.T("ui.paused")
+		stepHint = i18n.T("ui.step")
 	}
 
 	// Speed indicator and control hints
@@ -446,51 +502,59 @@ func (m Model) viewGame() string {
 	speedControls := ""
 	if m.speedMultiplier == 2 {
 		speedHint = " [½x]"
-		speedControls = " | > =fast"
+		speedControls = i18n.T("ui.fast")
 	} else if m.speedMultiplier == 4 {
 		speedHint = " [¼x]"
-		speedControls = " | > =fast"
+		speedControls = i18n.T("ui.fast")
 	}
 	if m.speedMultiplier < 4 {
-		speedControls = " | < =slow" + speedControls
+		speedControls = i18n.T("ui.slow") + speedControls
 	}
 
 	// Saving indicator
 	saveHint := ""
 	if time.Now().Before(m.saveIndicatorEnd) {
-		saveHint = " " + orangeStyle.Render("[Saved]")
+		saveHint = " " + orangeStyle.Render(i18n.T("ui.saved"))
 	}
 
 	// Build hints for all currently applicable actions
 	var hints []string
 
 	// q always shown
-	hints = append(hints, "q=leave world")
+	hints = append(hints, i18n.T("ui.q_leave_world"))
 
 	// View mode switching (always available)
 	if m.viewMode == viewModeAllActivity {
-		hints = append(hints, "s=select", "x=expand")
+		hints = append(hints, i18n.T("ui.s_select"), i18n.T("ui.x_expand"))
 	} else {
-		hints = append(hints, "a=all activity")
+		hints = append(hints, i18n.T("ui.a_all_activity"))
 	}
-	hints = append(hints, "b/n=back/next", "o=orders")
+	hints = append(hints, i18n.T("ui.b_n_back_next"), i18n.T("ui.o_orders"), i18n.T("ui.g_soil"))
 
 	// Cursor movement (not available in orders add/cancel mode)
 	inOrdersInput := m.showOrdersPanel && (m.ordersAddMode || m.ordersCancelMode)
 	if !inOrdersInput {
-		hints = append(hints, "ARROWS=cursor")
+		hints = append(hints, i18n.T("ui.arrows_cursor"))
 	}
 
 	// ESC hint — context-dependent, always visible when applicable
-	inSubpanel := m.showKnowledgePanel || m.showInventoryPanel || m.showPreferencesPanel
+	inSubpanel := m.showKnowledgePanel || m.showInventoryPanel || m.showPreferencesPanel || m.showTuningPanel
 	if m.ordersFullScreen || m.activityFullScreen {
-		hints = append(hints, "ESC=collapse")
+		hints = append(hints, i18n.T("ui.esc_collapse"))
 	} else if inOrdersInput || inSubpanel || m.showOrdersPanel || m.viewMode == viewModeSelect {
-		hints = append(hints, "ESC=back")
+		hints = append(hints, i18n.T("ui.esc_back_hint"))
 	}
 	// All-activity view with nothing expanded: no esc hint
 
-	statusBar := fmt.Sprintf("\nDay %d | [%s]%s%s SPACE=pause%s%s | %s", worldDay, status, speedHint, saveHint, speedControls, stepHint, strings.Join(hints, " | "))
+	statusBar := i18n.T("ui.status_bar", season, worldDay, clock, status, speedHint, saveHint, speedControls, stepHint, strings.Join(hints, " | "))
+
+	// Debug console replaces the hints while open
+	if m.consoleOpen {
+		statusBar = i18n.T("ui.status_bar_console", season, worldDay, clock, status, speedHint, m.consoleInput)
+		if m.consoleMessage != "" {
+			statusBar += "\n" + m.consoleMessage
+		}
+	}
 
 	// Debug line (only shown with -debug flag)
 	debugLine := ""
@@ -505,12 +569,60 @@ func (m Model) viewGame() string {
 			}
 			charInfo = append(charInfo, fmt.Sprintf("%s(%d,%d)%s", c.Name, pos.X, pos.Y, marker))
 		}
-		debugLine = fmt.Sprintf("\nChars: %v", charInfo)
+		debugLine = i18n.T("ui.debug_line", charInfo)
+		if summary := systemsDebugSummary(m.pipeline); summary != "" {
+			debugLine += i18n.T("ui.systems") + summary
+		}
 	}
 
 	return gameArea + statusBar + debugLine
 }
 
+// soilStyle returns the soil overlay tint for a fertility value
+func soilStyle(fertility float64) lipgloss.Style {
+	switch {
+	case fertility < config.SoilPoorFertility:
+		return soilPoorStyle
+	case fertility > config.SoilRichFertility:
+		return soilRichStyle
+	default:
+		return soilFairStyle
+	}
+}
+
+// soilLines returns the details lines for the tilled soil at pos: fertility and the last crop harvested
+func (m Model) soilLines(pos types.Position) []string {
+	lines := []string{i18n.T("ui.fertility", int(math.Round(m.gameMap.Fertility(pos)*100)))}
+	if crop := m.gameMap.LastCrop(pos); crop != "" {
+		lines = append(lines, i18n.T("ui.last_crop", entity.Pluralize(crop)))
+	}
+	return lines
+}
+
+// systemsDebugSummary lists disabled systems and the slowest profiled systems by average time per tick
+func systemsDebugSummary(pipeline *system.Pipeline) string {
+	if pipeline == nil {
+		return ""
+	}
+	var parts []string
+	if disabled := pipeline.Disabled(); len(disabled) > 0 {
+		parts = append(parts, i18n.T("ui.systems_off")+strings.Join(disabled, ","))
+	}
+
+	profiles := pipeline.Profile()
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Total/time.Duration(profiles[i].Calls) > profiles[j].Total/time.Duration(profiles[j].Calls)
+	})
+	for i, prof := range profiles {
+		if i == 3 {
+			break
+		}
+		avg := prof.Total / time.Duration(prof.Calls)
+		parts = append(parts, i18n.T("ui.phase_timing", prof.Name, float64(avg.Microseconds())/1000))
+	}
+	return strings.Join(parts, " | ")
+}
+
 // hutSymbolFromAdjacency computes the box-drawing symbol and horizontal fills
 // for a hut construct based on its cardinal neighbors (DD-42).
 func hutSymbolFromAdjacency(pos types.Position, gameMap *game.Map) (symbol rune, leftFill string, rightFill string) {
@@ -582,12 +694,16 @@ func (m Model) renderCell(x, y int) string {
 	var fill string      // terrain fill for padding (empty = use spaces)
 	var leftFill string  // asymmetric left fill (hut constructs)
 	var rightFill string // asymmetric right fill (hut constructs)
+	var suffix string    // color letter after the symbol (monochrome profile)
 
 	// Check for character first (takes visual precedence)
 	if char := m.gameMap.CharacterAt(pos); char != nil {
 		sym = m.styledSymbol(char)
+	} else if creature := m.gameMap.CreatureAt(pos); creature != nil {
+		sym = m.styledSymbol(creature)
 	} else if item := m.gameMap.ItemAt(pos); item != nil {
 		sym = m.styledSymbol(item)
+		suffix = colorSuffix(item.Color)
 	} else if construct := m.gameMap.ConstructAt(pos); construct != nil {
 		if construct.Kind == "hut" {
 			// Hut constructs use adjacency-based symbol computation (DD-42)
@@ -601,6 +717,9 @@ func (m Model) renderCell(x, y int) string {
 			if adjRight == string(config.CharHutEdgeH) {
 				rightFill = hFill
 			}
+		} else if construct.IsCampfire() {
+			sym = m.styledSymbol(construct)
+			suffix = colorSuffix(construct.MaterialColor)
 		} else {
 			sym = m.styledSymbol(construct)
 			// Fence: directional fill for horizontal continuity
@@ -608,9 +727,30 @@ func (m Model) renderCell(x, y int) string {
 			rightPos := types.Position{X: x + 1, Y: y}
 			if m.gameMap.ConstructAt(leftPos) != nil || m.gameMap.ConstructAt(rightPos) != nil {
 				fill = sym // horizontal neighbor: ╬╬╬ (continuous bar)
+			} else {
+				suffix = colorSuffix(construct.MaterialColor)
 			}
 			// else: no fill, renders as " ╬ " (centered post for vertical/standalone)
 		}
+	} else if tree := m.gameMap.TreeAt(pos); tree != game.TreeNone {
+		// Tree terrain: solid wood fills the tile, carved tiles show a floor or gap
+		switch tree {
+		case game.TreeLivewood:
+			sym = livewoodStyle.Render(string(config.CharLivewood))
+			fill = sym
+		case game.TreeHeartwood:
+			sym = heartwoodStyle.Render(string(config.CharHeartwood))
+			fill = sym
+		case game.TreeHollow:
+			sym = heartwoodStyle.Render(string(config.CharHollow))
+		case game.TreeOpening:
+			sym = livewoodStyle.Render(string(config.CharTreeOpening))
+		}
+	} else if m.gameMap.IsOutcrop(pos) {
+		// Rock outcrop — full terrain fill
+		rockFill := outcropStyle.Render(string(config.CharOutcrop))
+		sym = rockFill
+		fill = rockFill
 	} else if wtype := m.gameMap.WaterAt(pos); wtype != game.WaterNone {
 		// Water terrain
 		switch wtype {
@@ -621,6 +761,14 @@ func (m Model) renderCell(x, y int) string {
 			waterFill := waterStyle.Render(string(config.CharWater))
 			sym = waterFill
 			fill = waterFill
+		case game.WaterRiver:
+			riverFill := waterStyle.Render(string(config.CharRiver))
+			sym = riverFill
+			fill = riverFill
+		case game.WaterChannel:
+			channelFill := waterStyle.Render(string(config.CharChannel))
+			sym = channelFill
+			fill = channelFill
 		}
 	} else if m.gameMap.IsClay(pos) {
 		// Empty clay tile — full terrain fill
@@ -656,6 +804,44 @@ func (m Model) renderCell(x, y int) string {
 		fill = tStyle.Render(string(config.CharTilledSoil))
 	}
 
+	// Burning tiles stand out over every overlay
+	if !isCursor && m.gameMap.IsBurning(pos) {
+		padded := " " + sym + " "
+		if fill != "" {
+			padded = fill + sym + fill
+		}
+		return fireStyle.Render(padded)
+	}
+
+	// Soil overlay tints tilled tiles by fertility
+	if m.showSoilOverlay && !isCursor && m.gameMap.IsTilled(pos) {
+		left, right := " ", " "
+		if fill != "" {
+			left, right = fill, fill
+		}
+		if suffix != "" {
+			right = suffix
+		}
+		return soilStyle(m.gameMap.Fertility(pos)).Render(left + sym + right)
+	}
+
+	// Enclosed region under the cursor is tinted so its extent is visible
+	if !m.ordersAddMode && !isCursor {
+		region := m.gameMap.RegionOf(types.Position{X: m.cursorX, Y: m.cursorY})
+		if region != nil && m.gameMap.RegionOf(pos) == region {
+			hasEntity := m.gameMap.CharacterAt(pos) != nil || m.gameMap.ItemAt(pos) != nil || m.gameMap.FeatureAt(pos) != nil
+			if hasEntity {
+				bg := regionStyle.Render(" ")
+				return bg + sym + bg
+			}
+			padded := " " + sym + " "
+			if fill != "" {
+				padded = fill + sym + fill
+			}
+			return regionStyle.Render(padded)
+		}
+	}
+
 	// Area selection highlighting (only visible during tillSoil step 2)
 	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "tillSoil" {
 		// Rectangle highlight when anchor is set
@@ -745,6 +931,138 @@ func (m Model) renderCell(x, y int) string {
 		}
 	}
 
+	// Line preview and existing marks during digChannel step 2
+	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "digChannel" {
+		if m.areaSelectAnchor != nil && !isCursor {
+			cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+			if isOnLine(pos, *m.areaSelectAnchor, cursor) {
+				validator := isValidDigTarget
+				bgStyle := areaSelectStyle
+				if m.areaSelectUnmarkMode {
+					validator = isValidUnmarkDigTarget
+					bgStyle = areaUnselectStyle
+				}
+				if validator(pos, m.gameMap) {
+					padded := " " + sym + " "
+					if fill != "" {
+						padded = fill + sym + fill
+					}
+					return bgStyle.Render(padded)
+				}
+			}
+		}
+
+		// Highlight tiles already marked for digging
+		if m.gameMap.IsMarkedForDigging(pos) && !isCursor {
+			padded := " " + sym + " "
+			if fill != "" {
+				padded = fill + sym + fill
+			}
+			return markedForDiggingStyle.Render(padded)
+		}
+	}
+
+	// Line or rectangle preview and existing marks during deconstruct step 2
+	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "deconstruct" {
+		if m.areaSelectAnchor != nil && !isCursor {
+			cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+			inSelection := isInRect(pos, *m.areaSelectAnchor, cursor)
+			if m.areaSelectLineMode {
+				inSelection = isOnLine(pos, *m.areaSelectAnchor, cursor)
+			}
+			if inSelection {
+				validator := isValidDeconstructTarget
+				bgStyle := areaSelectStyle
+				if m.areaSelectUnmarkMode {
+					validator = isValidUnmarkDeconstructTarget
+					bgStyle = areaUnselectStyle
+				}
+				if validator(pos, m.gameMap) {
+					padded := " " + sym + " "
+					if fill != "" {
+						padded = fill + sym + fill
+					}
+					return bgStyle.Render(padded)
+				}
+			}
+		}
+
+		// Highlight constructs already marked for deconstruction
+		if m.gameMap.IsMarkedForDeconstruction(pos) && !isCursor {
+			padded := " " + sym + " "
+			if fill != "" {
+				padded = fill + sym + fill
+			}
+			return markedForDeconstructStyle.Render(padded)
+		}
+	}
+
+	// Rectangle preview and existing marks during carveWood step 2
+	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "carveWood" {
+		if m.areaSelectAnchor != nil && !isCursor {
+			cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+			if isInRect(pos, *m.areaSelectAnchor, cursor) {
+				validator := isValidCarveTarget
+				bgStyle := areaSelectStyle
+				if m.areaSelectUnmarkMode {
+					validator = isValidUnmarkCarveTarget
+					bgStyle = areaUnselectStyle
+				}
+				if validator(pos, m.gameMap) {
+					return bgStyle.Render(" " + sym + " ")
+				}
+			}
+		}
+
+		// Highlight tree tiles already marked for carving
+		if m.gameMap.IsMarkedForCarving(pos) && !isCursor {
+			return markedForCarvingStyle.Render(" " + sym + " ")
+		}
+	}
+
+	// Rectangle preview and existing marks during chopWood step 2
+	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "chopWood" {
+		if m.areaSelectAnchor != nil && !isCursor {
+			cursor := types.Position{X: m.cursorX, Y: m.cursorY}
+			if isInRect(pos, *m.areaSelectAnchor, cursor) {
+				validator := isValidChopTarget
+				bgStyle := areaSelectStyle
+				if m.areaSelectUnmarkMode {
+					validator = isValidUnmarkChopTarget
+					bgStyle = areaUnselectStyle
+				}
+				if validator(pos, m.gameMap) {
+					return bgStyle.Render(" " + sym + " ")
+				}
+			}
+		}
+
+		// Highlight tree tiles already marked for chopping
+		if m.gameMap.IsMarkedForChopping(pos) && !isCursor {
+			return markedForChoppingStyle.Render(" " + sym + " ")
+		}
+	}
+
+	// Campfire marks during buildCampfire step 2; other construction marks show grey
+	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildCampfire" {
+		if mark, ok := m.gameMap.GetConstructionMark(pos); ok && !isCursor {
+			markStyle := markedForConstructionStyle
+			if mark.ConstructKind != "campfire" {
+				markStyle = fenceMarkStyle
+			}
+			hasEntity := m.gameMap.CharacterAt(pos) != nil || m.gameMap.ItemAt(pos) != nil || m.gameMap.FeatureAt(pos) != nil
+			if hasEntity {
+				bg := markStyle.Render(" ")
+				return bg + sym + bg
+			}
+			padded := " " + sym + " "
+			if fill != "" {
+				padded = fill + sym + fill
+			}
+			return markStyle.Render(padded)
+		}
+	}
+
 	// Hut footprint preview and marks during buildHut step 2
 	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildHut" {
 		// Unmark mode: no footprint preview — only highlight existing marks red when cursor is on one
@@ -828,21 +1146,85 @@ func (m Model) renderCell(x, y int) string {
 	if isCursor {
 		return "[" + sym + "]"
 	}
-	if leftFill != "" || rightFill != "" {
-		l := leftFill
-		r := rightFill
-		if l == "" {
-			l = " "
+	left, right := " ", " "
+	switch {
+	case leftFill != "" || rightFill != "":
+		if leftFill != "" {
+			left = leftFill
+		}
+		if rightFill != "" {
+			right = rightFill
 		}
-		if r == "" {
-			r = " "
+	case suffix != "":
+		if fill != "" {
+			left = fill
 		}
-		return l + sym + r
+		right = suffix
+	case fill != "":
+		left, right = fill, fill
+	}
+
+	// Between dusk and dawn, bare ground and padding take on the time-of-day tint;
+	// by day, the weather's. Lit campfires keep the dark away from the tiles around them.
+	tint, ok := m.mapTint()
+	if system.NearLitCampfire(m.gameMap, pos) {
+		tint, ok = m.weatherTint()
 	}
-	if fill != "" {
-		return fill + sym + fill
+	if ok {
+		left, sym, right = tintBlank(tint, left), tintBlank(tint, sym), tintBlank(tint, right)
 	}
-	return " " + sym + " "
+	return left + sym + right
+}
+
+// mapTint returns the tint for bare ground: darkness takes precedence over the weather
+func (m Model) mapTint() (lipgloss.Style, bool) {
+	if tint, ok := m.timeOfDayTint(); ok {
+		return tint, true
+	}
+	return m.weatherTint()
+}
+
+// timeOfDayTint returns the map tint for the current time of day, or false in full daylight
+func (m Model) timeOfDayTint() (lipgloss.Style, bool) {
+	switch system.TimeOfDayAt(m.elapsedGameTime) {
+	case system.TimeDawn, system.TimeDusk:
+		return twilightStyle, true
+	case system.TimeNight:
+		return nightStyle, true
+	}
+	return lipgloss.Style{}, false
+}
+
+// weatherTint returns the map tint for the current weather, or false under clear skies
+func (m Model) weatherTint() (lipgloss.Style, bool) {
+	switch m.gameMap.Weather() {
+	case game.WeatherRain:
+		return rainStyle, true
+	case game.WeatherStorm:
+		return stormStyle, true
+	case game.WeatherDrought:
+		return droughtStyle, true
+	}
+	return lipgloss.Style{}, false
+}
+
+// tintBlank renders s with the tint's background if it is a blank cell part, leaving styled parts alone
+func tintBlank(tint lipgloss.Style, s string) string {
+	if s != " " {
+		return s
+	}
+	return tint.Render(s)
+}
+
+// colorSuffix returns the letter naming c when the render profile has no color, or "" otherwise
+func colorSuffix(c types.Color) string {
+	if !showsColorLetters() {
+		return ""
+	}
+	if letter, ok := colorLetters[c]; ok {
+		return string(letter)
+	}
+	return ""
 }
 
 // styledSymbol returns a colored symbol for an entity
@@ -916,61 +1298,113 @@ func (m Model) styledSymbol(e entity.Entity) string {
 	case *entity.Construct:
 		style := colorToStyle(v.MaterialColor)
 		return style.Render(sym)
+
+	case *entity.Creature:
+		// Sleeping creatures flash between their symbol and z, like characters
+		if v.IsSleeping && m.flashIndex%2 == 1 {
+			return sleepingStyle.Render("z")
+		}
+		return colorToStyle(v.Def().Color).Render(sym)
 	}
 
 	return sym
 }
 
+// conditionLine renders remaining durability, colored like a need tier as it wears down
+func (m Model) conditionLine(d *entity.Durability) string {
+	pct := d.Percent()
+	var tier int
+	switch {
+	case pct >= 75:
+		tier = entity.TierNone
+	case pct >= 50:
+		tier = entity.TierMild
+	case pct >= 25:
+		tier = entity.TierModerate
+	case pct >= 10:
+		tier = entity.TierSevere
+	default:
+		tier = entity.TierCrisis
+	}
+	if m.testCfg.Debug {
+		return i18n.T("ui.condition_label") + colorByTier(i18n.T("ui.condition_value", pct, d.Current, d.Max), tier)
+	}
+	return i18n.T("ui.condition_label") + colorByTier(i18n.T("ui.percent", pct), tier)
+}
+
+// regionLine returns the details annotation for a tile inside an enclosed region, or ""
+func (m Model) regionLine(pos types.Position) string {
+	region := m.gameMap.RegionOf(pos)
+	if region == nil {
+		return ""
+	}
+	label := i18n.T("ui.in_garden_enclosure")
+	switch region.Kind {
+	case game.RegionHutInterior:
+		label = i18n.T("ui.in_hut_interior")
+	case game.RegionTreeHollow:
+		label = i18n.T("ui.in_tree_hollow")
+	}
+	if m.testCfg.Debug {
+		label = i18n.T("ui.region_debug", label, region.ID, len(region.Tiles))
+	}
+	return " " + label
+}
+
+// biomeLabel returns the display name of a biome
+func biomeLabel(biome game.Biome) string {
+	switch biome {
+	case game.BiomeWetland:
+		return i18n.T("biome.wetland")
+	case game.BiomeForestEdge:
+		return i18n.T("biome.forest_edge")
+	case game.BiomeClayFlats:
+		return i18n.T("biome.clay_flats")
+	default:
+		return i18n.T("biome.meadow")
+	}
+}
+
+// terrainPresetLabel returns the display name of a terrain preset
+func terrainPresetLabel(id string) string {
+	switch id {
+	case "wetlands":
+		return i18n.T("terrain.wetlands")
+	case "dry":
+		return i18n.T("terrain.dry")
+	case "rugged":
+		return i18n.T("terrain.rugged")
+	default:
+		return i18n.T("terrain.balanced")
+	}
+}
+
+// treeTileLabel returns the display name of a tree tile
+func treeTileLabel(tile game.TreeTile) string {
+	switch tile {
+	case game.TreeLivewood:
+		return i18n.T("ui.tree_livewood")
+	case game.TreeHeartwood:
+		return i18n.T("ui.tree_heartwood")
+	case game.TreeHollow:
+		return i18n.T("ui.tree_hollow")
+	default:
+		return i18n.T("ui.tree_opening")
+	}
+}
+
 // colorToStyle maps a types.Color to the corresponding lipgloss style
 func colorToStyle(c types.Color) lipgloss.Style {
-	switch c {
-	case types.ColorRed:
-		return redStyle
-	case types.ColorBlue:
-		return blueStyle
-	case types.ColorBrown:
-		return brownStyle
-	case types.ColorWhite:
-		return whiteStyle
-	case types.ColorOrange:
-		return orangeStyle
-	case types.ColorYellow:
-		return yellowStyle
-	case types.ColorPurple:
-		return purpleStyle
-	case types.ColorTan:
-		return tanStyle
-	case types.ColorPink:
-		return pinkStyle
-	case types.ColorBlack:
-		return blackStyle
-	case types.ColorGreen:
-		return greenStyle
-	case types.ColorPalePink:
-		return palePinkStyle
-	case types.ColorPaleYellow:
-		return paleYellowStyle
-	case types.ColorSilver:
-		return silverStyle
-	case types.ColorGray:
-		return grayStyle
-	case types.ColorLavender:
-		return lavenderStyle
-	case types.ColorPaleGreen:
-		return paleGreenStyle
-	case types.ColorEarthy:
-		return earthyStyle
-	case types.ColorTerracotta:
-		return terracottaStyle
-	default:
-		return whiteStyle
+	if style, ok := itemStyles[c]; ok {
+		return style
 	}
+	return itemStyles[types.ColorWhite]
 }
 
 // renderDetails renders the details sidebar
 func (m Model) renderDetails() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("       DETAILS"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.details")), "")
 
 	// Check for character first, then item, then feature
 	cursorPos := types.Position{X: m.cursorX, Y: m.cursorY}
@@ -986,40 +1420,66 @@ func (m Model) renderDetails() string {
 	waterType := m.gameMap.WaterAt(cursorPos)
 
 	if e == nil && item == nil && feature == nil && construct == nil && waterType == game.WaterNone {
-		if m.gameMap.IsClay(cursorPos) {
-			lines = append(lines, " Type: "+clayStyle.Render("Clay deposit"))
+		if tree := m.gameMap.TreeAt(cursorPos); tree != game.TreeNone {
+			lines = append(lines, i18n.T("ui.type")+livewoodStyle.Render(treeTileLabel(tree)))
+			if tree.IsSolid() {
+				lines = append(lines, i18n.T("ui.not_passable"))
+			}
+			if m.gameMap.IsMarkedForCarving(cursorPos) {
+				lines = append(lines, " "+markedForCarvingStyle.Render(i18n.T("ui.marked_for_carving")))
+			}
+			if m.gameMap.IsMarkedForChopping(cursorPos) {
+				lines = append(lines, " "+markedForChoppingStyle.Render(i18n.T("ui.marked_for_chopping")))
+			}
+		} else if m.gameMap.IsOutcrop(cursorPos) {
+			lines = append(lines, i18n.T("ui.type")+outcropStyle.Render(i18n.T("ui.outcrop")))
+			lines = append(lines, i18n.T("ui.not_passable"))
+		} else if m.gameMap.IsClay(cursorPos) {
+			lines = append(lines, i18n.T("ui.type")+clayStyle.Render(i18n.T("ui.clay_deposit")))
 		} else if m.gameMap.IsTilled(cursorPos) {
-			lines = append(lines, " Type: "+growingStyle.Render("Tilled soil"))
+			lines = append(lines, i18n.T("ui.type")+growingStyle.Render(i18n.T("ui.tilled_soil")))
+			lines = append(lines, m.soilLines(cursorPos)...)
 		} else if m.gameMap.IsMarkedForTilling(cursorPos) {
-			lines = append(lines, " Type: "+growingStyle.Render("Marked for tilling"))
+			lines = append(lines, i18n.T("ui.type")+growingStyle.Render(i18n.T("ui.marked_for_tilling")))
 		} else if mark, ok := m.gameMap.GetConstructionMark(cursorPos); ok {
-			lines = append(lines, " Type: "+markedForConstructionStyle.Render(constructionMarkLabel(mark)))
+			lines = append(lines, i18n.T("ui.type")+markedForConstructionStyle.Render(constructionMarkLabel(mark)))
+		} else if m.gameMap.IsMarkedForDigging(cursorPos) {
+			lines = append(lines, i18n.T("ui.type")+markedForDiggingStyle.Render(i18n.T("ui.marked_for_digging")))
 		} else {
-			lines = append(lines, " Type: Empty")
+			lines = append(lines, i18n.T("ui.type_empty"))
+		}
+		if m.gameMap.IsDriedPondBed(cursorPos) {
+			lines = append(lines, " "+hintStyle.Render(i18n.T("ui.dried_pond_bed")))
 		}
 		if m.gameMap.IsManuallyWatered(cursorPos) {
-			label := "Watered"
+			label := i18n.T("ui.watered")
 			if m.testCfg.Debug {
-				label = fmt.Sprintf("Watered (%.0fs)", m.gameMap.WateredTimer(cursorPos))
+				label = i18n.T("ui.watered_timer", m.gameMap.WateredTimer(cursorPos))
 			}
 			lines = append(lines, " "+waterStyle.Render(label))
 		} else if m.gameMap.IsWet(cursorPos) {
-			lines = append(lines, " "+waterStyle.Render("Wet"))
+			lines = append(lines, " "+waterStyle.Render(i18n.T("ui.wet")))
+		}
+		if line := m.regionLine(cursorPos); line != "" {
+			lines = append(lines, line)
+		}
+		if m.gameMap.HasBiomes() {
+			lines = append(lines, i18n.T("ui.biome", biomeLabel(m.gameMap.BiomeAt(cursorPos))))
 		}
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
 	} else if char, ok := e.(*entity.Character); ok {
 		// Show name with edit UI if editing this character
 		if m.editingCharacterName && m.editingCharacterID == char.ID {
-			lines = append(lines, fmt.Sprintf(" Name: %s_", m.editingNameBuffer))
-			lines = append(lines, " [Enter=save, Esc=cancel]")
+			lines = append(lines, i18n.T("ui.name_editing", m.editingNameBuffer))
+			lines = append(lines, i18n.T("ui.enter_save_esc_cancel"))
 		} else {
-			lines = append(lines, fmt.Sprintf(" Name: %s", char.Name))
+			lines = append(lines, i18n.T("ui.name", char.Name))
 		}
-		lines = append(lines, " Type: Character")
+		lines = append(lines, i18n.T("ui.type_character"))
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
 
 		// Stats with tier-based coloring
@@ -1027,94 +1487,145 @@ func (m Model) renderDetails() string {
 		hungerLevel := colorByTier(char.HungerLevel(), char.HungerTier())
 		thirstLevel := colorByTier(char.ThirstLevel(), char.ThirstTier())
 		energyLevel := colorByTier(char.EnergyLevel(), char.EnergyTier())
+		warmthLevel := colorByTier(char.WarmthLevel(), char.WarmthTier())
 		moodLevel := colorByTier(char.MoodLevel(), char.MoodTier())
 
 		if m.testCfg.Debug {
 			lines = append(lines, "",
-				fmt.Sprintf(" Health: %d/100 (%s)", int(char.Health), healthLevel),
-				fmt.Sprintf(" Hunger: %d/100 (%s)", int(char.Hunger), hungerLevel),
-				fmt.Sprintf(" Thirst: %d/100 (%s)", int(char.Thirst), thirstLevel),
-				fmt.Sprintf(" Energy: %d/100 (%s)", int(char.Energy), energyLevel),
-				fmt.Sprintf(" Mood: %d/100 (%s)", int(char.Mood), moodLevel),
+				i18n.T("ui.health_value", int(char.Health), healthLevel),
+				i18n.T("ui.hunger_value", int(char.Hunger), hungerLevel),
+				i18n.T("ui.thirst_value", int(char.Thirst), thirstLevel),
+				i18n.T("ui.energy_value", int(char.Energy), energyLevel),
+				i18n.T("ui.warmth_value", int(char.Warmth), warmthLevel),
+				i18n.T("ui.mood_value", int(char.Mood), moodLevel),
 			)
 		} else {
 			lines = append(lines, "",
-				fmt.Sprintf(" Health: %s", healthLevel),
-				fmt.Sprintf(" Hunger: %s", hungerLevel),
-				fmt.Sprintf(" Thirst: %s", thirstLevel),
-				fmt.Sprintf(" Energy: %s", energyLevel),
-				fmt.Sprintf(" Mood: %s", moodLevel),
+				i18n.T("ui.health", healthLevel),
+				i18n.T("ui.hunger", hungerLevel),
+				i18n.T("ui.thirst", thirstLevel),
+				i18n.T("ui.energy", energyLevel),
+				i18n.T("ui.warmth", warmthLevel),
+				i18n.T("ui.mood", moodLevel),
 			)
 		}
 
 		// Speed info (debug only)
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Speed: %d/100", char.EffectiveSpeed()))
+			lines = append(lines, i18n.T("ui.speed_value", char.EffectiveSpeed()))
 		}
 
 		// Status with color - supports multiple simultaneous statuses
 		var statusLine string
 		if char.IsDead {
-			statusLine = " Status: " + deadStyle.Render("DEAD")
+			statusLine = i18n.T("ui.status") + deadStyle.Render(i18n.T("ui.dead"))
 		} else {
 			var statusParts []string
 
 			if char.IsSleeping {
-				location := "ground"
+				location := i18n.T("ui.ground")
 				if char.AtBed {
-					location = "bed"
+					location = i18n.T("ui.bed")
 				}
-				statusParts = append(statusParts, sleepingStyle.Render(fmt.Sprintf("SLEEPING (%s)", location)))
+				statusParts = append(statusParts, sleepingStyle.Render(i18n.T("ui.sleeping", location)))
 			}
 			if char.IsFrustrated {
-				statusParts = append(statusParts, frustratedStyle.Render(fmt.Sprintf("FRUSTRATED (%.0fs)", char.FrustrationTimer)))
+				statusParts = append(statusParts, frustratedStyle.Render(i18n.T("ui.frustrated", char.FrustrationTimer)))
 			}
 			if char.Poisoned {
-				statusParts = append(statusParts, poisonedStyle.Render(fmt.Sprintf("POISONED (%.0fs)", char.PoisonTimer)))
+				statusParts = append(statusParts, poisonedStyle.Render(i18n.T("ui.poisoned", char.PoisonTimer)))
+			}
+			if char.IsCold {
+				statusParts = append(statusParts, waterStyle.Render(i18n.T("ui.cold")))
 			}
 			if char.IsInCrisis() {
-				statusParts = append(statusParts, crisisStyle.Render("IN CRISIS"))
+				statusParts = append(statusParts, crisisStyle.Render(i18n.T("ui.in_crisis")))
 			}
 
 			if len(statusParts) == 0 {
-				statusLine = " Status: Normal"
+				statusLine = i18n.T("ui.status_normal")
 			} else {
-				statusLine = " Status: " + strings.Join(statusParts, ", ")
+				statusLine = i18n.T("ui.status") + strings.Join(statusParts, ", ")
 			}
 		}
 		lines = append(lines, statusLine)
 
 		// Activity with optional debug progress
-		activityLine := fmt.Sprintf(" Activity: %s", char.CurrentActivity)
+		activityLine := i18n.T("ui.activity", char.CurrentActivity)
 		if m.testCfg.Debug && char.ActionProgress > 0 {
-			activityLine = fmt.Sprintf(" Activity: %s (%.1fs)", char.CurrentActivity, char.ActionProgress)
+			activityLine = i18n.T("ui.activity_progress", char.CurrentActivity, char.ActionProgress)
 		}
 		lines = append(lines, activityLine)
 
 		// Show assigned order if any
 		if char.AssignedOrderID != 0 {
 			if order := m.findOrderByID(char.AssignedOrderID); order != nil {
-				orderLine := fmt.Sprintf(" Order: %s [%s]", order.DisplayName(), order.StatusDisplay())
+				orderLine := i18n.T("ui.order", order.DisplayName(), order.StatusDisplay())
 				lines = append(lines, orderLine)
 			}
 		}
 
 		if m.following == char {
-			lines = append(lines, "", highlightStyle.Render(" [FOLLOWING]"), " Press F to unfollow")
+			lines = append(lines, "", highlightStyle.Render(i18n.T("ui.following")), i18n.T("ui.press_f_to_unfollow"))
 		} else {
-			lines = append(lines, "", " Press F to follow")
+			lines = append(lines, "", i18n.T("ui.press_f_to_follow"))
 		}
-		lines = append(lines, " P: Preferences  K: Knowledge  I: Inventory")
+		lines = append(lines, i18n.T("ui.panel_hints"))
 		if m.showKnowledgePanel || m.showInventoryPanel || m.showPreferencesPanel {
-			lines = append(lines, " L: Log")
+			lines = append(lines, i18n.T("ui.l_log"))
 		}
 		if !m.editingCharacterName {
-			lines = append(lines, " Press E to edit name")
+			lines = append(lines, i18n.T("ui.press_e_to_edit_name"))
 		}
 		// Show items on the same tile as the character
 		if len(allItems) > 0 {
 			lines = append(lines, "")
-			lines = append(lines, " On ground:")
+			lines = append(lines, i18n.T("ui.on_ground"))
+			for _, groundItem := range allItems {
+				lines = append(lines, "   "+groundItem.Description())
+			}
+		}
+
+	} else if creature, ok := e.(*entity.Creature); ok {
+		lines = append(lines, i18n.T("ui.type_creature"), i18n.T("ui.kind", creature.DisplayName()))
+		if m.testCfg.Debug {
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
+		}
+
+		hungerLevel := colorByTier(creature.HungerLevel(), creature.HungerTier())
+		thirstLevel := colorByTier(creature.ThirstLevel(), creature.ThirstTier())
+		energyLevel := colorByTier(creature.EnergyLevel(), creature.EnergyTier())
+		if m.testCfg.Debug {
+			lines = append(lines, "",
+				i18n.T("ui.hunger_value", int(creature.Hunger), hungerLevel),
+				i18n.T("ui.thirst_value", int(creature.Thirst), thirstLevel),
+				i18n.T("ui.energy_value", int(creature.Energy), energyLevel),
+			)
+		} else {
+			lines = append(lines, "",
+				i18n.T("ui.hunger", hungerLevel),
+				i18n.T("ui.thirst", thirstLevel),
+				i18n.T("ui.energy", energyLevel),
+			)
+		}
+
+		if creature.IsSleeping {
+			lines = append(lines, i18n.T("ui.status")+sleepingStyle.Render(i18n.T("ui.sleeping", i18n.T("ui.ground"))))
+		} else {
+			lines = append(lines, i18n.T("ui.status_normal"))
+		}
+		activityLine := i18n.T("ui.activity", creature.CurrentActivity)
+		if m.testCfg.Debug && creature.ActionProgress > 0 {
+			activityLine = i18n.T("ui.activity_progress", creature.CurrentActivity, creature.ActionProgress)
+		}
+		lines = append(lines, activityLine)
+		if m.testCfg.Debug && !creature.Leaving {
+			lines = append(lines, i18n.T("ui.leaves_in", creature.StayTimer))
+		}
+
+		// Show items on the same tile as the creature
+		if len(allItems) > 0 {
+			lines = append(lines, "", i18n.T("ui.on_ground"))
 			for _, groundItem := range allItems {
 				lines = append(lines, "   "+groundItem.Description())
 			}
@@ -1122,83 +1633,86 @@ func (m Model) renderDetails() string {
 
 	} else if item != nil {
 		if item.Plant != nil && item.Plant.IsSprout {
-			lines = append(lines, " Type: "+growingStyle.Render("Sprout"))
+			lines = append(lines, i18n.T("ui.type")+growingStyle.Render(i18n.T("ui.sprout")))
 		} else {
-			lines = append(lines, " Type: Item")
+			lines = append(lines, i18n.T("ui.type_item"))
 		}
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
 		// Show Name for crafted items
 		if item.Name != "" {
-			lines = append(lines, fmt.Sprintf(" Name: %s", item.Name))
+			lines = append(lines, i18n.T("ui.name", item.Name))
 		}
 		kindLabel := item.ItemType
 		if item.Kind != "" {
 			kindLabel = item.Kind
 		}
 		if item.Plant != nil && item.Plant.IsSprout {
-			kindLabel += " sprout"
+			kindLabel += i18n.T("ui.sprout_suffix")
 		}
 		lines = append(lines,
-			fmt.Sprintf(" Kind: %s", kindLabel),
-			fmt.Sprintf(" Color: %s", item.Color),
+			i18n.T("ui.kind", kindLabel),
+			i18n.T("ui.color", colorLabel(item.Color)),
 		)
 		if item.Material != "" {
-			lines = append(lines, fmt.Sprintf(" Material: %s", item.Material))
+			lines = append(lines, i18n.T("ui.material", item.Material))
+		}
+		if item.Durability != nil {
+			lines = append(lines, m.conditionLine(item.Durability))
 		}
 		// Show bundle count for non-growing bundled items
 		if item.BundleCount > 0 && (item.Plant == nil || !item.Plant.IsGrowing) {
 			maxSize := config.MaxBundleSize[item.ItemType]
 			if maxSize > 0 {
-				lines = append(lines, fmt.Sprintf(" Bundle: %d/%d", item.BundleCount, maxSize))
+				lines = append(lines, i18n.T("ui.bundle", item.BundleCount, maxSize))
 			}
 		}
 		// Show Pattern/Texture if item has them (works for both natural and crafted items)
 		if item.Pattern != "" {
-			lines = append(lines, fmt.Sprintf(" Pattern: %s", item.Pattern))
+			lines = append(lines, i18n.T("ui.pattern", attributeLabel("pattern", string(item.Pattern))))
 		}
 		if item.Texture != "" {
-			lines = append(lines, fmt.Sprintf(" Texture: %s", item.Texture))
+			lines = append(lines, i18n.T("ui.texture", attributeLabel("texture", string(item.Texture))))
 		}
 		// Show Poisonous/Healing only for edible items
 		if item.IsEdible() {
-			poison := "No"
+			poison := i18n.T("ui.no")
 			if item.IsPoisonous() {
-				poison = redStyle.Render("Yes")
+				poison = redStyle.Render(i18n.T("ui.yes"))
 			}
-			healing := "No"
+			healing := i18n.T("ui.no")
 			if item.IsHealing() {
-				healing = optimalStyle.Render("Yes")
+				healing = optimalStyle.Render(i18n.T("ui.yes"))
 			}
 			lines = append(lines,
-				fmt.Sprintf(" Poisonous: %s", poison),
-				fmt.Sprintf(" Healing: %s", healing),
+				i18n.T("ui.poisonous", poison),
+				i18n.T("ui.healing", healing),
 			)
 		}
 		// Show Growing status for plants that can spread
 		if item.Plant != nil && item.Plant.IsGrowing {
-			lines = append(lines, " "+growingStyle.Render("Growing"))
+			lines = append(lines, " "+growingStyle.Render(i18n.T("ui.growing")))
 		}
 		// Show seed availability indicator for extractable plants
 		if config.ExtractableTypes[item.ItemType] && item.Plant != nil && item.Plant.IsGrowing && !item.Plant.IsSprout {
 			if item.Plant.SeedTimer <= 0 {
-				lines = append(lines, " "+tilledStyle.Render("Gone to seed"))
+				lines = append(lines, " "+tilledStyle.Render(i18n.T("ui.gone_to_seed")))
 			} else if m.testCfg.Debug {
-				lines = append(lines, fmt.Sprintf(" Seed cooldown: %.0fs", item.Plant.SeedTimer))
+				lines = append(lines, i18n.T("ui.seed_cooldown", item.Plant.SeedTimer))
 			}
 		}
 		// Show Plantable status
 		if item.Plantable {
-			lines = append(lines, " "+growingStyle.Render("Plantable"))
+			lines = append(lines, " "+growingStyle.Render(i18n.T("ui.plantable")))
 		}
 		// Show vessel contents if this is a container
 		if item.Container != nil {
 			lines = append(lines, "")
 			if len(item.Container.Contents) == 0 {
-				lines = append(lines, " Contents: (empty)")
+				lines = append(lines, i18n.T("ui.contents_empty"))
 			} else {
-				lines = append(lines, " Contents:")
+				lines = append(lines, i18n.T("ui.contents"))
 				for _, stack := range item.Container.Contents {
 					if stack.Variety != nil {
 						stackSize := config.GetStackSize(stack.Variety.ItemType)
@@ -1215,29 +1729,33 @@ func (m Model) renderDetails() string {
 		}
 		// Show terrain annotations
 		if m.gameMap.IsClay(cursorPos) {
-			lines = append(lines, " "+clayStyle.Render("Clay deposit"))
+			lines = append(lines, " "+clayStyle.Render(i18n.T("ui.clay_deposit")))
 		}
 		if m.gameMap.IsTilled(cursorPos) {
-			lines = append(lines, " "+growingStyle.Render("On tilled soil"))
+			lines = append(lines, " "+growingStyle.Render(i18n.T("ui.on_tilled_soil")))
+			lines = append(lines, m.soilLines(cursorPos)...)
 		} else if m.gameMap.IsMarkedForTilling(cursorPos) {
-			lines = append(lines, " "+growingStyle.Render("Marked for tilling"))
+			lines = append(lines, " "+growingStyle.Render(i18n.T("ui.marked_for_tilling")))
 		}
 		if mark, ok := m.gameMap.GetConstructionMark(cursorPos); ok {
 			lines = append(lines, " "+markedForConstructionStyle.Render(constructionMarkLabel(mark)))
 		}
+		if line := m.regionLine(cursorPos); line != "" {
+			lines = append(lines, line)
+		}
 		if m.gameMap.IsManuallyWatered(cursorPos) {
-			label := "Watered"
+			label := i18n.T("ui.watered")
 			if m.testCfg.Debug {
-				label = fmt.Sprintf("Watered (%.0fs)", m.gameMap.WateredTimer(cursorPos))
+				label = i18n.T("ui.watered_timer", m.gameMap.WateredTimer(cursorPos))
 			}
 			lines = append(lines, " "+waterStyle.Render(label))
 		} else if m.gameMap.IsWet(cursorPos) {
-			lines = append(lines, " "+waterStyle.Render("Wet"))
+			lines = append(lines, " "+waterStyle.Render(i18n.T("ui.wet")))
 		}
 		// Show additional items stacked at this position
 		if len(allItems) > 1 {
 			lines = append(lines, "")
-			lines = append(lines, " Also here:")
+			lines = append(lines, i18n.T("ui.also_here"))
 			for _, other := range allItems[1:] {
 				lines = append(lines, "   "+other.Description())
 			}
@@ -1245,34 +1763,60 @@ func (m Model) renderDetails() string {
 	} else if construct != nil {
 		lines = append(lines, fmt.Sprintf(" %s", construct.DisplayName()))
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
 		lines = append(lines, fmt.Sprintf(" %s", construct.Description()))
+		if showsColorLetters() && construct.MaterialColor != "" {
+			lines = append(lines, i18n.T("ui.color", colorLabel(construct.MaterialColor)))
+		}
+		if construct.Durability != nil {
+			lines = append(lines, m.conditionLine(construct.Durability))
+		}
+		if construct.IsCampfire() {
+			if construct.IsLit() {
+				lines = append(lines, i18n.T("ui.campfire_fuel", int(construct.Fuel*100/config.CampfireMaxFuel)))
+			} else {
+				lines = append(lines, " "+hintStyle.Render(i18n.T("ui.campfire_out")))
+			}
+		}
 		if !construct.Passable {
-			lines = append(lines, " Not passable")
+			lines = append(lines, i18n.T("ui.not_passable"))
+		}
+		if m.gameMap.IsMarkedForDeconstruction(cursorPos) {
+			lines = append(lines, " "+markedForDeconstructStyle.Render(i18n.T("ui.marked_for_deconstruction")))
 		}
 	} else if waterType != game.WaterNone {
-		lines = append(lines, " Type: Water")
+		lines = append(lines, i18n.T("ui.type_water"))
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
 		switch waterType {
 		case game.WaterSpring:
-			lines = append(lines, " Kind: spring")
+			lines = append(lines, i18n.T("ui.kind_spring"))
 		case game.WaterPond:
-			lines = append(lines, " Kind: pond")
+			lines = append(lines, i18n.T("ui.kind_pond"))
+		case game.WaterRiver:
+			lines = append(lines, i18n.T("ui.kind_river"))
+		case game.WaterChannel:
+			lines = append(lines, i18n.T("ui.kind_channel"))
+			if m.gameMap.ChannelNeedsUpkeep(cursorPos) {
+				lines = append(lines, " "+hintStyle.Render(i18n.T("ui.channel_silting")))
+			}
 		}
-		lines = append(lines, " Use: Drinking")
+		lines = append(lines, i18n.T("ui.use_drinking"))
 	} else if feature != nil {
-		lines = append(lines, " Type: Feature")
+		lines = append(lines, i18n.T("ui.type_feature"))
 		if m.testCfg.Debug {
-			lines = append(lines, fmt.Sprintf(" Pos: (%d, %d)", m.cursorX, m.cursorY))
+			lines = append(lines, i18n.T("ui.pos", m.cursorX, m.cursorY))
 		}
-		lines = append(lines, fmt.Sprintf(" Kind: %s", feature.Description()))
+		lines = append(lines, i18n.T("ui.kind", feature.Description()))
 		if feature.IsBed() {
-			lines = append(lines, " Use: Sleeping")
+			lines = append(lines, i18n.T("ui.use_sleeping"))
 		}
 	}
+	if m.gameMap.IsBurning(cursorPos) {
+		lines = append(lines, " "+fireStyle.Render(i18n.T("ui.burning")))
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -1280,13 +1824,13 @@ func (m Model) renderDetails() string {
 // renderActionLog renders the action log sidebar
 func (m Model) renderActionLog() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("       ACTION LOG"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.action_log")), "")
 
 	e := m.gameMap.EntityAt(types.Position{X: m.cursorX, Y: m.cursorY})
 	char, isChar := e.(*entity.Character)
 
 	if !isChar {
-		lines = append(lines, " Select character to", " view action log")
+		lines = append(lines, i18n.T("ui.select_character_to"), i18n.T("ui.view_action_log"))
 		return strings.Join(lines, "\n")
 	}
 
@@ -1296,10 +1840,7 @@ func (m Model) renderActionLog() string {
 	if !m.testCfg.Debug {
 		var filtered []system.Event
 		for _, event := range events {
-			if strings.Contains(event.Message, "Improved Mood") ||
-				strings.Contains(event.Message, "Worsened Mood") ||
-				strings.Contains(event.Message, "impacted health") ||
-				event.Message == "Collapsed from exhaustion!" {
+			if isDebugOnlyEvent(event) {
 				continue
 			}
 			filtered = append(filtered, event)
@@ -1308,7 +1849,7 @@ func (m Model) renderActionLog() string {
 	}
 
 	if len(events) == 0 {
-		lines = append(lines, " No events yet")
+		lines = append(lines, i18n.T("ui.no_events_yet"))
 		return strings.Join(lines, "\n")
 	}
 
@@ -1328,7 +1869,7 @@ func (m Model) renderActionLog() string {
 	}
 
 	if m.logScrollOffset > 0 {
-		lines = append(lines, fmt.Sprintf(" [Scrolled: -%d]", m.logScrollOffset))
+		lines = append(lines, i18n.T("ui.scrolled", m.logScrollOffset))
 	}
 
 	// Format and display events (most recent first)
@@ -1359,7 +1900,7 @@ func (m Model) renderActionLog() string {
 		}
 
 		// Color based on message content
-		coloredLine := colorLogMessage(line, message)
+		coloredLine := colorLogMessage(line, event)
 
 		// Highlight most recent (only if not already colored)
 		isNewest := (i == len(displayEvents)-1) && m.logScrollOffset == 0
@@ -1378,7 +1919,7 @@ func (m Model) renderActionLog() string {
 // renderCombinedLog renders the combined activity log for all characters
 func (m Model) renderCombinedLog() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("     ALL ACTIVITY"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.all_activity")), "")
 	lines = append(lines, m.renderActivityContent(false)...)
 	return strings.Join(lines, "\n")
 }
@@ -1387,14 +1928,14 @@ func (m Model) renderCombinedLog() string {
 func (m Model) viewFullScreenActivity() string {
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, titleStyle.Render("                  ALL ACTIVITY"))
+	lines = append(lines, titleStyle.Render(i18n.T("ui.all_activity_wide")))
 	lines = append(lines, "")
 	lines = append(lines, m.renderActivityContent(true)...)
 
 	// Footer with controls
 	lines = append(lines, "")
-	lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(
-		"PgUp/PgDn: Scroll | X: Collapse | S: Select Mode"))
+	lines = append(lines, hintStyle.Render(
+		i18n.T("ui.all_activity_hints")))
 
 	content := strings.Join(lines, "\n")
 	return borderStyle.Width(m.width - 4).Height(m.height - 4).Render(content)
@@ -1411,10 +1952,7 @@ func (m Model) renderActivityContent(expanded bool) []string {
 	if !m.testCfg.Debug {
 		var filtered []system.Event
 		for _, event := range events {
-			if strings.Contains(event.Message, "Improved Mood") ||
-				strings.Contains(event.Message, "Worsened Mood") ||
-				strings.Contains(event.Message, "impacted health") ||
-				event.Message == "Collapsed from exhaustion!" {
+			if isDebugOnlyEvent(event) {
 				continue
 			}
 			filtered = append(filtered, event)
@@ -1423,7 +1961,7 @@ func (m Model) renderActivityContent(expanded bool) []string {
 	}
 
 	if len(events) == 0 {
-		lines = append(lines, " No events yet")
+		lines = append(lines, i18n.T("ui.no_events_yet"))
 		return lines
 	}
 
@@ -1451,7 +1989,7 @@ func (m Model) renderActivityContent(expanded bool) []string {
 	}
 
 	if m.logScrollOffset > 0 {
-		lines = append(lines, fmt.Sprintf(" [Scrolled: -%d]", m.logScrollOffset))
+		lines = append(lines, i18n.T("ui.scrolled", m.logScrollOffset))
 	}
 
 	// Format and display events (most recent first)
@@ -1486,7 +2024,7 @@ func (m Model) renderActivityContent(expanded bool) []string {
 		}
 
 		// Color based on message content
-		coloredLine := colorLogMessage(line, message)
+		coloredLine := colorLogMessage(line, event)
 
 		// Highlight most recent (only if not already colored)
 		isNewest := (i == len(displayEvents)-1) && m.logScrollOffset == 0
@@ -1505,7 +2043,7 @@ func (m Model) renderActivityContent(expanded bool) []string {
 // renderKnowledgePanel renders the knowledge panel for the selected character
 func (m Model) renderKnowledgePanel() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("       KNOWLEDGE"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.knowledge")), "")
 
 	// Get character at cursor
 	if e := m.gameMap.EntityAt(types.Position{X: m.cursorX, Y: m.cursorY}); e != nil {
@@ -1521,7 +2059,7 @@ func (m Model) renderKnowledgePanel() string {
 
 			// Show facts section
 			if hasFacts {
-				lines = append(lines, " Facts:")
+				lines = append(lines, i18n.T("ui.facts"))
 				for _, k := range char.Knowledge {
 					lines = append(lines, "   "+k.Description())
 				}
@@ -1532,17 +2070,17 @@ func (m Model) renderKnowledgePanel() string {
 
 			// Show know-how section
 			if hasKnowHow {
-				lines = append(lines, " Knows how to:")
+				lines = append(lines, i18n.T("ui.knows_how_to"))
 				for _, activityID := range char.KnownActivities {
 					if activity, ok := entity.ActivityRegistry[activityID]; ok {
 						if activity.Category != "" {
-							if display, ok := categoryDisplayName[activity.Category]; ok {
-								lines = append(lines, "   "+display+": "+activity.Name)
+							if key, ok := categoryDisplayKeys[activity.Category]; ok {
+								lines = append(lines, "   "+i18n.T(key)+": "+activity.DisplayName())
 							} else {
-								lines = append(lines, "   "+activity.Category+": "+activity.Name)
+								lines = append(lines, "   "+activity.Category+": "+activity.DisplayName())
 							}
 						} else {
-							lines = append(lines, "   "+activity.Name)
+							lines = append(lines, "   "+activity.DisplayName())
 						}
 					}
 				}
@@ -1553,21 +2091,21 @@ func (m Model) renderKnowledgePanel() string {
 
 			// Show recipes section
 			if hasRecipes {
-				lines = append(lines, " Recipes:")
+				lines = append(lines, i18n.T("ui.recipes"))
 				for _, recipeID := range char.KnownRecipes {
 					if recipe, ok := entity.RecipeRegistry[recipeID]; ok {
-						lines = append(lines, "   "+recipe.Name)
+						lines = append(lines, "   "+recipe.DisplayName())
 					}
 				}
 			}
 		} else {
-			lines = append(lines, " Select a character")
+			lines = append(lines, i18n.T("ui.select_a_character"))
 		}
 	} else {
-		lines = append(lines, " Select a character")
+		lines = append(lines, i18n.T("ui.select_a_character"))
 	}
 
-	lines = append(lines, "", " K or Esc to return")
+	lines = append(lines, "", i18n.T("ui.k_or_esc_to_return"))
 
 	return strings.Join(lines, "\n")
 }
@@ -1575,15 +2113,15 @@ func (m Model) renderKnowledgePanel() string {
 // renderInventoryPanel renders the inventory panel for the selected character
 func (m Model) renderInventoryPanel() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("       INVENTORY"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.inventory")), "")
 
 	// Get character at cursor
 	if e := m.gameMap.EntityAt(types.Position{X: m.cursorX, Y: m.cursorY}); e != nil {
 		if char, ok := e.(*entity.Character); ok {
 			if len(char.Inventory) == 0 {
-				lines = append(lines, " Inventory: empty")
+				lines = append(lines, i18n.T("ui.inventory_empty"))
 			} else {
-				lines = append(lines, fmt.Sprintf(" Inventory: %d/%d slots", len(char.Inventory), entity.InventoryCapacity))
+				lines = append(lines, i18n.T("ui.inventory_slots", len(char.Inventory), entity.InventoryCapacity))
 				for i, item := range char.Inventory {
 					label := item.Description()
 					if attrs := inventoryItemAttrs(item); attrs != "" {
@@ -1594,7 +2132,7 @@ func (m Model) renderInventoryPanel() string {
 					// Show vessel contents if this item is a vessel
 					if item.Container != nil {
 						if len(item.Container.Contents) == 0 {
-							lines = append(lines, "      (empty)")
+							lines = append(lines, i18n.T("ui.container_empty"))
 						} else {
 							for _, stack := range item.Container.Contents {
 								if stack.Variety != nil {
@@ -1613,13 +2151,13 @@ func (m Model) renderInventoryPanel() string {
 				}
 			}
 		} else {
-			lines = append(lines, " Select a character")
+			lines = append(lines, i18n.T("ui.select_a_character"))
 		}
 	} else {
-		lines = append(lines, " Select a character")
+		lines = append(lines, i18n.T("ui.select_a_character"))
 	}
 
-	lines = append(lines, "", " I or Esc to return")
+	lines = append(lines, "", i18n.T("ui.i_or_esc_to_return"))
 
 	return strings.Join(lines, "\n")
 }
@@ -1629,16 +2167,16 @@ func (m Model) renderInventoryPanel() string {
 func inventoryItemAttrs(item *entity.Item) string {
 	var parts []string
 	if item.IsEdible() {
-		parts = append(parts, "Edible")
+		parts = append(parts, i18n.T("ui.edible"))
 	}
 	if item.IsPoisonous() {
-		parts = append(parts, "Poison")
+		parts = append(parts, i18n.T("ui.poison"))
 	}
 	if item.IsHealing() {
-		parts = append(parts, "Healing")
+		parts = append(parts, i18n.T("ui.healing_tag"))
 	}
 	if item.Plantable {
-		parts = append(parts, "Plantable")
+		parts = append(parts, i18n.T("ui.plantable"))
 	}
 	return strings.Join(parts, ", ")
 }
@@ -1646,13 +2184,13 @@ func inventoryItemAttrs(item *entity.Item) string {
 // renderPreferencesPanel renders the preferences panel for the selected character
 func (m Model) renderPreferencesPanel(panelHeight int) string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("      PREFERENCES"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.preferences")), "")
 
 	// Get character at cursor
 	if e := m.gameMap.EntityAt(types.Position{X: m.cursorX, Y: m.cursorY}); e != nil {
 		if char, ok := e.(*entity.Character); ok {
 			if len(char.Preferences) == 0 {
-				lines = append(lines, " No preferences yet")
+				lines = append(lines, i18n.T("ui.no_preferences_yet"))
 			} else {
 				// Calculate display range for scrolling
 				maxDisplay := panelHeight - 5 // Account for header, footer, borders
@@ -1673,15 +2211,15 @@ func (m Model) renderPreferencesPanel(panelHeight int) string {
 
 				// Show scroll indicator if scrolled
 				if m.logScrollOffset > 0 {
-					lines = append(lines, fmt.Sprintf(" [Scrolled: -%d]", m.logScrollOffset))
+					lines = append(lines, i18n.T("ui.scrolled", m.logScrollOffset))
 				}
 
 				// Display preferences in range
 				for i := startIdx; i < endIdx; i++ {
 					pref := char.Preferences[i]
-					verb := "Likes"
+					verb := i18n.T("ui.likes")
 					if !pref.IsPositive() {
-						verb = "Dislikes"
+						verb = i18n.T("ui.dislikes")
 					}
 					line := fmt.Sprintf("   %s %s", verb, pref.Description())
 					// Color based on valence
@@ -1694,17 +2232,17 @@ func (m Model) renderPreferencesPanel(panelHeight int) string {
 
 				// Show hint if more items exist
 				if total > maxDisplay {
-					lines = append(lines, fmt.Sprintf(" (%d total, PgUp/PgDn to scroll)", total))
+					lines = append(lines, i18n.T("ui.preferences_total", total))
 				}
 			}
 		} else {
-			lines = append(lines, " Select a character")
+			lines = append(lines, i18n.T("ui.select_a_character"))
 		}
 	} else {
-		lines = append(lines, " Select a character")
+		lines = append(lines, i18n.T("ui.select_a_character"))
 	}
 
-	lines = append(lines, "", " P or Esc to return")
+	lines = append(lines, "", i18n.T("ui.p_or_esc_to_return"))
 
 	return strings.Join(lines, "\n")
 }
@@ -1730,67 +2268,150 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 	// Add hints at top so they're always visible
 	if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "tillSoil" {
 		// Area selection hints
-		modeName := "Mark"
+		modeName := i18n.T("ui.mark")
 		if m.areaSelectUnmarkMode {
-			modeName = "Unmark"
+			modeName = i18n.T("ui.unmark")
 		}
-		lines = append(lines, indent+growingStyle.Render("Till Soil: "+modeName), "")
+		lines = append(lines, indent+growingStyle.Render(i18n.T("ui.till_soil")+modeName), "")
 		if m.areaSelectAnchor == nil {
-			lines = append(lines, indent+"arrows: move cursor")
-			lines = append(lines, indent+"p: set anchor")
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
 		} else {
-			lines = append(lines, indent+"arrows: resize")
-			lines = append(lines, indent+"p: confirm plot")
+			lines = append(lines, indent+i18n.T("ui.arrows_resize"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_plot"))
 		}
-		lines = append(lines, indent+"tab: toggle mark/unmark")
-		lines = append(lines, indent+"enter: done  esc: cancel")
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
 		lines = append(lines, "")
 	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildFence" {
 		// Line marking hints
-		modeName := "Mark"
+		modeName := i18n.T("ui.mark")
 		if m.areaSelectUnmarkMode {
-			modeName = "Unmark"
+			modeName = i18n.T("ui.unmark")
 		}
-		lines = append(lines, indent+markedForConstructionStyle.Render("Fence: "+modeName), "")
+		lines = append(lines, indent+markedForConstructionStyle.Render(i18n.T("ui.fence")+modeName), "")
 		if m.areaSelectAnchor == nil {
-			lines = append(lines, indent+"arrows: move cursor")
-			lines = append(lines, indent+"p: set anchor")
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
 		} else {
-			lines = append(lines, indent+"arrows: draw line")
-			lines = append(lines, indent+"p: confirm line")
+			lines = append(lines, indent+i18n.T("ui.arrows_draw_line"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_line"))
 		}
-		lines = append(lines, indent+"tab: toggle mark/unmark")
-		lines = append(lines, indent+"enter: done  esc: cancel")
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
+		lines = append(lines, "")
+	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "digChannel" {
+		// Line marking hints
+		modeName := i18n.T("ui.mark")
+		if m.areaSelectUnmarkMode {
+			modeName = i18n.T("ui.unmark")
+		}
+		lines = append(lines, indent+markedForDiggingStyle.Render(i18n.T("ui.dig_channel")+modeName), "")
+		if m.areaSelectAnchor == nil {
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
+		} else {
+			lines = append(lines, indent+i18n.T("ui.arrows_draw_line"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_line"))
+		}
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
+		lines = append(lines, indent+hintStyle.Render(i18n.T("ui.channel_from_water")))
+		lines = append(lines, "")
+	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "deconstruct" {
+		// Line or rectangle marking hints
+		modeName := i18n.T("ui.mark")
+		if m.areaSelectUnmarkMode {
+			modeName = i18n.T("ui.unmark")
+		}
+		lines = append(lines, indent+markedForDeconstructStyle.Render(i18n.T("ui.deconstruct")+modeName), "")
+		if m.areaSelectAnchor == nil {
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
+		} else if m.areaSelectLineMode {
+			lines = append(lines, indent+i18n.T("ui.arrows_draw_line"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_line"))
+		} else {
+			lines = append(lines, indent+i18n.T("ui.arrows_resize"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_area"))
+		}
+		if m.areaSelectLineMode {
+			lines = append(lines, indent+i18n.T("ui.l_rectangle_tool"))
+		} else {
+			lines = append(lines, indent+i18n.T("ui.l_line_tool"))
+		}
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
+		lines = append(lines, "")
+	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "carveWood" {
+		// Rectangle marking hints
+		modeName := i18n.T("ui.mark")
+		if m.areaSelectUnmarkMode {
+			modeName = i18n.T("ui.unmark")
+		}
+		lines = append(lines, indent+markedForCarvingStyle.Render(i18n.T("ui.carve")+modeName), "")
+		if m.areaSelectAnchor == nil {
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
+		} else {
+			lines = append(lines, indent+i18n.T("ui.arrows_resize"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_area"))
+		}
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
+		lines = append(lines, "")
+	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "chopWood" {
+		// Rectangle marking hints
+		modeName := i18n.T("ui.mark")
+		if m.areaSelectUnmarkMode {
+			modeName = i18n.T("ui.unmark")
+		}
+		lines = append(lines, indent+markedForChoppingStyle.Render(i18n.T("ui.chop")+modeName), "")
+		if m.areaSelectAnchor == nil {
+			lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+			lines = append(lines, indent+i18n.T("ui.p_set_anchor"))
+		} else {
+			lines = append(lines, indent+i18n.T("ui.arrows_resize"))
+			lines = append(lines, indent+i18n.T("ui.p_confirm_area"))
+		}
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
+		lines = append(lines, "")
+	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildCampfire" {
+		lines = append(lines, indent+markedForConstructionStyle.Render(i18n.T("ui.campfire")), "")
+		lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
+		lines = append(lines, indent+i18n.T("ui.p_toggle_campfire"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
 		lines = append(lines, "")
 	} else if m.ordersAddMode && m.ordersAddStep == 2 && m.step2ActivityID == "buildHut" {
-		modeName := "Mark"
-		pHint := "p: place hut"
+		modeName := i18n.T("ui.mark")
+		pHint := i18n.T("ui.p_place_hut")
 		if m.areaSelectUnmarkMode {
-			modeName = "Unmark"
-			pHint = "p: remove hut"
+			modeName = i18n.T("ui.unmark")
+			pHint = i18n.T("ui.p_remove_hut")
 		}
-		lines = append(lines, indent+markedForConstructionStyle.Render("Hut: "+modeName), "")
-		lines = append(lines, indent+"arrows: move cursor")
+		lines = append(lines, indent+markedForConstructionStyle.Render(i18n.T("ui.hut")+modeName), "")
+		lines = append(lines, indent+i18n.T("ui.arrows_move_cursor"))
 		lines = append(lines, indent+pHint)
-		lines = append(lines, indent+"tab: toggle mark/unmark")
-		lines = append(lines, indent+"enter: done  esc: cancel")
+		lines = append(lines, indent+i18n.T("ui.tab_toggle_mark_unmark"))
+		lines = append(lines, indent+i18n.T("ui.enter_done_esc_cancel"))
 		lines = append(lines, "")
 	} else if m.ordersAddMode || m.ordersCancelMode {
-		lines = append(lines, indent+"enter: confirm  esc: back", "")
+		lines = append(lines, indent+i18n.T("ui.enter_confirm_esc_back"), "")
 	} else {
 		var hints []string
 		if len(orderableActivities) > 0 {
-			hints = append(hints, "+: add")
+			hints = append(hints, i18n.T("ui.plus_add"))
 		}
 		if len(m.orders) > 0 {
-			hints = append(hints, "c: cancel")
+			hints = append(hints, i18n.T("ui.c_cancel"))
 		}
 		if expanded {
-			hints = append(hints, "x: collapse")
+			hints = append(hints, i18n.T("ui.x_collapse"))
 		} else {
-			hints = append(hints, "x: expand")
+			hints = append(hints, i18n.T("ui.x_expand_hint"))
 		}
-		hints = append(hints, "o: close")
+		hints = append(hints, i18n.T("ui.o_close"))
 		lines = append(lines, indent+strings.Join(hints, "  "), "")
 	}
 
@@ -1798,25 +2419,25 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 		// Add order flow
 		if m.ordersAddStep == 0 {
 			// Step 0: Select activity
-			lines = append(lines, indent+"Select activity:", "")
+			lines = append(lines, indent+i18n.T("ui.select_activity"), "")
 			if len(orderableActivities) == 0 {
-				lines = append(lines, selectIndent+"(no activities available)")
-				lines = append(lines, selectIndent+"Characters must discover")
-				lines = append(lines, selectIndent+"know-how first.")
+				lines = append(lines, selectIndent+i18n.T("ui.no_activities_available"))
+				lines = append(lines, selectIndent+i18n.T("ui.characters_must_discover"))
+				lines = append(lines, selectIndent+i18n.T("ui.know_how_first"))
 			} else {
 				for i, activity := range orderableActivities {
 					prefix := selectIndent
 					if i == m.selectedActivityIndex {
 						prefix = selectPrefix
 					}
-					lines = append(lines, fmt.Sprintf("%s%d. %s", prefix, i+1, activity.Name))
+					lines = append(lines, fmt.Sprintf("%s%d. %s", prefix, i+1, activity.DisplayName()))
 				}
 			}
 		} else if m.ordersAddStep == 2 {
 			if m.step2ActivityID == "plant" {
 				// Step 2 (plant): show plantable type selection
 				plantTypes := game.GetPlantableTypes(m.gameMap.Items(), m.gameMap.Characters())
-				lines = append(lines, indent+"Select type to plant:", "")
+				lines = append(lines, indent+i18n.T("ui.select_type_to_plant"), "")
 				for i, pt := range plantTypes {
 					prefix := selectIndent
 					if i == m.selectedPlantTypeIndex {
@@ -1832,9 +2453,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 				isSyntheticCategory(orderableActivities[m.selectedActivityIndex].ID) {
 				category := syntheticCategoryID(orderableActivities[m.selectedActivityIndex].ID)
 				categoryActivities := m.getCategoryActivities(category)
-				prompt := "Select activity:"
+				prompt := i18n.T("ui.select_activity")
 				if category == "craft" {
-					prompt = "Select item to craft:"
+					prompt = i18n.T("ui.select_item_to_craft")
 				}
 				lines = append(lines, indent+prompt, "")
 				for i, activity := range categoryActivities {
@@ -1842,12 +2463,12 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 					if i == m.selectedTargetIndex {
 						prefix = selectPrefix
 					}
-					displayName := activity.Name
+					displayName := activity.DisplayName()
 					switch category {
 					case "construction":
-						displayName = "Build " + strings.ToLower(activity.Name)
+						displayName = i18n.T("order.build", strings.ToLower(activity.DisplayName()))
 					case "craft":
-						displayName = "Craft " + strings.ToLower(activity.Name)
+						displayName = i18n.T("order.craft", strings.ToLower(activity.DisplayName()))
 					}
 					lines = append(lines, fmt.Sprintf("%s%d. %s", prefix, i+1, displayName))
 				}
@@ -1855,9 +2476,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 				orderableActivities[m.selectedActivityIndex].ID == "gather" {
 				// Gather selected - show gatherable types on ground
 				gatherTypes := game.GetGatherableTypes(m.gameMap.Items())
-				lines = append(lines, indent+"Select type to gather:", "")
+				lines = append(lines, indent+i18n.T("ui.select_type_to_gather"), "")
 				if len(gatherTypes) == 0 {
-					lines = append(lines, selectIndent+"(nothing to gather)")
+					lines = append(lines, selectIndent+i18n.T("ui.nothing_to_gather"))
 				}
 				for i, gt := range gatherTypes {
 					prefix := selectIndent
@@ -1870,9 +2491,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 				orderableActivities[m.selectedActivityIndex].ID == "extract" {
 				// Extract selected - show extractable plant types
 				extractTypes := game.GetExtractableItemTypes(m.gameMap.Items())
-				lines = append(lines, indent+"Select type to extract:", "")
+				lines = append(lines, indent+i18n.T("ui.select_type_to_extract"), "")
 				if len(extractTypes) == 0 {
-					lines = append(lines, selectIndent+"(no extractable plants)")
+					lines = append(lines, selectIndent+i18n.T("ui.no_extractable_plants"))
 				}
 				for i, et := range extractTypes {
 					prefix := selectIndent
@@ -1884,7 +2505,7 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 			} else {
 				// Harvest selected - show item types
 				harvestableTypes := m.getHarvestableItemTypes()
-				lines = append(lines, indent+"Select item type:", "")
+				lines = append(lines, indent+i18n.T("ui.select_item_type"), "")
 				for i, itemType := range harvestableTypes {
 					prefix := selectIndent
 					if i == m.selectedTargetIndex {
@@ -1896,9 +2517,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 		}
 	} else if m.ordersCancelMode {
 		// Cancel mode - show orders with selection
-		lines = append(lines, indent+"Select order to cancel:", "")
+		lines = append(lines, indent+i18n.T("ui.select_order_to_cancel"), "")
 		if len(m.orders) == 0 {
-			lines = append(lines, selectIndent+"(no orders)")
+			lines = append(lines, selectIndent+i18n.T("ui.no_orders"))
 		} else {
 			// Build character lookup map for assigned names
 			charByID := make(map[int]*entity.Character)
@@ -1922,9 +2543,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 				feasible, noKnowHow := system.IsOrderFeasible(order, items, m.gameMap)
 				if !feasible || order.Status == entity.OrderAbandoned {
 					if noKnowHow {
-						statusStr = "No one knows how"
+						statusStr = i18n.T("ui.no_one_knows_how")
 					} else if !feasible {
-						statusStr = "Unfulfillable"
+						statusStr = i18n.T("ui.unfulfillable")
 					}
 					lines = append(lines, unfulfillableStyle.Render(fmt.Sprintf("%s%d. %s [%s]", prefix, i+1, order.DisplayName(), statusStr)))
 				} else {
@@ -1935,14 +2556,14 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 	} else {
 		// Normal view - show order list
 		if len(m.orders) == 0 {
-			lines = append(lines, indent+"No orders.")
+			lines = append(lines, indent+i18n.T("ui.no_orders_yet"))
 			lines = append(lines, "")
 			if len(orderableActivities) > 0 {
-				lines = append(lines, indent+"Press + to add an order.")
+				lines = append(lines, indent+i18n.T("ui.press_to_add_an_order"))
 			} else {
-				lines = append(lines, indent+"Characters must discover")
-				lines = append(lines, indent+"know-how before orders")
-				lines = append(lines, indent+"can be created.")
+				lines = append(lines, indent+i18n.T("ui.characters_must_discover"))
+				lines = append(lines, indent+i18n.T("ui.know_how_before_orders"))
+				lines = append(lines, indent+i18n.T("ui.can_be_created"))
 			}
 		} else {
 			// Build character lookup map for assigned names
@@ -1963,9 +2584,9 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 				feasible, noKnowHow := system.IsOrderFeasible(order, items, m.gameMap)
 				if !feasible || order.Status == entity.OrderAbandoned {
 					if noKnowHow {
-						statusStr = "No one knows how"
+						statusStr = i18n.T("ui.no_one_knows_how")
 					} else if !feasible {
-						statusStr = "Unfulfillable"
+						statusStr = i18n.T("ui.unfulfillable")
 					}
 					lines = append(lines, unfulfillableStyle.Render(fmt.Sprintf("%s%s [%s]", indent, order.DisplayName(), statusStr)))
 				} else {
@@ -1977,7 +2598,7 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 
 	// Order creation flash confirmation
 	if time.Now().Before(m.orderFlashEnd) {
-		flash := indent + "+ " + m.orderFlashMessage + " added"
+		flash := indent + i18n.T("ui.order_added", m.orderFlashMessage)
 		if m.orderFlashCount > 1 {
 			flash += fmt.Sprintf(" (x%d)", m.orderFlashCount)
 		}
@@ -1990,16 +2611,31 @@ func (m Model) renderOrdersContent(expanded bool) []string {
 // renderOrdersPanel renders the collapsed orders panel
 func (m Model) renderOrdersPanel() string {
 	var lines []string
-	lines = append(lines, titleStyle.Render("         ORDERS"), "")
+	lines = append(lines, titleStyle.Render(i18n.T("ui.orders")), "")
 	lines = append(lines, m.renderOrdersContent(false)...)
 	return strings.Join(lines, "\n")
 }
 
-// categoryDisplayName maps category IDs to their display names in the order UI
-var categoryDisplayName = map[string]string{
-	"craft":        "Craft",
-	"garden":       "Garden",
-	"construction": "Construction",
+// colorLabel spells out a color for the details panel, with its map letter when the profile has no color
+func colorLabel(c types.Color) string {
+	label := attributeLabel("color", string(c))
+	if letter := colorSuffix(c); letter != "" {
+		label += " [" + letter + "]"
+	}
+	return label
+}
+
+// attributeLabel returns a color, pattern, or texture value in the active language
+func attributeLabel(attribute, value string) string {
+	return i18n.Or(attribute+"."+strings.ReplaceAll(value, " ", "_"), value)
+}
+
+// categoryDisplayKeys maps category IDs to their display name message keys in the order UI
+var categoryDisplayKeys = map[string]string{
+	"craft":        "category.craft",
+	"garden":       "category.garden",
+	"construction": "category.construction",
+	"woodwork":     "category.woodwork",
 }
 
 // getOrderableActivities returns activities that can be ordered
@@ -2039,8 +2675,8 @@ func (m Model) getOrderableActivities() []entity.Activity {
 	// Add synthetic category entries for categories with known activities
 	for category := range knownCategories {
 		name := category
-		if display, ok := categoryDisplayName[category]; ok {
-			name = display
+		if key, ok := categoryDisplayKeys[category]; ok {
+			name = i18n.T(key)
 		}
 		result = append(result, entity.Activity{
 			ID:       "category:" + category,
@@ -2141,7 +2777,7 @@ func (m *Model) sweepCompletedOrders() {
 func (m Model) viewFullScreenOrders() string {
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, titleStyle.Render("                    ORDERS"))
+	lines = append(lines, titleStyle.Render(i18n.T("ui.orders_wide")))
 	lines = append(lines, "")
 	lines = append(lines, m.renderOrdersContent(true)...)
 
@@ -1,11 +1,16 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
 	"petri/internal/config"
 	"petri/internal/entity"
 	"petri/internal/game"
+	"petri/internal/system"
 	"petri/internal/types"
 )
 
@@ -106,6 +111,30 @@ func TestHutSymbolFromAdjacency_DoorAlwaysReturnsDoorsymbol(t *testing.T) {
 	}
 }
 
+func TestHutSymbolFromAdjacency_AfterWallDeconstructed(t *testing.T) {
+	t.Parallel()
+	// Top wall of a hut: corner, wall, wall. Taking down the middle wall leaves the
+	// corner with only its south neighbor and the far wall as a lone edge.
+	m := game.NewMap(20, 20)
+	corner := entity.NewHutConstruct(5, 5, "stick", types.ColorBrown, "wall")
+	middle := entity.NewHutConstruct(6, 5, "stick", types.ColorBrown, "wall")
+	far := entity.NewHutConstruct(7, 5, "stick", types.ColorBrown, "wall")
+	side := entity.NewHutConstruct(5, 6, "stick", types.ColorBrown, "wall")
+	for _, c := range []*entity.Construct{corner, middle, far, side} {
+		m.AddConstruct(c)
+	}
+	m.MarkForDeconstruction(middle.Pos())
+
+	system.DeconstructConstruct(m, middle, entity.NewCharacter(1, 6, 4, "Test", "berry", types.ColorRed), nil)
+
+	if sym, _, rightFill := hutSymbolFromAdjacency(corner.Pos(), m); sym != config.CharHutEdgeV || rightFill != " " {
+		t.Errorf("corner after removal: got %c with rightFill %q, want %c with no fill", sym, rightFill, config.CharHutEdgeV)
+	}
+	if sym, leftFill, _ := hutSymbolFromAdjacency(far.Pos(), m); sym != config.CharHutEdgeH || leftFill != " " {
+		t.Errorf("far wall after removal: got %c with leftFill %q, want %c with no fill", sym, leftFill, config.CharHutEdgeH)
+	}
+}
+
 func TestHutSymbolFromAdjacency_SingleOrNoNeighbor(t *testing.T) {
 	center := types.Position{X: 10, Y: 10}
 
@@ -149,3 +178,231 @@ func TestHutSymbolFromAdjacency_SingleOrNoNeighbor(t *testing.T) {
 		})
 	}
 }
+
+func TestTuningPanel_DebugOnlyAndListsSources(t *testing.T) {
+	t.Parallel()
+
+	m := Model{phase: phasePlaying, paused: true, gameMap: game.NewMap(20, 20)}
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}}
+
+	result, _ := m.Update(key)
+	if result.(Model).showTuningPanel {
+		t.Error("Expected tuning panel unavailable without debug")
+	}
+
+	m.testCfg.Debug = true
+	result, _ = m.Update(key)
+	m = result.(Model)
+	if !m.showTuningPanel {
+		t.Fatal("Expected t to open the tuning panel in debug mode")
+	}
+
+	panel := m.renderTuningPanel(200)
+	if !strings.Contains(panel, "SURVIVAL") || !strings.Contains(panel, "hunger_increase_rate") {
+		t.Errorf("Expected grouped tunables in panel:\n%s", panel)
+	}
+	if !strings.Contains(panel, "default") {
+		t.Errorf("Expected value sources in panel:\n%s", panel)
+	}
+}
+
+func TestParseRenderProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range RenderProfiles {
+		got, err := ParseRenderProfile(string(p))
+		if err != nil || got != p {
+			t.Errorf("ParseRenderProfile(%q) = %q, %v", p, got, err)
+		}
+	}
+	if _, err := ParseRenderProfile("sepia"); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}
+
+func TestRenderProfiles_ColorEveryItemColor(t *testing.T) {
+	t.Parallel()
+
+	letters := map[rune]types.Color{}
+	for c, letter := range colorLetters {
+		if other, ok := letters[letter]; ok {
+			t.Errorf("%s and %s share map letter %q", c, other, letter)
+		}
+		letters[letter] = c
+	}
+	for _, p := range RenderProfiles {
+		if p == RenderMonochrome {
+			continue
+		}
+		for c := range colorLetters {
+			if palettes[p].items[c] == "" {
+				t.Errorf("%s profile has no color for %s", p, c)
+			}
+		}
+	}
+}
+
+// Not parallel: SetRenderProfile mutates the package styles
+func TestRenderCell_MonochromeNamesItemColor(t *testing.T) {
+	t.Cleanup(func() { SetRenderProfile(RenderDefault) })
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.AddItem(entity.NewBerry(2, 2, types.ColorRed, false, false))
+	m := Model{phase: phasePlaying, gameMap: gameMap}
+
+	if cell := m.renderCell(2, 2); !strings.HasSuffix(cell, " ") {
+		t.Errorf("Default profile: got %q, want symbol padded with a space", cell)
+	}
+
+	SetRenderProfile(RenderMonochrome)
+	if cell := m.renderCell(2, 2); !strings.HasSuffix(cell, "r") {
+		t.Errorf("Monochrome profile: got %q, want red letter suffix", cell)
+	}
+
+	m.cursorX, m.cursorY = 2, 2
+	if details := m.renderDetails(); !strings.Contains(details, "red [r]") {
+		t.Errorf("Monochrome details should spell out the color and its letter:\n%s", details)
+	}
+}
+
+func TestRenderDetails_Creature(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	gameMap.SetTilled(types.Position{X: 3, Y: 3})
+	gameMap.AddItem(entity.NewBerry(3, 3, types.ColorRed, false, false))
+	rabbit := entity.NewCreature(3, 3, "rabbit")
+	gameMap.AddCreature(rabbit)
+	m := Model{phase: phasePlaying, gameMap: gameMap, cursorX: 3, cursorY: 3}
+
+	if cell := m.renderCell(3, 3); !strings.Contains(cell, string(config.CharRabbit)) {
+		t.Errorf("Expected the rabbit drawn over the berry, got %q", cell)
+	}
+	details := m.renderDetails()
+	for _, want := range []string{"Type: Creature", "Kind: Rabbit", "Hunger:", "Activity: Idle", "On ground:"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("Details missing %q:\n%s", want, details)
+		}
+	}
+}
+
+func TestRenderDetails_Condition(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	fence := entity.NewFence(2, 2, "stick", types.ColorBrown)
+	fence.Durability.Current = fence.Durability.Max * 0.4
+	gameMap.AddConstruct(fence)
+	gameMap.AddItem(entity.NewHoe(5, 5, types.ColorSilver))
+	gameMap.AddItem(entity.NewBerry(7, 7, types.ColorRed, false, false))
+
+	m := Model{phase: phasePlaying, gameMap: gameMap, cursorX: 2, cursorY: 2}
+	if details := m.renderDetails(); !strings.Contains(details, "Condition:") || !strings.Contains(details, "40%") {
+		t.Errorf("Expected fence condition at 40%%:\n%s", details)
+	}
+	m.cursorX, m.cursorY = 5, 5
+	if details := m.renderDetails(); !strings.Contains(details, "100%") {
+		t.Errorf("Expected new hoe at full condition:\n%s", details)
+	}
+	m.cursorX, m.cursorY = 7, 7
+	if details := m.renderDetails(); strings.Contains(details, "Condition:") {
+		t.Errorf("Expected no condition line for a berry:\n%s", details)
+	}
+}
+
+func TestRenderDetails_HutInterior(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(12, 12)
+	game.AddTestHut(gameMap, 2, 2)
+
+	m := Model{phase: phasePlaying, gameMap: gameMap, cursorX: 4, cursorY: 4}
+	if details := m.renderDetails(); !strings.Contains(details, "In hut interior") {
+		t.Errorf("Expected hut interior annotation:\n%s", details)
+	}
+	m.cursorX, m.cursorY = 9, 9
+	if details := m.renderDetails(); strings.Contains(details, "In hut interior") {
+		t.Errorf("Expected no region annotation outside the hut:\n%s", details)
+	}
+}
+
+func TestViewGame_StatusBarShowsSeasonAndClock(t *testing.T) {
+	t.Parallel()
+
+	m := Model{phase: phasePlaying, gameMap: game.NewMap(10, 10), actionLog: system.NewActionLog(10), width: 120, height: 40}
+	if view := m.viewGame(); !strings.Contains(view, "Spring, Day 1 08:00 day") {
+		t.Errorf("Expected the status bar to show the morning clock, got:\n%s", view)
+	}
+
+	m.elapsedGameTime = 80 // Midnight
+	m.gameMap.SetCalendar(game.SeasonWinter, 0)
+	if view := m.viewGame(); !strings.Contains(view, "Winter, Day 2 00:00 night") {
+		t.Errorf("Expected the status bar to show midnight on day 2, got:\n%s", view)
+	}
+}
+
+func TestTimeOfDayTint_OnlyBetweenDuskAndDawn(t *testing.T) {
+	t.Parallel()
+
+	m := Model{phase: phasePlaying, gameMap: game.NewMap(10, 10)}
+	if _, ok := m.timeOfDayTint(); ok {
+		t.Error("Expected no map tint in the morning")
+	}
+	m.elapsedGameTime = 80 // Midnight
+	if tint, ok := m.timeOfDayTint(); !ok || tint.GetBackground() != nightStyle.GetBackground() {
+		t.Error("Expected the night tint at midnight")
+	}
+	if cell := m.renderCell(4, 4); lipgloss.Width(cell) != 3 {
+		t.Errorf("Expected a tinted cell to stay 3 columns wide, got %q", cell)
+	}
+}
+
+func TestMapTint_WeatherShowsByDayOnly(t *testing.T) {
+	t.Parallel()
+
+	m := Model{phase: phasePlaying, gameMap: game.NewMap(10, 10)}
+	if _, ok := m.mapTint(); ok {
+		t.Error("Expected no map tint on a clear morning")
+	}
+	m.gameMap.SetWeather(game.WeatherRain, game.WeatherClear, 100)
+	if tint, ok := m.mapTint(); !ok || tint.GetBackground() != rainStyle.GetBackground() {
+		t.Error("Expected the rain tint on a rainy morning")
+	}
+	m.elapsedGameTime = 80 // Midnight
+	if tint, ok := m.mapTint(); !ok || tint.GetBackground() != nightStyle.GetBackground() {
+		t.Error("Expected darkness to take precedence over the rain tint")
+	}
+}
+
+func TestSoilOverlay_ToggledByGAndTintsByFertility(t *testing.T) {
+	t.Parallel()
+
+	gameMap := game.NewMap(10, 10)
+	pos := types.Position{X: 3, Y: 3}
+	gameMap.SetTilled(pos)
+	gameMap.SetSoil(pos, config.SoilFertilityMin, "berry")
+	gameMap.SetTilled(types.Position{X: 4, Y: 3})
+	m := Model{phase: phasePlaying, gameMap: gameMap, cursorX: pos.X, cursorY: pos.Y}
+
+	newModel, _ := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	m = newModel.(Model)
+	if !m.showSoilOverlay {
+		t.Fatal("Expected g to turn the soil overlay on")
+	}
+	if cell := m.renderCell(4, 3); lipgloss.Width(cell) != 3 {
+		t.Errorf("Expected an overlaid cell to stay 3 columns wide, got %q", cell)
+	}
+	if soilStyle(gameMap.Fertility(pos)).GetBackground() != soilPoorStyle.GetBackground() {
+		t.Error("Expected worn-out soil to show as poor")
+	}
+	if soilStyle(config.SoilFertilityMax).GetBackground() != soilRichStyle.GetBackground() {
+		t.Error("Expected composted soil to show as rich")
+	}
+
+	details := m.renderDetails()
+	for _, want := range []string{"Fertility: 25%", "Last crop: berries"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("Details missing %q:\n%s", want, details)
+		}
+	}
+}